@@ -0,0 +1,50 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DryRunAnnotation is an annotation on an ORC object which controls
+	// whether the controller may modify OpenStack on its behalf. If it is
+	// "true", the controller reports the changes it would make to OpenStack
+	// without making them. If it is "false", the controller modifies
+	// OpenStack even if dry run is the manager's default. Any other value is
+	// treated as "true".
+	DryRunAnnotation = "openstack.k-orc.cloud/dry-run"
+
+	// PausedAnnotation is an annotation on an ORC object which pauses its
+	// reconciliation. If it is set to any value other than "false", the
+	// controller makes no calls to OpenStack for the object until the
+	// annotation is removed.
+	PausedAnnotation = "openstack.k-orc.cloud/paused"
+)
+
+// IsPaused returns true if reconciliation of obj has been paused with the
+// PausedAnnotation.
+func IsPaused(obj metav1.Object) bool {
+	value, ok := obj.GetAnnotations()[PausedAnnotation]
+	if !ok {
+		return false
+	}
+	paused, err := strconv.ParseBool(value)
+	return err != nil || paused
+}
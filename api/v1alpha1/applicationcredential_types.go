@@ -74,6 +74,8 @@ type ApplicationCredentialResourceSpec struct {
 	Unrestricted *bool `json:"unrestricted,omitempty"`
 
 	// secretRef is a reference to a Secret containing the application credential secret
+	// in a key named `value`. Once the application credential has been created, ORC
+	// writes its credentials to the same Secret so that it can be used by cloudCredentialsRef.
 	// +required
 	SecretRef KubernetesNameRef `json:"secretRef,omitempty"`
 
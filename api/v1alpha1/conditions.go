@@ -44,13 +44,48 @@ const (
 	// user likely wants to know about this if it persists.
 	ConditionReasonTransientError = "TransientError"
 
+	// OpenStack throttled our requests. We will retry after the interval it
+	// requested.
+	ConditionReasonRateLimited = "RateLimited"
+
+	// The controller is in dry run mode and has not made changes to OpenStack
+	// which are required to reconcile the resource.
+	ConditionReasonDryRun = "DryRun"
+
+	// Reconciliation of the resource has been paused by the user. The
+	// controller will not call OpenStack until it is resumed.
+	ConditionReasonPaused = "Paused"
+
+	// The ORC object has been deleted, but the controller will not delete the
+	// OpenStack resource because deletion protection is enabled.
+	ConditionReasonDeletionProtected = "DeletionProtected"
+
 	// The resource is ready for use.
 	ConditionReasonSuccess = "Success"
+
+	// The OpenStack resource does not match the desired state, and the
+	// controller has been configured not to correct it.
+	ConditionReasonDriftDetected = "DriftDetected"
+
+	// The OpenStack resource matches the desired state.
+	ConditionReasonNoDrift = "NoDrift"
+
+	// OpenStack rejected the credentials used to reconcile the resource.
+	ConditionReasonAuthenticationFailed = "AuthenticationFailed"
 )
 
 const (
 	ConditionAvailable   = "Available"
 	ConditionProgressing = "Progressing"
+
+	// ConditionDrifted is only set on objects whose drift policy is
+	// `report`. It is True if the OpenStack resource no longer matches the
+	// desired state.
+	ConditionDrifted = "Drifted"
+
+	// ConditionCredentialsFailing is only set while OpenStack rejects the
+	// credentials used to reconcile the object.
+	ConditionCredentialsFailing = "CredentialsFailing"
 )
 
 // IsConditionReasonTerminal returns true if the given reason represents an error which should prevent further reconciliation.
@@ -45,6 +45,20 @@ const (
 	OnDeleteDetach OnDelete = "detach"
 )
 
+// +kubebuilder:validation:Enum:=correct;report
+type DriftPolicy string
+
+const (
+	// DriftPolicyCorrect specifies that the controller will update the
+	// OpenStack resource to match the desired state when drift is detected.
+	DriftPolicyCorrect DriftPolicy = "correct"
+
+	// DriftPolicyReport specifies that the controller will not update the
+	// OpenStack resource when drift is detected. Instead it will report the
+	// fields which have drifted in the object's status.
+	DriftPolicyReport DriftPolicy = "report"
+)
+
 type ManagedOptions struct {
 	// onDelete specifies the behaviour of the controller when the ORC
 	// object is deleted. Options are `delete` - delete the OpenStack resource;
@@ -53,6 +67,22 @@ type ManagedOptions struct {
 	// +kubebuilder:default:=delete
 	// +optional
 	OnDelete OnDelete `json:"onDelete,omitempty"`
+
+	// driftPolicy specifies the behaviour of the controller when the
+	// OpenStack resource no longer matches the desired state. Options are
+	// `correct` - update the OpenStack resource to match the desired state;
+	// `report` - do not modify the OpenStack resource, but report the fields
+	// which differ in status.drift and the Drifted condition. If not
+	// specified, the manager's default drift policy is used.
+	// +optional
+	DriftPolicy DriftPolicy `json:"driftPolicy,omitempty"`
+
+	// deletionProtection prevents the controller from deleting the
+	// OpenStack resource. If it is true and onDelete is `delete`, the
+	// controller will not delete the OpenStack resource or remove its
+	// finalizer from the ORC object until deletionProtection is set to false.
+	// +optional
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
 }
 
 // GetOnDelete returns the delete behaviour from ManagedOptions. If called on a
@@ -63,3 +93,18 @@ func (o *ManagedOptions) GetOnDelete() OnDelete {
 	}
 	return o.OnDelete
 }
+
+// GetDeletionProtection returns true if deletion protection is enabled in
+// ManagedOptions. If called on a nil receiver it safely returns false.
+func (o *ManagedOptions) GetDeletionProtection() bool {
+	return o != nil && o.DeletionProtection != nil && *o.DeletionProtection
+}
+
+// GetDriftPolicy returns the drift policy from ManagedOptions. If called on a
+// nil receiver, or if no drift policy is set, it returns the given default.
+func (o *ManagedOptions) GetDriftPolicy(defaultPolicy DriftPolicy) DriftPolicy {
+	if o == nil || o.DriftPolicy == "" {
+		return defaultPolicy
+	}
+	return o.DriftPolicy
+}
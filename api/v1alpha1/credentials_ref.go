@@ -21,12 +21,120 @@ const (
 	CloudCredentialsConfigSecretKey = "clouds.yaml"
 	// CloudCredencialsCASecretKey is the key for the CA certificate in the cloud credentials secret.
 	CloudCredencialsCASecretKey = "cacert"
+
+	// The following keys define a single cloud in a cloud credentials secret
+	// which does not contain CloudCredentialsConfigSecretKey. They have the
+	// same meaning as the equivalent clouds.yaml fields.
+
+	// CloudCredentialsAuthURLSecretKey is the key for the Keystone URL. It is
+	// required in a secret which does not contain a clouds.yaml file.
+	CloudCredentialsAuthURLSecretKey = "auth_url"
+	// CloudCredentialsApplicationCredentialIDSecretKey is the key for the ID
+	// of an application credential.
+	CloudCredentialsApplicationCredentialIDSecretKey = "application_credential_id"
+	// CloudCredentialsApplicationCredentialNameSecretKey is the key for the
+	// name of an application credential. It requires the user to be
+	// identified.
+	CloudCredentialsApplicationCredentialNameSecretKey = "application_credential_name"
+	// CloudCredentialsApplicationCredentialSecretSecretKey is the key for the
+	// secret of an application credential. If it is present, the secret
+	// authenticates with an application credential. Otherwise it
+	// authenticates with a password.
+	CloudCredentialsApplicationCredentialSecretSecretKey = "application_credential_secret"
+	// CloudCredentialsUsernameSecretKey is the key for the name of the user.
+	CloudCredentialsUsernameSecretKey = "username"
+	// CloudCredentialsUserIDSecretKey is the key for the ID of the user.
+	CloudCredentialsUserIDSecretKey = "user_id"
+	// CloudCredentialsPasswordSecretKey is the key for the password of the user.
+	CloudCredentialsPasswordSecretKey = "password"
+	// CloudCredentialsUserDomainNameSecretKey is the key for the name of the
+	// user's domain.
+	CloudCredentialsUserDomainNameSecretKey = "user_domain_name"
+	// CloudCredentialsUserDomainIDSecretKey is the key for the ID of the
+	// user's domain.
+	CloudCredentialsUserDomainIDSecretKey = "user_domain_id"
+	// CloudCredentialsProjectNameSecretKey is the key for the name of the
+	// project to scope to.
+	CloudCredentialsProjectNameSecretKey = "project_name"
+	// CloudCredentialsProjectIDSecretKey is the key for the ID of the project
+	// to scope to.
+	CloudCredentialsProjectIDSecretKey = "project_id"
+	// CloudCredentialsProjectDomainNameSecretKey is the key for the name of
+	// the project's domain.
+	CloudCredentialsProjectDomainNameSecretKey = "project_domain_name"
+	// CloudCredentialsProjectDomainIDSecretKey is the key for the ID of the
+	// project's domain.
+	CloudCredentialsProjectDomainIDSecretKey = "project_domain_id"
+	// CloudCredentialsRegionNameSecretKey is the key for the region.
+	CloudCredentialsRegionNameSecretKey = "region_name"
+	// CloudCredentialsInterfaceSecretKey is the key for the endpoint
+	// interface: public, internal or admin.
+	CloudCredentialsInterfaceSecretKey = "interface"
+	// CloudCredentialsAuthTypeSecretKey is the key for the authentication
+	// type. If it is not present, the authentication type is determined by the
+	// other keys in the secret.
+	CloudCredentialsAuthTypeSecretKey = "auth_type"
+
+	// The following keys configure the v3oidcaccesstoken auth type, which
+	// authenticates by exchanging a token of a Kubernetes ServiceAccount in
+	// the secret's namespace for a Keystone token using identity federation.
+
+	// CloudCredentialsIdentityProviderSecretKey is the key for the name of
+	// the Keystone identity provider.
+	CloudCredentialsIdentityProviderSecretKey = "identity_provider"
+	// CloudCredentialsProtocolSecretKey is the key for the name of the
+	// Keystone federation protocol.
+	CloudCredentialsProtocolSecretKey = "protocol"
+	// CloudCredentialsServiceAccountSecretKey is the key for the name of the
+	// ServiceAccount whose token is exchanged.
+	CloudCredentialsServiceAccountSecretKey = "service_account"
+	// CloudCredentialsAudienceSecretKey is the key for the audience of the
+	// ServiceAccount token. It is required, and must not be an audience of the
+	// Kubernetes API server.
+	CloudCredentialsAudienceSecretKey = "audience"
+
+	// ServiceAccountTokenExchangeAudienceAnnotation is an annotation on a
+	// ServiceAccount which allows ORC to request tokens for it with the given
+	// audience for the v3oidcaccesstoken auth type. ORC will not request a
+	// token for a ServiceAccount without this annotation.
+	ServiceAccountTokenExchangeAudienceAnnotation = "openstack.k-orc.cloud/token-exchange-audience"
+
+	// CloudCredentialsRateLimitQPSAnnotation is an annotation on the cloud
+	// credentials secret which overrides the manager's default sustained rate
+	// of OpenStack API requests per second for each cloud in the secret. A
+	// value of 0 disables rate limiting.
+	CloudCredentialsRateLimitQPSAnnotation = "openstack.k-orc.cloud/rate-limit-qps"
+	// CloudCredentialsRateLimitBurstAnnotation is an annotation on the cloud
+	// credentials secret which overrides the manager's default maximum burst
+	// of OpenStack API requests for each cloud in the secret.
+	CloudCredentialsRateLimitBurstAnnotation = "openstack.k-orc.cloud/rate-limit-burst"
+	// CloudCredentialsMaxInFlightAnnotation is an annotation on the cloud
+	// credentials secret which overrides the manager's default maximum number
+	// of concurrent OpenStack API requests for each cloud in the secret. A
+	// value of 0 disables the limit.
+	CloudCredentialsMaxInFlightAnnotation = "openstack.k-orc.cloud/max-inflight-requests"
+
+	// CloudCredentialsStatusAnnotation is an annotation on the cloud
+	// credentials secret which ORC sets to the result of authenticating with
+	// each cloud in the secret it has used. Its value is a JSON object indexed
+	// by cloud name, which is empty for a secret without a clouds.yaml file.
+	// It is written by ORC and should not be modified.
+	CloudCredentialsStatusAnnotation = "openstack.k-orc.cloud/credentials-status"
+
+	// CloudCredentialsApplicationCredentialAnnotation is an annotation on a
+	// cloud credentials secret which ORC sets when it writes the credentials
+	// of an ApplicationCredential to it. Its value is the name of the
+	// ApplicationCredential. ORC will not write the credentials of another
+	// ApplicationCredential to the secret.
+	CloudCredentialsApplicationCredentialAnnotation = "openstack.k-orc.cloud/application-credential"
 )
 
 // CloudCredentialsReference is a reference to a secret containing OpenStack credentials.
+// +kubebuilder:validation:XValidation:rule="has(self.regionName) == has(oldSelf.regionName) && (!has(self.regionName) || self.regionName == oldSelf.regionName)",message="regionName is immutable"
 type CloudCredentialsReference struct {
 	// secretName is the name of a secret in the same namespace as the resource being provisioned.
-	// The secret must contain a key named `clouds.yaml` which contains an OpenStack clouds.yaml file.
+	// The secret must contain either a key named `clouds.yaml` which contains an OpenStack clouds.yaml file,
+	// or the credentials of a single cloud as individual keys, including `auth_url`.
 	// The secret may optionally contain a key named `cacert` containing a PEM-encoded CA certificate.
 	// +required
 	// +kubebuilder:validation:MinLength:=1
@@ -34,10 +142,20 @@ type CloudCredentialsReference struct {
 	SecretName string `json:"secretName,omitempty"`
 
 	// cloudName specifies the name of the entry in the clouds.yaml file to use.
-	// +required
+	// It is required if the secret contains a clouds.yaml file, and is ignored otherwise.
 	// +kubebuilder:validation:MinLength:=1
 	// +kubebuilder:validation:MaxLength:=256
+	// +optional
 	CloudName string `json:"cloudName,omitempty"`
+
+	// regionName is the OpenStack region to use. If specified, it overrides
+	// the region of the cloud in the secret, allowing a single cloud to be
+	// used in multiple regions. It is immutable, as the resource exists in a
+	// single region.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:MaxLength:=255
+	// +optional
+	RegionName string `json:"regionName,omitempty"`
 }
 
 // CloudCredentialsRefProvider is an interface for obtaining OpenStack credentials from an API object
@@ -45,3 +163,13 @@ type CloudCredentialsReference struct {
 type CloudCredentialsRefProvider interface {
 	GetCloudCredentialsRef() (*string, *CloudCredentialsReference)
 }
+
+// OpenStackCloudStatusProvider is an interface for obtaining the OpenStackCloud
+// recorded in the status of an API object which inherits its credentials.
+// +kubebuilder:object:generate:=false
+type OpenStackCloudStatusProvider interface {
+	// GetOpenStackCloudStatus returns the name of the OpenStackCloud
+	// recorded in the object's status, if any, and whether the object has
+	// an OpenStack resource.
+	GetOpenStackCloudStatus() (*string, bool)
+}
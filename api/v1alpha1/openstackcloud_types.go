@@ -0,0 +1,138 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// OpenStackCloudSecretReference is a reference to a secret in any namespace.
+type OpenStackCloudSecretReference struct {
+	// namespace is the namespace of the secret.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:MaxLength:=63
+	// +required
+	Namespace string `json:"namespace,omitempty"`
+
+	// name is the name of the secret.
+	// +required
+	Name KubernetesNameRef `json:"name,omitempty"`
+}
+
+// OpenStackCloudAllowedNamespaces selects namespaces. A namespace is selected
+// if it is listed in names or matched by selector.
+// +kubebuilder:validation:MinProperties:=1
+type OpenStackCloudAllowedNamespaces struct {
+	// names is a list of namespace names.
+	// +kubebuilder:validation:MaxItems:=256
+	// +kubebuilder:validation:items:MinLength:=1
+	// +kubebuilder:validation:items:MaxLength:=63
+	// +listType=set
+	// +optional
+	Names []string `json:"names,omitempty"`
+
+	// selector selects namespaces by their labels.
+	// +optional
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+}
+
+// OpenStackCloudSpec defines the default OpenStack credentials of a set of
+// namespaces.
+// +kubebuilder:validation:XValidation:rule="has(self.regionName) == has(oldSelf.regionName) && (!has(self.regionName) || self.regionName == oldSelf.regionName)",message="regionName is immutable"
+type OpenStackCloudSpec struct {
+	// secretRef references a secret containing OpenStack credentials, in the
+	// same format as a secret referenced by cloudCredentialsRef. The secret may
+	// be in any namespace.
+	// +required
+	SecretRef OpenStackCloudSecretReference `json:"secretRef,omitzero"`
+
+	// cloudName specifies the name of the entry in the clouds.yaml file to use.
+	// It is required if the secret contains a clouds.yaml file, and is ignored
+	// otherwise.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:MaxLength:=256
+	// +optional
+	CloudName string `json:"cloudName,omitempty"`
+
+	// regionName is the OpenStack region to use. If specified, it overrides
+	// any region in the credentials. It is immutable, as resources using
+	// these credentials exist in a single region.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:MaxLength:=255
+	// +optional
+	RegionName string `json:"regionName,omitempty"`
+
+	// caCert is a PEM-encoded CA bundle used to verify the OpenStack API
+	// endpoints. If specified, it overrides any CA bundle in the credentials
+	// secret.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:MaxLength:=262144
+	// +optional
+	CACert string `json:"caCert,omitempty"`
+
+	// allowedNamespaces selects the namespaces whose objects use these
+	// credentials when they do not specify cloudCredentialsRef. At most one
+	// OpenStackCloud may select any namespace.
+	// +required
+	AllowedNamespaces OpenStackCloudAllowedNamespaces `json:"allowedNamespaces,omitzero"`
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,categories=openstack
+// +kubebuilder:printcolumn:name="Secret Namespace",type="string",JSONPath=".spec.secretRef.namespace",description="Namespace of the credentials secret"
+// +kubebuilder:printcolumn:name="Secret",type="string",JSONPath=".spec.secretRef.name",description="Name of the credentials secret"
+// +kubebuilder:printcolumn:name="Cloud",type="string",JSONPath=".spec.cloudName",description="Name of the cloud in clouds.yaml"
+
+// OpenStackCloud defines the default OpenStack credentials of objects in a set
+// of namespaces which do not specify cloudCredentialsRef.
+type OpenStackCloud struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata contains the object metadata.
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec specifies the default credentials.
+	// +required
+	Spec OpenStackCloudSpec `json:"spec,omitzero"`
+}
+
+// +kubebuilder:object:root=true
+
+// OpenStackCloudList contains a list of OpenStackCloud.
+type OpenStackCloudList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata contains the list metadata.
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// items contains a list of OpenStackCloud.
+	// +required
+	Items []OpenStackCloud `json:"items"`
+}
+
+func (l *OpenStackCloudList) GetItems() []OpenStackCloud {
+	return l.Items
+}
+
+func init() {
+	SchemeBuilder.Register(&OpenStackCloud{}, &OpenStackCloudList{})
+}
@@ -18,7 +18,24 @@ package v1alpha1
 
 import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+// +kubebuilder:validation:Enum:=never;on-demand
+type VolumeMigrationPolicy string
+
+const (
+	// VolumeMigrationPolicyNever specifies that a volume will not be
+	// migrated to another backend when its volume type is changed. Changing
+	// the volume type fails if the new volume type cannot be applied to the
+	// volume on its current backend.
+	VolumeMigrationPolicyNever VolumeMigrationPolicy = "never"
+
+	// VolumeMigrationPolicyOnDemand specifies that a volume may be migrated
+	// to another backend if this is required to change its volume type.
+	VolumeMigrationPolicyOnDemand VolumeMigrationPolicy = "on-demand"
+)
+
 // VolumeResourceSpec contains the desired state of the resource.
+// +kubebuilder:validation:XValidation:rule="[has(self.imageRef), has(self.snapshotRef), has(self.sourceVolumeRef), has(self.backupRef)].filter(x, x).size() <= 1",message="only one of imageRef, snapshotRef, sourceVolumeRef or backupRef may be specified"
+// +kubebuilder:validation:XValidation:rule="!has(oldSelf.volumeTypeRef) || has(self.volumeTypeRef)",message="volumeTypeRef may not be removed"
 type VolumeResourceSpec struct {
 	// name will be the name of the created resource. If not specified, the
 	// name of the ORC object will be used.
@@ -31,17 +48,27 @@ type VolumeResourceSpec struct {
 	// +optional
 	Description *string `json:"description,omitempty"`
 
-	// size is the size of the volume, in gibibytes (GiB).
+	// size is the size of the volume, in gibibytes (GiB). The size of an
+	// existing volume may be increased, which extends the volume, but it may
+	// not be decreased. Extending a volume which is attached to a server
+	// requires block storage API microversion 3.42.
 	// +kubebuilder:validation:Minimum=1
 	// +required
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="size is immutable"
+	// +kubebuilder:validation:XValidation:rule="self >= oldSelf",message="size may not be decreased"
 	Size int32 `json:"size,omitempty"`
 
 	// volumeTypeRef is a reference to the ORC VolumeType which this resource is associated with.
+	// Changing the volume type of an existing volume retypes the volume,
+	// subject to migrationPolicy. It may not be removed once set.
 	// +optional
-	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="volumeTypeRef is immutable"
 	VolumeTypeRef *KubernetesNameRef `json:"volumeTypeRef,omitempty"`
 
+	// migrationPolicy specifies whether the volume may be migrated to
+	// another backend when its volume type is changed. If not specified,
+	// the volume will not be migrated.
+	// +optional
+	MigrationPolicy VolumeMigrationPolicy `json:"migrationPolicy,omitempty"`
+
 	// availabilityZone is the availability zone in which to create the volume.
 	// +kubebuilder:validation:MaxLength:=255
 	// +optional
@@ -63,6 +90,27 @@ type VolumeResourceSpec struct {
 	// +optional
 	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="imageRef is immutable"
 	ImageRef *KubernetesNameRef `json:"imageRef,omitempty"`
+
+	// snapshotRef is a reference to an ORC VolumeSnapshot. If specified,
+	// creates the volume from this snapshot. The volume size must be >= the
+	// snapshot's size.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="snapshotRef is immutable"
+	SnapshotRef *KubernetesNameRef `json:"snapshotRef,omitempty"`
+
+	// sourceVolumeRef is a reference to an ORC Volume. If specified, creates
+	// the volume as a clone of this volume. The volume size must be >= the
+	// source volume's size.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="sourceVolumeRef is immutable"
+	SourceVolumeRef *KubernetesNameRef `json:"sourceVolumeRef,omitempty"`
+
+	// backupRef is a reference to an ORC VolumeBackup. If specified,
+	// restores the backup into the new volume. The volume size must be >=
+	// the backup's size.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="backupRef is immutable"
+	BackupRef *KubernetesNameRef `json:"backupRef,omitempty"`
 }
 
 // VolumeFilter defines an existing resource by its properties
@@ -248,3 +296,22 @@ type VolumeMetadataStatus struct {
 	// +optional
 	Value string `json:"value,omitempty"`
 }
+
+type VolumeStatusExtra struct {
+	// lastRetype is the most recent retype requested by the controller. It
+	// is used to detect a retype which Cinder accepted but did not perform.
+	// +optional
+	LastRetype *VolumeRetypeAttempt `json:"lastRetype,omitempty"`
+}
+
+type VolumeRetypeAttempt struct {
+	// volumeType is the name of the volume type the volume was retyped to.
+	// +kubebuilder:validation:MaxLength=1024
+	// +required
+	VolumeType string `json:"volumeType"`
+
+	// generation is the generation of the object when the retype was
+	// requested.
+	// +required
+	Generation int64 `json:"generation"`
+}
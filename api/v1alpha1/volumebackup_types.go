@@ -0,0 +1,167 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+// VolumeBackupResourceSpec contains the desired state of the resource.
+type VolumeBackupResourceSpec struct {
+	// name will be the name of the created resource. If not specified, the
+	// name of the ORC object will be used.
+	// +optional
+	Name *OpenStackName `json:"name,omitempty"`
+
+	// description is a human-readable description for the resource.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:MaxLength:=255
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// volumeRef is a reference to the ORC Volume to back up.
+	// +required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="volumeRef is immutable"
+	VolumeRef KubernetesNameRef `json:"volumeRef,omitempty"`
+
+	// snapshotRef is a reference to an ORC VolumeSnapshot of the volume. If
+	// specified, the backup is created from this snapshot instead of from
+	// the current contents of the volume.
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="snapshotRef is immutable"
+	SnapshotRef *KubernetesNameRef `json:"snapshotRef,omitempty"`
+
+	// incremental creates an incremental backup, containing only the
+	// changes since the most recent backup of the volume. The volume must
+	// already have a full backup.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="incremental is immutable"
+	// +optional
+	Incremental *bool `json:"incremental,omitempty"`
+
+	// container is the name of the container in the backup service in
+	// which to store the backup. If not specified, the backup service's
+	// default container is used.
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:MaxLength:=255
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="container is immutable"
+	// +optional
+	Container *string `json:"container,omitempty"`
+
+	// force allows the backup of a volume which is attached to a server to
+	// be created. The backup of an attached volume may not be consistent.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="force is immutable"
+	// +optional
+	Force *bool `json:"force,omitempty"`
+}
+
+// VolumeBackupFilter defines an existing resource by its properties
+// +kubebuilder:validation:MinProperties:=1
+type VolumeBackupFilter struct {
+	// name of the existing resource
+	// +optional
+	Name *OpenStackName `json:"name,omitempty"`
+
+	// description of the existing resource
+	// +kubebuilder:validation:MinLength:=1
+	// +kubebuilder:validation:MaxLength:=255
+	// +optional
+	Description *string `json:"description,omitempty"`
+
+	// volumeRef is a reference to the ORC Volume of the existing backup.
+	// +optional
+	VolumeRef *KubernetesNameRef `json:"volumeRef,omitempty"`
+}
+
+// VolumeBackupResourceStatus represents the observed state of the resource.
+type VolumeBackupResourceStatus struct {
+	// name is a Human-readable name for the resource. Might not be unique.
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// description is a human-readable description for the resource.
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// volumeID is the ID of the volume from which the backup was created.
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	VolumeID string `json:"volumeID,omitempty"`
+
+	// snapshotID is the ID of the snapshot from which the backup was
+	// created.
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	SnapshotID string `json:"snapshotID,omitempty"`
+
+	// size is the size of the backup in GiB.
+	// +optional
+	Size *int32 `json:"size,omitempty"`
+
+	// objectCount is the number of objects in the backup service which
+	// store the backup.
+	// +optional
+	ObjectCount *int32 `json:"objectCount,omitempty"`
+
+	// status represents the current status of the backup.
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	Status string `json:"status,omitempty"`
+
+	// failReason is the reason the backup failed, if its status is error.
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	FailReason string `json:"failReason,omitempty"`
+
+	// container is the container in the backup service in which the backup
+	// is stored.
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	Container string `json:"container,omitempty"`
+
+	// incremental is whether the backup is incremental.
+	// +optional
+	Incremental *bool `json:"incremental,omitempty"`
+
+	// hasDependentBackups is whether there are incremental backups which
+	// depend on this backup. A backup with dependent backups cannot be
+	// deleted.
+	// +optional
+	HasDependentBackups *bool `json:"hasDependentBackups,omitempty"`
+
+	// availabilityZone is the availability zone of the backup.
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	AvailabilityZone string `json:"availabilityZone,omitempty"`
+
+	// projectID is the ID of the project that owns the backup.
+	// +kubebuilder:validation:MaxLength=1024
+	// +optional
+	ProjectID string `json:"projectID,omitempty"`
+
+	// createdAt shows the date and time when the resource was created. The date and time stamp format is ISO 8601
+	// +optional
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+
+	// updatedAt shows the date and time when the resource was updated. The date and time stamp format is ISO 8601
+	// +optional
+	UpdatedAt *metav1.Time `json:"updatedAt,omitempty"`
+
+	// dataTimestamp shows the date and time of the data in the volume
+	// when the backup was taken. The date and time stamp format is ISO 8601
+	// +optional
+	DataTimestamp *metav1.Time `json:"dataTimestamp,omitempty"`
+}
@@ -83,15 +83,18 @@ type ApplicationCredentialSpec struct {
 	// +optional
 	ResyncPeriod *metav1.Duration `json:"resyncPeriod,omitempty"` //nolint:kubeapilinter // metav1.Duration is appropriate for user-facing duration config
 
-	// cloudCredentialsRef points to a secret containing OpenStack credentials
-	// +required
-	CloudCredentialsRef CloudCredentialsReference `json:"cloudCredentialsRef,omitzero"`
+	// cloudCredentialsRef points to a secret containing OpenStack credentials.
+	// If not specified, the object uses the credentials of the OpenStackCloud
+	// which allows its namespace.
+	// +optional
+	CloudCredentialsRef *CloudCredentialsReference `json:"cloudCredentialsRef,omitempty"`
 }
 
 // ApplicationCredentialStatus defines the observed state of an ORC resource.
 type ApplicationCredentialStatus struct {
 	// conditions represents the observed status of the object.
-	// Known .status.conditions.type are: "Available", "Progressing"
+	// Known .status.conditions.type are: "Available", "Progressing", "Drifted",
+	// "CredentialsFailing"
 	//
 	// Available represents the availability of the OpenStack resource. If it is
 	// true then the resource is ready for use.
@@ -104,6 +107,14 @@ type ApplicationCredentialStatus struct {
 	// Progressing is True, an observer waiting on the resource should continue
 	// to wait.
 	//
+	// Drifted is only present on managed objects whose drift policy is
+	// `report`. If it is True, the OpenStack resource does not match the
+	// desired state and status.drift lists the fields which differ.
+	//
+	// CredentialsFailing is only present while OpenStack rejects the
+	// credentials used to reconcile the object. It is removed once the object
+	// has been reconciled with working credentials.
+	//
 	// +kubebuilder:validation:MaxItems:=32
 	// +patchMergeKey=type
 	// +patchStrategy=merge
@@ -127,6 +138,23 @@ type ApplicationCredentialStatus struct {
 	// API.
 	// +optional
 	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// drift contains the paths of spec fields whose desired value does not
+	// match the observed state of the OpenStack resource. It is only
+	// populated for managed objects whose drift policy is `report`.
+	// +kubebuilder:validation:MaxItems:=64
+	// +kubebuilder:validation:items:MaxLength:=1024
+	// +listType=set
+	// +optional
+	Drift []string `json:"drift,omitempty"`
+
+	// openStackCloudName is the name of the OpenStackCloud whose credentials
+	// are used by the object. It is only set when cloudCredentialsRef is not
+	// specified. Once the object has an OpenStack resource, the object will
+	// not use the credentials of a different OpenStackCloud.
+	// +kubebuilder:validation:MaxLength:=253
+	// +optional
+	OpenStackCloudName *string `json:"openStackCloudName,omitempty"`
 }
 
 var _ ObjectWithConditions = &ApplicationCredential{}
@@ -188,7 +216,17 @@ func (i *ApplicationCredential) GetCloudCredentialsRef() (*string, *CloudCredent
 		return nil, nil
 	}
 
-	return &i.Namespace, &i.Spec.CloudCredentialsRef
+	return &i.Namespace, i.Spec.CloudCredentialsRef
 }
 
 var _ CloudCredentialsRefProvider = &ApplicationCredential{}
+
+func (i *ApplicationCredential) GetOpenStackCloudStatus() (*string, bool) {
+	if i == nil {
+		return nil, false
+	}
+
+	return i.Status.OpenStackCloudName, i.Status.ID != nil
+}
+
+var _ OpenStackCloudStatusProvider = &ApplicationCredential{}
@@ -225,14 +225,18 @@ func (in *AddressScopeSpec) DeepCopyInto(out *AddressScopeSpec) {
 	if in.ManagedOptions != nil {
 		in, out := &in.ManagedOptions, &out.ManagedOptions
 		*out = new(ManagedOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ResyncPeriod != nil {
 		in, out := &in.ResyncPeriod, &out.ResyncPeriod
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	out.CloudCredentialsRef = in.CloudCredentialsRef
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddressScopeSpec.
@@ -269,6 +273,16 @@ func (in *AddressScopeStatus) DeepCopyInto(out *AddressScopeStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddressScopeStatus.
@@ -645,14 +659,18 @@ func (in *ApplicationCredentialSpec) DeepCopyInto(out *ApplicationCredentialSpec
 	if in.ManagedOptions != nil {
 		in, out := &in.ManagedOptions, &out.ManagedOptions
 		*out = new(ManagedOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ResyncPeriod != nil {
 		in, out := &in.ResyncPeriod, &out.ResyncPeriod
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	out.CloudCredentialsRef = in.CloudCredentialsRef
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationCredentialSpec.
@@ -689,6 +707,16 @@ func (in *ApplicationCredentialStatus) DeepCopyInto(out *ApplicationCredentialSt
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationCredentialStatus.
@@ -891,14 +919,18 @@ func (in *DomainSpec) DeepCopyInto(out *DomainSpec) {
 	if in.ManagedOptions != nil {
 		in, out := &in.ManagedOptions, &out.ManagedOptions
 		*out = new(ManagedOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ResyncPeriod != nil {
 		in, out := &in.ResyncPeriod, &out.ResyncPeriod
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	out.CloudCredentialsRef = in.CloudCredentialsRef
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainSpec.
@@ -935,6 +967,16 @@ func (in *DomainStatus) DeepCopyInto(out *DomainStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DomainStatus.
@@ -1112,14 +1154,18 @@ func (in *EndpointSpec) DeepCopyInto(out *EndpointSpec) {
 	if in.ManagedOptions != nil {
 		in, out := &in.ManagedOptions, &out.ManagedOptions
 		*out = new(ManagedOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ResyncPeriod != nil {
 		in, out := &in.ResyncPeriod, &out.ResyncPeriod
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	out.CloudCredentialsRef = in.CloudCredentialsRef
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointSpec.
@@ -1156,6 +1202,16 @@ func (in *EndpointStatus) DeepCopyInto(out *EndpointStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EndpointStatus.
@@ -1568,14 +1624,18 @@ func (in *FlavorSpec) DeepCopyInto(out *FlavorSpec) {
 	if in.ManagedOptions != nil {
 		in, out := &in.ManagedOptions, &out.ManagedOptions
 		*out = new(ManagedOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ResyncPeriod != nil {
 		in, out := &in.ResyncPeriod, &out.ResyncPeriod
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	out.CloudCredentialsRef = in.CloudCredentialsRef
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavorSpec.
@@ -1612,6 +1672,16 @@ func (in *FlavorStatus) DeepCopyInto(out *FlavorStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlavorStatus.
@@ -1841,14 +1911,18 @@ func (in *FloatingIPSpec) DeepCopyInto(out *FloatingIPSpec) {
 	if in.ManagedOptions != nil {
 		in, out := &in.ManagedOptions, &out.ManagedOptions
 		*out = new(ManagedOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ResyncPeriod != nil {
 		in, out := &in.ResyncPeriod, &out.ResyncPeriod
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	out.CloudCredentialsRef = in.CloudCredentialsRef
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FloatingIPSpec.
@@ -1885,6 +1959,16 @@ func (in *FloatingIPStatus) DeepCopyInto(out *FloatingIPStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FloatingIPStatus.
@@ -2067,14 +2151,18 @@ func (in *GroupSpec) DeepCopyInto(out *GroupSpec) {
 	if in.ManagedOptions != nil {
 		in, out := &in.ManagedOptions, &out.ManagedOptions
 		*out = new(ManagedOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ResyncPeriod != nil {
 		in, out := &in.ResyncPeriod, &out.ResyncPeriod
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	out.CloudCredentialsRef = in.CloudCredentialsRef
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupSpec.
@@ -2111,6 +2199,16 @@ func (in *GroupStatus) DeepCopyInto(out *GroupStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GroupStatus.
@@ -2603,14 +2701,18 @@ func (in *ImageSpec) DeepCopyInto(out *ImageSpec) {
 	if in.ManagedOptions != nil {
 		in, out := &in.ManagedOptions, &out.ManagedOptions
 		*out = new(ManagedOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ResyncPeriod != nil {
 		in, out := &in.ResyncPeriod, &out.ResyncPeriod
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	out.CloudCredentialsRef = in.CloudCredentialsRef
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSpec.
@@ -2647,6 +2749,16 @@ func (in *ImageStatus) DeepCopyInto(out *ImageStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
 	in.ImageStatusExtra.DeepCopyInto(&out.ImageStatusExtra)
 }
 
@@ -2840,14 +2952,18 @@ func (in *KeyPairSpec) DeepCopyInto(out *KeyPairSpec) {
 	if in.ManagedOptions != nil {
 		in, out := &in.ManagedOptions, &out.ManagedOptions
 		*out = new(ManagedOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ResyncPeriod != nil {
 		in, out := &in.ResyncPeriod, &out.ResyncPeriod
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	out.CloudCredentialsRef = in.CloudCredentialsRef
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairSpec.
@@ -2884,6 +3000,16 @@ func (in *KeyPairStatus) DeepCopyInto(out *KeyPairStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyPairStatus.
@@ -2899,6 +3025,11 @@ func (in *KeyPairStatus) DeepCopy() *KeyPairStatus {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ManagedOptions) DeepCopyInto(out *ManagedOptions) {
 	*out = *in
+	if in.DeletionProtection != nil {
+		in, out := &in.DeletionProtection, &out.DeletionProtection
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedOptions.
@@ -3178,14 +3309,18 @@ func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 	if in.ManagedOptions != nil {
 		in, out := &in.ManagedOptions, &out.ManagedOptions
 		*out = new(ManagedOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ResyncPeriod != nil {
 		in, out := &in.ResyncPeriod, &out.ResyncPeriod
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	out.CloudCredentialsRef = in.CloudCredentialsRef
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSpec.
@@ -3222,6 +3357,16 @@ func (in *NetworkStatus) DeepCopyInto(out *NetworkStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkStatus.
@@ -3262,6 +3407,121 @@ func (in *NeutronStatusMetadata) DeepCopy() *NeutronStatusMetadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackCloud) DeepCopyInto(out *OpenStackCloud) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackCloud.
+func (in *OpenStackCloud) DeepCopy() *OpenStackCloud {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackCloud)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackCloud) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackCloudAllowedNamespaces) DeepCopyInto(out *OpenStackCloudAllowedNamespaces) {
+	*out = *in
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackCloudAllowedNamespaces.
+func (in *OpenStackCloudAllowedNamespaces) DeepCopy() *OpenStackCloudAllowedNamespaces {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackCloudAllowedNamespaces)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackCloudList) DeepCopyInto(out *OpenStackCloudList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]OpenStackCloud, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackCloudList.
+func (in *OpenStackCloudList) DeepCopy() *OpenStackCloudList {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackCloudList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *OpenStackCloudList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackCloudSecretReference) DeepCopyInto(out *OpenStackCloudSecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackCloudSecretReference.
+func (in *OpenStackCloudSecretReference) DeepCopy() *OpenStackCloudSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackCloudSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OpenStackCloudSpec) DeepCopyInto(out *OpenStackCloudSpec) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+	in.AllowedNamespaces.DeepCopyInto(&out.AllowedNamespaces)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OpenStackCloudSpec.
+func (in *OpenStackCloudSpec) DeepCopy() *OpenStackCloudSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(OpenStackCloudSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Port) DeepCopyInto(out *Port) {
 	*out = *in
@@ -3565,14 +3825,18 @@ func (in *PortSpec) DeepCopyInto(out *PortSpec) {
 	if in.ManagedOptions != nil {
 		in, out := &in.ManagedOptions, &out.ManagedOptions
 		*out = new(ManagedOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ResyncPeriod != nil {
 		in, out := &in.ResyncPeriod, &out.ResyncPeriod
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	out.CloudCredentialsRef = in.CloudCredentialsRef
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortSpec.
@@ -3609,6 +3873,16 @@ func (in *PortStatus) DeepCopyInto(out *PortStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PortStatus.
@@ -3832,14 +4106,18 @@ func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 	if in.ManagedOptions != nil {
 		in, out := &in.ManagedOptions, &out.ManagedOptions
 		*out = new(ManagedOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ResyncPeriod != nil {
 		in, out := &in.ResyncPeriod, &out.ResyncPeriod
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	out.CloudCredentialsRef = in.CloudCredentialsRef
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectSpec.
@@ -3876,6 +4154,16 @@ func (in *ProjectStatus) DeepCopyInto(out *ProjectStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectStatus.
@@ -4120,14 +4408,18 @@ func (in *RoleAssignmentSpec) DeepCopyInto(out *RoleAssignmentSpec) {
 	if in.ManagedOptions != nil {
 		in, out := &in.ManagedOptions, &out.ManagedOptions
 		*out = new(ManagedOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ResyncPeriod != nil {
 		in, out := &in.ResyncPeriod, &out.ResyncPeriod
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	out.CloudCredentialsRef = in.CloudCredentialsRef
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleAssignmentSpec.
@@ -4159,6 +4451,16 @@ func (in *RoleAssignmentStatus) DeepCopyInto(out *RoleAssignmentStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleAssignmentStatus.
@@ -4314,14 +4616,18 @@ func (in *RoleSpec) DeepCopyInto(out *RoleSpec) {
 	if in.ManagedOptions != nil {
 		in, out := &in.ManagedOptions, &out.ManagedOptions
 		*out = new(ManagedOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ResyncPeriod != nil {
 		in, out := &in.ResyncPeriod, &out.ResyncPeriod
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	out.CloudCredentialsRef = in.CloudCredentialsRef
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleSpec.
@@ -4358,6 +4664,16 @@ func (in *RoleStatus) DeepCopyInto(out *RoleStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RoleStatus.
@@ -4706,14 +5022,18 @@ func (in *RouterSpec) DeepCopyInto(out *RouterSpec) {
 	if in.ManagedOptions != nil {
 		in, out := &in.ManagedOptions, &out.ManagedOptions
 		*out = new(ManagedOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ResyncPeriod != nil {
 		in, out := &in.ResyncPeriod, &out.ResyncPeriod
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	out.CloudCredentialsRef = in.CloudCredentialsRef
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterSpec.
@@ -4750,6 +5070,16 @@ func (in *RouterStatus) DeepCopyInto(out *RouterStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouterStatus.
@@ -5028,14 +5358,18 @@ func (in *SecurityGroupSpec) DeepCopyInto(out *SecurityGroupSpec) {
 	if in.ManagedOptions != nil {
 		in, out := &in.ManagedOptions, &out.ManagedOptions
 		*out = new(ManagedOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ResyncPeriod != nil {
 		in, out := &in.ResyncPeriod, &out.ResyncPeriod
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	out.CloudCredentialsRef = in.CloudCredentialsRef
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupSpec.
@@ -5072,6 +5406,16 @@ func (in *SecurityGroupStatus) DeepCopyInto(out *SecurityGroupStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupStatus.
@@ -5352,14 +5696,18 @@ func (in *ServerGroupSpec) DeepCopyInto(out *ServerGroupSpec) {
 	if in.ManagedOptions != nil {
 		in, out := &in.ManagedOptions, &out.ManagedOptions
 		*out = new(ManagedOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ResyncPeriod != nil {
 		in, out := &in.ResyncPeriod, &out.ResyncPeriod
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	out.CloudCredentialsRef = in.CloudCredentialsRef
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerGroupSpec.
@@ -5396,10 +5744,20 @@ func (in *ServerGroupStatus) DeepCopyInto(out *ServerGroupStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerGroupStatus.
-func (in *ServerGroupStatus) DeepCopy() *ServerGroupStatus {
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerGroupStatus.
+func (in *ServerGroupStatus) DeepCopy() *ServerGroupStatus {
 	if in == nil {
 		return nil
 	}
@@ -5729,14 +6087,18 @@ func (in *ServerSpec) DeepCopyInto(out *ServerSpec) {
 	if in.ManagedOptions != nil {
 		in, out := &in.ManagedOptions, &out.ManagedOptions
 		*out = new(ManagedOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ResyncPeriod != nil {
 		in, out := &in.ResyncPeriod, &out.ResyncPeriod
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	out.CloudCredentialsRef = in.CloudCredentialsRef
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerSpec.
@@ -5773,6 +6135,16 @@ func (in *ServerStatus) DeepCopyInto(out *ServerStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServerStatus.
@@ -5995,14 +6367,18 @@ func (in *ServiceSpec) DeepCopyInto(out *ServiceSpec) {
 	if in.ManagedOptions != nil {
 		in, out := &in.ManagedOptions, &out.ManagedOptions
 		*out = new(ManagedOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ResyncPeriod != nil {
 		in, out := &in.ResyncPeriod, &out.ResyncPeriod
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	out.CloudCredentialsRef = in.CloudCredentialsRef
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceSpec.
@@ -6039,6 +6415,16 @@ func (in *ServiceStatus) DeepCopyInto(out *ServiceStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceStatus.
@@ -6244,14 +6630,18 @@ func (in *ShareNetworkSpec) DeepCopyInto(out *ShareNetworkSpec) {
 	if in.ManagedOptions != nil {
 		in, out := &in.ManagedOptions, &out.ManagedOptions
 		*out = new(ManagedOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ResyncPeriod != nil {
 		in, out := &in.ResyncPeriod, &out.ResyncPeriod
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	out.CloudCredentialsRef = in.CloudCredentialsRef
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShareNetworkSpec.
@@ -6288,6 +6678,16 @@ func (in *ShareNetworkStatus) DeepCopyInto(out *ShareNetworkStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ShareNetworkStatus.
@@ -6597,14 +6997,18 @@ func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
 	if in.ManagedOptions != nil {
 		in, out := &in.ManagedOptions, &out.ManagedOptions
 		*out = new(ManagedOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ResyncPeriod != nil {
 		in, out := &in.ResyncPeriod, &out.ResyncPeriod
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	out.CloudCredentialsRef = in.CloudCredentialsRef
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetSpec.
@@ -6641,6 +7045,16 @@ func (in *SubnetStatus) DeepCopyInto(out *SubnetStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetStatus.
@@ -6870,14 +7284,18 @@ func (in *TrunkSpec) DeepCopyInto(out *TrunkSpec) {
 	if in.ManagedOptions != nil {
 		in, out := &in.ManagedOptions, &out.ManagedOptions
 		*out = new(ManagedOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ResyncPeriod != nil {
 		in, out := &in.ResyncPeriod, &out.ResyncPeriod
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	out.CloudCredentialsRef = in.CloudCredentialsRef
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrunkSpec.
@@ -6914,6 +7332,16 @@ func (in *TrunkStatus) DeepCopyInto(out *TrunkStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrunkStatus.
@@ -7161,14 +7589,18 @@ func (in *UserSpec) DeepCopyInto(out *UserSpec) {
 	if in.ManagedOptions != nil {
 		in, out := &in.ManagedOptions, &out.ManagedOptions
 		*out = new(ManagedOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ResyncPeriod != nil {
 		in, out := &in.ResyncPeriod, &out.ResyncPeriod
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	out.CloudCredentialsRef = in.CloudCredentialsRef
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserSpec.
@@ -7205,6 +7637,16 @@ func (in *UserStatus) DeepCopyInto(out *UserStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UserStatus.
@@ -7264,7 +7706,34 @@ func (in *VolumeAttachmentStatus) DeepCopy() *VolumeAttachmentStatus {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VolumeFilter) DeepCopyInto(out *VolumeFilter) {
+func (in *VolumeBackup) DeepCopyInto(out *VolumeBackup) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeBackup.
+func (in *VolumeBackup) DeepCopy() *VolumeBackup {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeBackup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeBackup) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeBackupFilter) DeepCopyInto(out *VolumeBackupFilter) {
 	*out = *in
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
@@ -7276,25 +7745,25 @@ func (in *VolumeFilter) DeepCopyInto(out *VolumeFilter) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.Size != nil {
-		in, out := &in.Size, &out.Size
-		*out = new(int32)
+	if in.VolumeRef != nil {
+		in, out := &in.VolumeRef, &out.VolumeRef
+		*out = new(KubernetesNameRef)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeFilter.
-func (in *VolumeFilter) DeepCopy() *VolumeFilter {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeBackupFilter.
+func (in *VolumeBackupFilter) DeepCopy() *VolumeBackupFilter {
 	if in == nil {
 		return nil
 	}
-	out := new(VolumeFilter)
+	out := new(VolumeBackupFilter)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VolumeImport) DeepCopyInto(out *VolumeImport) {
+func (in *VolumeBackupImport) DeepCopyInto(out *VolumeBackupImport) {
 	*out = *in
 	if in.ID != nil {
 		in, out := &in.ID, &out.ID
@@ -7303,47 +7772,47 @@ func (in *VolumeImport) DeepCopyInto(out *VolumeImport) {
 	}
 	if in.Filter != nil {
 		in, out := &in.Filter, &out.Filter
-		*out = new(VolumeFilter)
+		*out = new(VolumeBackupFilter)
 		(*in).DeepCopyInto(*out)
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeImport.
-func (in *VolumeImport) DeepCopy() *VolumeImport {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeBackupImport.
+func (in *VolumeBackupImport) DeepCopy() *VolumeBackupImport {
 	if in == nil {
 		return nil
 	}
-	out := new(VolumeImport)
+	out := new(VolumeBackupImport)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VolumeList) DeepCopyInto(out *VolumeList) {
+func (in *VolumeBackupList) DeepCopyInto(out *VolumeBackupList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]Volume, len(*in))
+		*out = make([]VolumeBackup, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeList.
-func (in *VolumeList) DeepCopy() *VolumeList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeBackupList.
+func (in *VolumeBackupList) DeepCopy() *VolumeBackupList {
 	if in == nil {
 		return nil
 	}
-	out := new(VolumeList)
+	out := new(VolumeBackupList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VolumeList) DeepCopyObject() runtime.Object {
+func (in *VolumeBackupList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -7351,37 +7820,7 @@ func (in *VolumeList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VolumeMetadata) DeepCopyInto(out *VolumeMetadata) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeMetadata.
-func (in *VolumeMetadata) DeepCopy() *VolumeMetadata {
-	if in == nil {
-		return nil
-	}
-	out := new(VolumeMetadata)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VolumeMetadataStatus) DeepCopyInto(out *VolumeMetadataStatus) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeMetadataStatus.
-func (in *VolumeMetadataStatus) DeepCopy() *VolumeMetadataStatus {
-	if in == nil {
-		return nil
-	}
-	out := new(VolumeMetadataStatus)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VolumeResourceSpec) DeepCopyInto(out *VolumeResourceSpec) {
+func (in *VolumeBackupResourceSpec) DeepCopyInto(out *VolumeBackupResourceSpec) {
 	*out = *in
 	if in.Name != nil {
 		in, out := &in.Name, &out.Name
@@ -7393,65 +7832,58 @@ func (in *VolumeResourceSpec) DeepCopyInto(out *VolumeResourceSpec) {
 		*out = new(string)
 		**out = **in
 	}
-	if in.VolumeTypeRef != nil {
-		in, out := &in.VolumeTypeRef, &out.VolumeTypeRef
+	if in.SnapshotRef != nil {
+		in, out := &in.SnapshotRef, &out.SnapshotRef
 		*out = new(KubernetesNameRef)
 		**out = **in
 	}
-	if in.Metadata != nil {
-		in, out := &in.Metadata, &out.Metadata
-		*out = make([]VolumeMetadata, len(*in))
-		copy(*out, *in)
+	if in.Incremental != nil {
+		in, out := &in.Incremental, &out.Incremental
+		*out = new(bool)
+		**out = **in
 	}
-	if in.ImageRef != nil {
-		in, out := &in.ImageRef, &out.ImageRef
-		*out = new(KubernetesNameRef)
+	if in.Container != nil {
+		in, out := &in.Container, &out.Container
+		*out = new(string)
+		**out = **in
+	}
+	if in.Force != nil {
+		in, out := &in.Force, &out.Force
+		*out = new(bool)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeResourceSpec.
-func (in *VolumeResourceSpec) DeepCopy() *VolumeResourceSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeBackupResourceSpec.
+func (in *VolumeBackupResourceSpec) DeepCopy() *VolumeBackupResourceSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VolumeResourceSpec)
+	out := new(VolumeBackupResourceSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VolumeResourceStatus) DeepCopyInto(out *VolumeResourceStatus) {
+func (in *VolumeBackupResourceStatus) DeepCopyInto(out *VolumeBackupResourceStatus) {
 	*out = *in
 	if in.Size != nil {
 		in, out := &in.Size, &out.Size
 		*out = new(int32)
 		**out = **in
 	}
-	if in.Attachments != nil {
-		in, out := &in.Attachments, &out.Attachments
-		*out = make([]VolumeAttachmentStatus, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.Metadata != nil {
-		in, out := &in.Metadata, &out.Metadata
-		*out = make([]VolumeMetadataStatus, len(*in))
-		copy(*out, *in)
-	}
-	if in.Bootable != nil {
-		in, out := &in.Bootable, &out.Bootable
-		*out = new(bool)
+	if in.ObjectCount != nil {
+		in, out := &in.ObjectCount, &out.ObjectCount
+		*out = new(int32)
 		**out = **in
 	}
-	if in.Encrypted != nil {
-		in, out := &in.Encrypted, &out.Encrypted
+	if in.Incremental != nil {
+		in, out := &in.Incremental, &out.Incremental
 		*out = new(bool)
 		**out = **in
 	}
-	if in.Multiattach != nil {
-		in, out := &in.Multiattach, &out.Multiattach
+	if in.HasDependentBackups != nil {
+		in, out := &in.HasDependentBackups, &out.HasDependentBackups
 		*out = new(bool)
 		**out = **in
 	}
@@ -7463,56 +7895,64 @@ func (in *VolumeResourceStatus) DeepCopyInto(out *VolumeResourceStatus) {
 		in, out := &in.UpdatedAt, &out.UpdatedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.DataTimestamp != nil {
+		in, out := &in.DataTimestamp, &out.DataTimestamp
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeResourceStatus.
-func (in *VolumeResourceStatus) DeepCopy() *VolumeResourceStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeBackupResourceStatus.
+func (in *VolumeBackupResourceStatus) DeepCopy() *VolumeBackupResourceStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VolumeResourceStatus)
+	out := new(VolumeBackupResourceStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VolumeSpec) DeepCopyInto(out *VolumeSpec) {
+func (in *VolumeBackupSpec) DeepCopyInto(out *VolumeBackupSpec) {
 	*out = *in
 	if in.Import != nil {
 		in, out := &in.Import, &out.Import
-		*out = new(VolumeImport)
+		*out = new(VolumeBackupImport)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Resource != nil {
 		in, out := &in.Resource, &out.Resource
-		*out = new(VolumeResourceSpec)
+		*out = new(VolumeBackupResourceSpec)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.ManagedOptions != nil {
 		in, out := &in.ManagedOptions, &out.ManagedOptions
 		*out = new(ManagedOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ResyncPeriod != nil {
 		in, out := &in.ResyncPeriod, &out.ResyncPeriod
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	out.CloudCredentialsRef = in.CloudCredentialsRef
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSpec.
-func (in *VolumeSpec) DeepCopy() *VolumeSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeBackupSpec.
+func (in *VolumeBackupSpec) DeepCopy() *VolumeBackupSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(VolumeSpec)
+	out := new(VolumeBackupSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VolumeStatus) DeepCopyInto(out *VolumeStatus) {
+func (in *VolumeBackupStatus) DeepCopyInto(out *VolumeBackupStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
@@ -7528,71 +7968,728 @@ func (in *VolumeStatus) DeepCopyInto(out *VolumeStatus) {
 	}
 	if in.Resource != nil {
 		in, out := &in.Resource, &out.Resource
-		*out = new(VolumeResourceStatus)
+		*out = new(VolumeBackupResourceStatus)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.LastSyncTime != nil {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeStatus.
-func (in *VolumeStatus) DeepCopy() *VolumeStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeBackupStatus.
+func (in *VolumeBackupStatus) DeepCopy() *VolumeBackupStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(VolumeStatus)
+	out := new(VolumeBackupStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VolumeType) DeepCopyInto(out *VolumeType) {
+func (in *VolumeFilter) DeepCopyInto(out *VolumeFilter) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
-	in.Status.DeepCopyInto(&out.Status)
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeType.
-func (in *VolumeType) DeepCopy() *VolumeType {
-	if in == nil {
-		return nil
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(OpenStackName)
+		**out = **in
 	}
-	out := new(VolumeType)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *VolumeType) DeepCopyObject() runtime.Object {
-	if c := in.DeepCopy(); c != nil {
-		return c
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(int32)
+		**out = **in
 	}
-	return nil
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VolumeTypeExtraSpec) DeepCopyInto(out *VolumeTypeExtraSpec) {
-	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeTypeExtraSpec.
-func (in *VolumeTypeExtraSpec) DeepCopy() *VolumeTypeExtraSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeFilter.
+func (in *VolumeFilter) DeepCopy() *VolumeFilter {
 	if in == nil {
 		return nil
 	}
-	out := new(VolumeTypeExtraSpec)
+	out := new(VolumeFilter)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VolumeTypeExtraSpecStatus) DeepCopyInto(out *VolumeTypeExtraSpecStatus) {
+func (in *VolumeImport) DeepCopyInto(out *VolumeImport) {
 	*out = *in
-}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(VolumeFilter)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeImport.
+func (in *VolumeImport) DeepCopy() *VolumeImport {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeList) DeepCopyInto(out *VolumeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Volume, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeList.
+func (in *VolumeList) DeepCopy() *VolumeList {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeMetadata) DeepCopyInto(out *VolumeMetadata) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeMetadata.
+func (in *VolumeMetadata) DeepCopy() *VolumeMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeMetadataStatus) DeepCopyInto(out *VolumeMetadataStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeMetadataStatus.
+func (in *VolumeMetadataStatus) DeepCopy() *VolumeMetadataStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeMetadataStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeResourceSpec) DeepCopyInto(out *VolumeResourceSpec) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(OpenStackName)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeTypeRef != nil {
+		in, out := &in.VolumeTypeRef, &out.VolumeTypeRef
+		*out = new(KubernetesNameRef)
+		**out = **in
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make([]VolumeMetadata, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImageRef != nil {
+		in, out := &in.ImageRef, &out.ImageRef
+		*out = new(KubernetesNameRef)
+		**out = **in
+	}
+	if in.SnapshotRef != nil {
+		in, out := &in.SnapshotRef, &out.SnapshotRef
+		*out = new(KubernetesNameRef)
+		**out = **in
+	}
+	if in.SourceVolumeRef != nil {
+		in, out := &in.SourceVolumeRef, &out.SourceVolumeRef
+		*out = new(KubernetesNameRef)
+		**out = **in
+	}
+	if in.BackupRef != nil {
+		in, out := &in.BackupRef, &out.BackupRef
+		*out = new(KubernetesNameRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeResourceSpec.
+func (in *VolumeResourceSpec) DeepCopy() *VolumeResourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeResourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeResourceStatus) DeepCopyInto(out *VolumeResourceStatus) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Attachments != nil {
+		in, out := &in.Attachments, &out.Attachments
+		*out = make([]VolumeAttachmentStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make([]VolumeMetadataStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.Bootable != nil {
+		in, out := &in.Bootable, &out.Bootable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Encrypted != nil {
+		in, out := &in.Encrypted, &out.Encrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Multiattach != nil {
+		in, out := &in.Multiattach, &out.Multiattach
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeResourceStatus.
+func (in *VolumeResourceStatus) DeepCopy() *VolumeResourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeResourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeRetypeAttempt) DeepCopyInto(out *VolumeRetypeAttempt) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeRetypeAttempt.
+func (in *VolumeRetypeAttempt) DeepCopy() *VolumeRetypeAttempt {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeRetypeAttempt)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshot) DeepCopyInto(out *VolumeSnapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshot.
+func (in *VolumeSnapshot) DeepCopy() *VolumeSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotFilter) DeepCopyInto(out *VolumeSnapshotFilter) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(OpenStackName)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.VolumeRef != nil {
+		in, out := &in.VolumeRef, &out.VolumeRef
+		*out = new(KubernetesNameRef)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotFilter.
+func (in *VolumeSnapshotFilter) DeepCopy() *VolumeSnapshotFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotImport) DeepCopyInto(out *VolumeSnapshotImport) {
+	*out = *in
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Filter != nil {
+		in, out := &in.Filter, &out.Filter
+		*out = new(VolumeSnapshotFilter)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotImport.
+func (in *VolumeSnapshotImport) DeepCopy() *VolumeSnapshotImport {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotImport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotList) DeepCopyInto(out *VolumeSnapshotList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VolumeSnapshot, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotList.
+func (in *VolumeSnapshotList) DeepCopy() *VolumeSnapshotList {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeSnapshotList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotMetadata) DeepCopyInto(out *VolumeSnapshotMetadata) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotMetadata.
+func (in *VolumeSnapshotMetadata) DeepCopy() *VolumeSnapshotMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotMetadataStatus) DeepCopyInto(out *VolumeSnapshotMetadataStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotMetadataStatus.
+func (in *VolumeSnapshotMetadataStatus) DeepCopy() *VolumeSnapshotMetadataStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotMetadataStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotResourceSpec) DeepCopyInto(out *VolumeSnapshotResourceSpec) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(OpenStackName)
+		**out = **in
+	}
+	if in.Description != nil {
+		in, out := &in.Description, &out.Description
+		*out = new(string)
+		**out = **in
+	}
+	if in.Force != nil {
+		in, out := &in.Force, &out.Force
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make([]VolumeSnapshotMetadata, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotResourceSpec.
+func (in *VolumeSnapshotResourceSpec) DeepCopy() *VolumeSnapshotResourceSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotResourceSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotResourceStatus) DeepCopyInto(out *VolumeSnapshotResourceStatus) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make([]VolumeSnapshotMetadataStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.UpdatedAt != nil {
+		in, out := &in.UpdatedAt, &out.UpdatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotResourceStatus.
+func (in *VolumeSnapshotResourceStatus) DeepCopy() *VolumeSnapshotResourceStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotResourceStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotSpec) DeepCopyInto(out *VolumeSnapshotSpec) {
+	*out = *in
+	if in.Import != nil {
+		in, out := &in.Import, &out.Import
+		*out = new(VolumeSnapshotImport)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resource != nil {
+		in, out := &in.Resource, &out.Resource
+		*out = new(VolumeSnapshotResourceSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ManagedOptions != nil {
+		in, out := &in.ManagedOptions, &out.ManagedOptions
+		*out = new(ManagedOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResyncPeriod != nil {
+		in, out := &in.ResyncPeriod, &out.ResyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotSpec.
+func (in *VolumeSnapshotSpec) DeepCopy() *VolumeSnapshotSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotStatus) DeepCopyInto(out *VolumeSnapshotStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Resource != nil {
+		in, out := &in.Resource, &out.Resource
+		*out = new(VolumeSnapshotResourceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotStatus.
+func (in *VolumeSnapshotStatus) DeepCopy() *VolumeSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSpec) DeepCopyInto(out *VolumeSpec) {
+	*out = *in
+	if in.Import != nil {
+		in, out := &in.Import, &out.Import
+		*out = new(VolumeImport)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resource != nil {
+		in, out := &in.Resource, &out.Resource
+		*out = new(VolumeResourceSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ManagedOptions != nil {
+		in, out := &in.ManagedOptions, &out.ManagedOptions
+		*out = new(ManagedOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResyncPeriod != nil {
+		in, out := &in.ResyncPeriod, &out.ResyncPeriod
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSpec.
+func (in *VolumeSpec) DeepCopy() *VolumeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeStatus) DeepCopyInto(out *VolumeStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ID != nil {
+		in, out := &in.ID, &out.ID
+		*out = new(string)
+		**out = **in
+	}
+	if in.Resource != nil {
+		in, out := &in.Resource, &out.Resource
+		*out = new(VolumeResourceStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.LastSyncTime != nil {
+		in, out := &in.LastSyncTime, &out.LastSyncTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
+	in.VolumeStatusExtra.DeepCopyInto(&out.VolumeStatusExtra)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeStatus.
+func (in *VolumeStatus) DeepCopy() *VolumeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeStatusExtra) DeepCopyInto(out *VolumeStatusExtra) {
+	*out = *in
+	if in.LastRetype != nil {
+		in, out := &in.LastRetype, &out.LastRetype
+		*out = new(VolumeRetypeAttempt)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeStatusExtra.
+func (in *VolumeStatusExtra) DeepCopy() *VolumeStatusExtra {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeStatusExtra)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeType) DeepCopyInto(out *VolumeType) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeType.
+func (in *VolumeType) DeepCopy() *VolumeType {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeType)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VolumeType) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeTypeExtraSpec) DeepCopyInto(out *VolumeTypeExtraSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeTypeExtraSpec.
+func (in *VolumeTypeExtraSpec) DeepCopy() *VolumeTypeExtraSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeTypeExtraSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeTypeExtraSpecStatus) DeepCopyInto(out *VolumeTypeExtraSpecStatus) {
+	*out = *in
+}
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeTypeExtraSpecStatus.
 func (in *VolumeTypeExtraSpecStatus) DeepCopy() *VolumeTypeExtraSpecStatus {
@@ -7767,14 +8864,18 @@ func (in *VolumeTypeSpec) DeepCopyInto(out *VolumeTypeSpec) {
 	if in.ManagedOptions != nil {
 		in, out := &in.ManagedOptions, &out.ManagedOptions
 		*out = new(ManagedOptions)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.ResyncPeriod != nil {
 		in, out := &in.ResyncPeriod, &out.ResyncPeriod
 		*out = new(v1.Duration)
 		**out = **in
 	}
-	out.CloudCredentialsRef = in.CloudCredentialsRef
+	if in.CloudCredentialsRef != nil {
+		in, out := &in.CloudCredentialsRef, &out.CloudCredentialsRef
+		*out = new(CloudCredentialsReference)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeTypeSpec.
@@ -7811,6 +8912,16 @@ func (in *VolumeTypeStatus) DeepCopyInto(out *VolumeTypeStatus) {
 		in, out := &in.LastSyncTime, &out.LastSyncTime
 		*out = (*in).DeepCopy()
 	}
+	if in.Drift != nil {
+		in, out := &in.Drift, &out.Drift
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.OpenStackCloudName != nil {
+		in, out := &in.OpenStackCloudName, &out.OpenStackCloudName
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeTypeStatus.
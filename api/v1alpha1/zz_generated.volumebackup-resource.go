@@ -0,0 +1,232 @@
+// Code generated by resource-generator. DO NOT EDIT.
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// VolumeBackupImport specifies an existing resource which will be imported instead of
+// creating a new one
+// +kubebuilder:validation:MinProperties:=1
+// +kubebuilder:validation:MaxProperties:=1
+type VolumeBackupImport struct {
+	// id contains the unique identifier of an existing OpenStack resource. Note
+	// that when specifying an import by ID, the resource MUST already exist.
+	// The ORC object will enter an error state if the resource does not exist.
+	// +kubebuilder:validation:Format:=uuid
+	// +kubebuilder:validation:MaxLength:=36
+	// +optional
+	ID *string `json:"id,omitempty"` //nolint:kubeapilinter
+
+	// filter contains a resource query which is expected to return a single
+	// result. The controller will continue to retry if filter returns no
+	// results. If filter returns multiple results the controller will set an
+	// error state and will not continue to retry.
+	// +optional
+	Filter *VolumeBackupFilter `json:"filter,omitempty"`
+}
+
+// VolumeBackupSpec defines the desired state of an ORC object.
+// +kubebuilder:validation:XValidation:rule="self.managementPolicy == 'managed' ? has(self.resource) : true",message="resource must be specified when policy is managed"
+// +kubebuilder:validation:XValidation:rule="self.managementPolicy == 'managed' ? !has(self.__import__) : true",message="import may not be specified when policy is managed"
+// +kubebuilder:validation:XValidation:rule="self.managementPolicy == 'unmanaged' ? !has(self.resource) : true",message="resource may not be specified when policy is unmanaged"
+// +kubebuilder:validation:XValidation:rule="self.managementPolicy == 'unmanaged' ? has(self.__import__) : true",message="import must be specified when policy is unmanaged"
+// +kubebuilder:validation:XValidation:rule="has(self.managedOptions) ? self.managementPolicy == 'managed' : true",message="managedOptions may only be provided when policy is managed"
+type VolumeBackupSpec struct {
+	// import refers to an existing OpenStack resource which will be imported instead of
+	// creating a new one.
+	// +optional
+	Import *VolumeBackupImport `json:"import,omitempty"`
+
+	// resource specifies the desired state of the resource.
+	//
+	// resource may not be specified if the management policy is `unmanaged`.
+	//
+	// resource must be specified if the management policy is `managed`.
+	// +optional
+	Resource *VolumeBackupResourceSpec `json:"resource,omitempty"`
+
+	// managementPolicy defines how ORC will treat the object. Valid values are
+	// `managed`: ORC will create, update, and delete the resource; `unmanaged`:
+	// ORC will import an existing resource, and will not apply updates to it or
+	// delete it.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="managementPolicy is immutable"
+	// +kubebuilder:default:=managed
+	// +optional
+	ManagementPolicy ManagementPolicy `json:"managementPolicy,omitempty"`
+
+	// managedOptions specifies options which may be applied to managed objects.
+	// +optional
+	ManagedOptions *ManagedOptions `json:"managedOptions,omitempty"`
+
+	// resyncPeriod defines how frequently the controller will re-reconcile
+	// this resource even when no changes have been detected. This overrides
+	// the global default resync period. The value must be a valid Go duration
+	// string, e.g. "10m", "1h". Set to "0s" to disable periodic resync for
+	// this resource. Very low values may cause excessive OpenStack API load.
+	// +optional
+	ResyncPeriod *metav1.Duration `json:"resyncPeriod,omitempty"` //nolint:kubeapilinter // metav1.Duration is appropriate for user-facing duration config
+
+	// cloudCredentialsRef points to a secret containing OpenStack credentials.
+	// If not specified, the object uses the credentials of the OpenStackCloud
+	// which allows its namespace.
+	// +optional
+	CloudCredentialsRef *CloudCredentialsReference `json:"cloudCredentialsRef,omitempty"`
+}
+
+// VolumeBackupStatus defines the observed state of an ORC resource.
+type VolumeBackupStatus struct {
+	// conditions represents the observed status of the object.
+	// Known .status.conditions.type are: "Available", "Progressing", "Drifted",
+	// "CredentialsFailing"
+	//
+	// Available represents the availability of the OpenStack resource. If it is
+	// true then the resource is ready for use.
+	//
+	// Progressing indicates whether the controller is still attempting to
+	// reconcile the current state of the OpenStack resource to the desired
+	// state. Progressing will be False either because the desired state has
+	// been achieved, or because some terminal error prevents it from ever being
+	// achieved and the controller is no longer attempting to reconcile. If
+	// Progressing is True, an observer waiting on the resource should continue
+	// to wait.
+	//
+	// Drifted is only present on managed objects whose drift policy is
+	// `report`. If it is True, the OpenStack resource does not match the
+	// desired state and status.drift lists the fields which differ.
+	//
+	// CredentialsFailing is only present while OpenStack rejects the
+	// credentials used to reconcile the object. It is removed once the object
+	// has been reconciled with working credentials.
+	//
+	// +kubebuilder:validation:MaxItems:=32
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// id is the unique identifier of the OpenStack resource.
+	// +kubebuilder:validation:MaxLength:=1024
+	// +optional
+	ID *string `json:"id,omitempty"`
+
+	// resource contains the observed state of the OpenStack resource.
+	// +optional
+	Resource *VolumeBackupResourceStatus `json:"resource,omitempty"`
+
+	// lastSyncTime is the timestamp of the last successful reconciliation
+	// that fetched state from OpenStack. It is updated each time the
+	// controller successfully reads the resource state from the OpenStack
+	// API.
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+
+	// drift contains the paths of spec fields whose desired value does not
+	// match the observed state of the OpenStack resource. It is only
+	// populated for managed objects whose drift policy is `report`.
+	// +kubebuilder:validation:MaxItems:=64
+	// +kubebuilder:validation:items:MaxLength:=1024
+	// +listType=set
+	// +optional
+	Drift []string `json:"drift,omitempty"`
+
+	// openStackCloudName is the name of the OpenStackCloud whose credentials
+	// are used by the object. It is only set when cloudCredentialsRef is not
+	// specified. Once the object has an OpenStack resource, the object will
+	// not use the credentials of a different OpenStackCloud.
+	// +kubebuilder:validation:MaxLength:=253
+	// +optional
+	OpenStackCloudName *string `json:"openStackCloudName,omitempty"`
+}
+
+var _ ObjectWithConditions = &VolumeBackup{}
+
+func (i *VolumeBackup) GetConditions() []metav1.Condition {
+	return i.Status.Conditions
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:categories=openstack
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.id",description="Resource ID"
+// +kubebuilder:printcolumn:name="Available",type="string",JSONPath=".status.conditions[?(@.type=='Available')].status",description="Availability status of resource"
+// +kubebuilder:printcolumn:name="Message",type="string",JSONPath=".status.conditions[?(@.type=='Progressing')].message",description="Message describing current progress status"
+
+// VolumeBackup is the Schema for an ORC resource.
+type VolumeBackup struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata contains the object metadata
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// spec specifies the desired state of the resource.
+	// +required
+	Spec VolumeBackupSpec `json:"spec,omitzero"`
+
+	// status defines the observed state of the resource.
+	// +optional
+	Status VolumeBackupStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// VolumeBackupList contains a list of VolumeBackup.
+type VolumeBackupList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// metadata contains the list metadata
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// items contains a list of VolumeBackup.
+	// +required
+	Items []VolumeBackup `json:"items"`
+}
+
+func (l *VolumeBackupList) GetItems() []VolumeBackup {
+	return l.Items
+}
+
+func init() {
+	SchemeBuilder.Register(&VolumeBackup{}, &VolumeBackupList{})
+}
+
+func (i *VolumeBackup) GetCloudCredentialsRef() (*string, *CloudCredentialsReference) {
+	if i == nil {
+		return nil, nil
+	}
+
+	return &i.Namespace, i.Spec.CloudCredentialsRef
+}
+
+var _ CloudCredentialsRefProvider = &VolumeBackup{}
+
+func (i *VolumeBackup) GetOpenStackCloudStatus() (*string, bool) {
+	if i == nil {
+		return nil, false
+	}
+
+	return i.Status.OpenStackCloudName, i.Status.ID != nil
+}
+
+var _ OpenStackCloudStatusProvider = &VolumeBackup{}
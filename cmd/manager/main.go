@@ -18,15 +18,19 @@ package main
 
 import (
 	"flag"
+	"fmt"
 	"os"
+	"strings"
 
 	// Import all Kubernetes client auth plugins (e.g. Azure, GCP, OIDC, etc.)
 	// to ensure that exec-entrypoint and run can make use of them.
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 
+	"k8s.io/apimachinery/pkg/labels"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/addressscope"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/applicationcredential"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/domain"
@@ -53,16 +57,22 @@ import (
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/trunk"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/user"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/volume"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/volumebackup"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/volumesnapshot"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/volumetype"
 	internalmanager "github.com/k-orc/openstack-resource-controller/v2/internal/manager"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/scheme"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/scope"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/webhooks"
 	// +kubebuilder:scaffold:imports
 )
 
 var (
 	defaultCACertsPath string
 	namespaceList      []string
+	defaultRateLimit   scope.RateLimit
+	controllerConfig   string
+	controllerFlags    internalmanager.ControllersConfig
 )
 
 func main() {
@@ -85,8 +95,64 @@ func main() {
 	flag.DurationVar(&orcOpts.DefaultResyncPeriod, "default-resync-period", 0,
 		"Default resync period for all resources. Set to 0 to disable. "+
 			"Can be overridden per-resource via spec.resyncPeriod.")
+	flag.Func("default-drift-policy", "Default drift policy for managed resources: 'correct' or 'report'. "+
+		"Defaults to 'correct'. Can be overridden per-resource via spec.managedOptions.driftPolicy.", func(policy string) error {
+		switch orcv1alpha1.DriftPolicy(policy) {
+		case orcv1alpha1.DriftPolicyCorrect, orcv1alpha1.DriftPolicyReport:
+			orcOpts.DefaultDriftPolicy = orcv1alpha1.DriftPolicy(policy)
+			return nil
+		default:
+			return fmt.Errorf("invalid drift policy %q", policy)
+		}
+	})
+	flag.BoolVar(&orcOpts.DryRun, "dry-run", false,
+		"If set, report the changes ORC would make to OpenStack without making them. "+
+			"Can be overridden per-resource via the "+orcv1alpha1.DryRunAnnotation+" annotation.")
+	flag.DurationVar(&orcOpts.ListCacheTTL, "list-cache-ttl", 0,
+		"The maximum age of a cached list of OpenStack resources used to resync up to date resources "+
+			"instead of fetching each resource individually. Set to 0 to disable.")
+	flag.Float64Var(&defaultRateLimit.QPS, "openstack-qps", 0,
+		"The sustained rate of OpenStack API requests per second allowed for each set of credentials. "+
+			"Set to 0 to disable. Can be overridden per-secret via the "+orcv1alpha1.CloudCredentialsRateLimitQPSAnnotation+" annotation.")
+	flag.IntVar(&defaultRateLimit.Burst, "openstack-burst", 0,
+		"The maximum burst of OpenStack API requests allowed for each set of credentials. Defaults to openstack-qps. "+
+			"Can be overridden per-secret via the "+orcv1alpha1.CloudCredentialsRateLimitBurstAnnotation+" annotation.")
+	flag.IntVar(&defaultRateLimit.MaxInFlight, "openstack-max-inflight", 0,
+		"The maximum number of concurrent OpenStack API requests allowed for each set of credentials. "+
+			"Set to 0 to disable. Can be overridden per-secret via the "+orcv1alpha1.CloudCredentialsMaxInFlightAnnotation+" annotation.")
+	flag.StringVar(&controllerConfig, "controller-config", "",
+		"The path to a YAML file configuring the concurrency and retry backoff of individual controllers. "+
+			"Values set by the controller-concurrency, controller-base-delay and controller-max-delay flags take precedence.")
+	flag.Func("controller-concurrency", "A comma-separated list of controller=count pairs setting the maximum number of "+
+		"objects each controller reconciles concurrently, e.g. port=10,server=4. Can be specified multiple times.",
+		controllerFlags.SetMaxConcurrentReconciles)
+	flag.Func("controller-base-delay", "A comma-separated list of controller=duration pairs setting the delay before "+
+		"each controller first retries a failed reconcile, e.g. port=10ms. Can be specified multiple times.",
+		controllerFlags.SetBaseDelay)
+	flag.Func("controller-max-delay", "A comma-separated list of controller=duration pairs setting the maximum delay "+
+		"before each controller retries a failed reconcile, e.g. port=5m. Can be specified multiple times.",
+		controllerFlags.SetMaxDelay)
+	flag.Func("webhooks", "A comma-separated list of validating webhooks to enable, by the lowercase name of their kind. "+
+		"Available webhooks: "+strings.Join(webhooks.Names(), ", ")+". Can be specified multiple times.", func(names string) error {
+		orcOpts.Webhooks = append(orcOpts.Webhooks, strings.Split(names, ",")...)
+		return nil
+	})
 	flag.StringVar(&defaultCACertsPath, "default-ca-certs", "",
 		"The path to a PEM-encoded CA Certificate file to supply as default for OpenStack API requests.")
+	flag.IntVar(&orcOpts.Shard.Count, "shard-count", 0,
+		"The number of shards between which namespaces are distributed by a consistent hash of their name. "+
+			"Each shard is reconciled by managers with the same shard-index, which elect their own leader. Set to 0 to disable.")
+	flag.IntVar(&orcOpts.Shard.Index, "shard-index", 0,
+		"The shard reconciled by this manager, from 0 to shard-count - 1.")
+	flag.Func("shard-namespace-selector", "A label selector restricting the namespaces whose objects are reconciled "+
+		"by this manager, e.g. orc-shard=a. Managers with the same selector elect their own leader.", func(selector string) error {
+		parsed, err := labels.Parse(selector)
+		if err != nil {
+			return err
+		}
+		orcOpts.Shard.NamespaceSelector = parsed
+		return nil
+	})
 	flag.Func("namespace", "A namespace that the controller watches to reconcile ORC objects. "+
 		"Can be specified multiple times.", func(ns string) error {
 		namespaceList = append(namespaceList, ns)
@@ -114,7 +180,17 @@ func main() {
 			os.Exit(1)
 		}
 	}
-	scopeFactory := scope.NewFactory(orcOpts.ScopeCacheMaxSize, caCerts)
+	if controllerConfig != "" {
+		fileConfig, err := internalmanager.LoadControllersConfig(controllerConfig)
+		if err != nil {
+			setupLog.Error(err, "unable to read controller config file")
+			os.Exit(1)
+		}
+		controllerFlags = fileConfig.Merge(controllerFlags)
+	}
+	orcOpts.Controllers = controllerFlags
+
+	scopeFactory := scope.NewFactory(orcOpts.ScopeCacheMaxSize, caCerts, defaultRateLimit)
 
 	controllers := []interfaces.Controller{
 		addressscope.New(scopeFactory),
@@ -135,6 +211,8 @@ func main() {
 		project.New(scopeFactory),
 		user.New(scopeFactory),
 		volume.New(scopeFactory),
+		volumebackup.New(scopeFactory),
+		volumesnapshot.New(scopeFactory),
 		volumetype.New(scopeFactory),
 		domain.New(scopeFactory),
 		service.New(scopeFactory),
@@ -147,6 +225,7 @@ func main() {
 
 	restConfig := ctrl.GetConfigOrDie()
 	orcOpts.WatchNamespaces = namespaceList
+	orcOpts.ScopeFactory = scopeFactory
 	err := internalmanager.Run(ctx, &orcOpts, restConfig, scheme.New(), setupLog, log, controllers)
 	if err != nil {
 		setupLog.Error(err, "Error starting manager")
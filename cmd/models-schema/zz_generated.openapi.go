@@ -140,6 +140,11 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.NetworkSpec":                           schema_openstack_resource_controller_v2_api_v1alpha1_NetworkSpec(ref),
 		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.NetworkStatus":                         schema_openstack_resource_controller_v2_api_v1alpha1_NetworkStatus(ref),
 		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.NeutronStatusMetadata":                 schema_openstack_resource_controller_v2_api_v1alpha1_NeutronStatusMetadata(ref),
+		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.OpenStackCloud":                        schema_openstack_resource_controller_v2_api_v1alpha1_OpenStackCloud(ref),
+		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.OpenStackCloudAllowedNamespaces":       schema_openstack_resource_controller_v2_api_v1alpha1_OpenStackCloudAllowedNamespaces(ref),
+		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.OpenStackCloudList":                    schema_openstack_resource_controller_v2_api_v1alpha1_OpenStackCloudList(ref),
+		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.OpenStackCloudSecretReference":         schema_openstack_resource_controller_v2_api_v1alpha1_OpenStackCloudSecretReference(ref),
+		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.OpenStackCloudSpec":                    schema_openstack_resource_controller_v2_api_v1alpha1_OpenStackCloudSpec(ref),
 		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.Port":                                  schema_openstack_resource_controller_v2_api_v1alpha1_Port(ref),
 		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.PortFilter":                            schema_openstack_resource_controller_v2_api_v1alpha1_PortFilter(ref),
 		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.PortImport":                            schema_openstack_resource_controller_v2_api_v1alpha1_PortImport(ref),
@@ -271,6 +276,14 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.UserStatus":                            schema_openstack_resource_controller_v2_api_v1alpha1_UserStatus(ref),
 		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.Volume":                                schema_openstack_resource_controller_v2_api_v1alpha1_Volume(ref),
 		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeAttachmentStatus":                schema_openstack_resource_controller_v2_api_v1alpha1_VolumeAttachmentStatus(ref),
+		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeBackup":                          schema_openstack_resource_controller_v2_api_v1alpha1_VolumeBackup(ref),
+		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeBackupFilter":                    schema_openstack_resource_controller_v2_api_v1alpha1_VolumeBackupFilter(ref),
+		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeBackupImport":                    schema_openstack_resource_controller_v2_api_v1alpha1_VolumeBackupImport(ref),
+		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeBackupList":                      schema_openstack_resource_controller_v2_api_v1alpha1_VolumeBackupList(ref),
+		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeBackupResourceSpec":              schema_openstack_resource_controller_v2_api_v1alpha1_VolumeBackupResourceSpec(ref),
+		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeBackupResourceStatus":            schema_openstack_resource_controller_v2_api_v1alpha1_VolumeBackupResourceStatus(ref),
+		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeBackupSpec":                      schema_openstack_resource_controller_v2_api_v1alpha1_VolumeBackupSpec(ref),
+		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeBackupStatus":                    schema_openstack_resource_controller_v2_api_v1alpha1_VolumeBackupStatus(ref),
 		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeFilter":                          schema_openstack_resource_controller_v2_api_v1alpha1_VolumeFilter(ref),
 		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeImport":                          schema_openstack_resource_controller_v2_api_v1alpha1_VolumeImport(ref),
 		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeList":                            schema_openstack_resource_controller_v2_api_v1alpha1_VolumeList(ref),
@@ -278,8 +291,20 @@ func GetOpenAPIDefinitions(ref common.ReferenceCallback) map[string]common.OpenA
 		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeMetadataStatus":                  schema_openstack_resource_controller_v2_api_v1alpha1_VolumeMetadataStatus(ref),
 		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeResourceSpec":                    schema_openstack_resource_controller_v2_api_v1alpha1_VolumeResourceSpec(ref),
 		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeResourceStatus":                  schema_openstack_resource_controller_v2_api_v1alpha1_VolumeResourceStatus(ref),
+		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeRetypeAttempt":                   schema_openstack_resource_controller_v2_api_v1alpha1_VolumeRetypeAttempt(ref),
+		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshot":                        schema_openstack_resource_controller_v2_api_v1alpha1_VolumeSnapshot(ref),
+		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshotFilter":                  schema_openstack_resource_controller_v2_api_v1alpha1_VolumeSnapshotFilter(ref),
+		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshotImport":                  schema_openstack_resource_controller_v2_api_v1alpha1_VolumeSnapshotImport(ref),
+		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshotList":                    schema_openstack_resource_controller_v2_api_v1alpha1_VolumeSnapshotList(ref),
+		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshotMetadata":                schema_openstack_resource_controller_v2_api_v1alpha1_VolumeSnapshotMetadata(ref),
+		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshotMetadataStatus":          schema_openstack_resource_controller_v2_api_v1alpha1_VolumeSnapshotMetadataStatus(ref),
+		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshotResourceSpec":            schema_openstack_resource_controller_v2_api_v1alpha1_VolumeSnapshotResourceSpec(ref),
+		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshotResourceStatus":          schema_openstack_resource_controller_v2_api_v1alpha1_VolumeSnapshotResourceStatus(ref),
+		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshotSpec":                    schema_openstack_resource_controller_v2_api_v1alpha1_VolumeSnapshotSpec(ref),
+		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshotStatus":                  schema_openstack_resource_controller_v2_api_v1alpha1_VolumeSnapshotStatus(ref),
 		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSpec":                            schema_openstack_resource_controller_v2_api_v1alpha1_VolumeSpec(ref),
 		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeStatus":                          schema_openstack_resource_controller_v2_api_v1alpha1_VolumeStatus(ref),
+		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeStatusExtra":                     schema_openstack_resource_controller_v2_api_v1alpha1_VolumeStatusExtra(ref),
 		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeType":                            schema_openstack_resource_controller_v2_api_v1alpha1_VolumeType(ref),
 		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeTypeExtraSpec":                   schema_openstack_resource_controller_v2_api_v1alpha1_VolumeTypeExtraSpec(ref),
 		"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeTypeExtraSpecStatus":             schema_openstack_resource_controller_v2_api_v1alpha1_VolumeTypeExtraSpecStatus(ref),
@@ -904,13 +929,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_AddressScopeSpec(ref c
 					},
 					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials",
-							Default:     map[string]interface{}{},
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
 							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
 				},
-				Required: []string{"cloudCredentialsRef"},
 			},
 		},
 		Dependencies: []string{
@@ -937,7 +960,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_AddressScopeStatus(ref
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -968,6 +991,33 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_AddressScopeStatus(ref
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1358,7 +1408,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_ApplicationCredentialR
 					},
 					"secretRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "secretRef is a reference to a Secret containing the application credential secret",
+							Description: "secretRef is a reference to a Secret containing the application credential secret in a key named `value`. Once the application credential has been created, ORC writes its credentials to the same Secret so that it can be used by cloudCredentialsRef.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -1577,13 +1627,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_ApplicationCredentialS
 					},
 					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials",
-							Default:     map[string]interface{}{},
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
 							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
 				},
-				Required: []string{"cloudCredentialsRef"},
 			},
 		},
 		Dependencies: []string{
@@ -1610,7 +1658,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_ApplicationCredentialS
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -1641,6 +1689,33 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_ApplicationCredentialS
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -1658,20 +1733,27 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_CloudCredentialsRefere
 				Properties: map[string]spec.Schema{
 					"secretName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "secretName is the name of a secret in the same namespace as the resource being provisioned. The secret must contain a key named `clouds.yaml` which contains an OpenStack clouds.yaml file. The secret may optionally contain a key named `cacert` containing a PEM-encoded CA certificate.",
+							Description: "secretName is the name of a secret in the same namespace as the resource being provisioned. The secret must contain either a key named `clouds.yaml` which contains an OpenStack clouds.yaml file, or the credentials of a single cloud as individual keys, including `auth_url`. The secret may optionally contain a key named `cacert` containing a PEM-encoded CA certificate.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 					"cloudName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudName specifies the name of the entry in the clouds.yaml file to use.",
+							Description: "cloudName specifies the name of the entry in the clouds.yaml file to use. It is required if the secret contains a clouds.yaml file, and is ignored otherwise.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"regionName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "regionName is the OpenStack region to use. If specified, it overrides the region of the cloud in the secret, allowing a single cloud to be used in multiple regions. It is immutable, as the resource exists in a single region.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
 				},
-				Required: []string{"secretName", "cloudName"},
+				Required: []string{"secretName"},
 			},
 		},
 	}
@@ -1942,13 +2024,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_DomainSpec(ref common.
 					},
 					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials",
-							Default:     map[string]interface{}{},
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
 							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
 				},
-				Required: []string{"cloudCredentialsRef"},
 			},
 		},
 		Dependencies: []string{
@@ -1975,7 +2055,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_DomainStatus(ref commo
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -2006,6 +2086,33 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_DomainStatus(ref commo
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -2316,13 +2423,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_EndpointSpec(ref commo
 					},
 					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials",
-							Default:     map[string]interface{}{},
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
 							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
 				},
-				Required: []string{"cloudCredentialsRef"},
 			},
 		},
 		Dependencies: []string{
@@ -2349,7 +2454,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_EndpointStatus(ref com
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -2380,6 +2485,33 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_EndpointStatus(ref com
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -3187,13 +3319,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_FlavorSpec(ref common.
 					},
 					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials",
-							Default:     map[string]interface{}{},
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
 							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
 				},
-				Required: []string{"cloudCredentialsRef"},
 			},
 		},
 		Dependencies: []string{
@@ -3220,7 +3350,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_FlavorStatus(ref commo
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -3251,6 +3381,33 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_FlavorStatus(ref commo
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -3762,13 +3919,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_FloatingIPSpec(ref com
 					},
 					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials",
-							Default:     map[string]interface{}{},
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
 							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
 				},
-				Required: []string{"cloudCredentialsRef"},
 			},
 		},
 		Dependencies: []string{
@@ -3795,7 +3950,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_FloatingIPStatus(ref c
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -3826,6 +3981,33 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_FloatingIPStatus(ref c
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -4099,13 +4281,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_GroupSpec(ref common.R
 					},
 					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials",
-							Default:     map[string]interface{}{},
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
 							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
 				},
-				Required: []string{"cloudCredentialsRef"},
 			},
 		},
 		Dependencies: []string{
@@ -4132,7 +4312,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_GroupStatus(ref common
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -4163,6 +4343,33 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_GroupStatus(ref common
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -4912,13 +5119,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_ImageSpec(ref common.R
 					},
 					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials",
-							Default:     map[string]interface{}{},
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
 							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
 				},
-				Required: []string{"cloudCredentialsRef"},
 			},
 		},
 		Dependencies: []string{
@@ -4945,7 +5150,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_ImageStatus(ref common
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -4976,6 +5181,33 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_ImageStatus(ref common
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"downloadAttempts": {
 						SchemaProps: spec.SchemaProps{
 							Description: "downloadAttempts is the number of times the controller has attempted to download the image contents",
@@ -5276,13 +5508,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_KeyPairSpec(ref common
 					},
 					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials",
-							Default:     map[string]interface{}{},
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
 							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
 				},
-				Required: []string{"cloudCredentialsRef"},
 			},
 		},
 		Dependencies: []string{
@@ -5309,7 +5539,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_KeyPairStatus(ref comm
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -5340,6 +5570,33 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_KeyPairStatus(ref comm
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -5361,6 +5618,20 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_ManagedOptions(ref com
 							Format:      "",
 						},
 					},
+					"driftPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "driftPolicy specifies the behaviour of the controller when the OpenStack resource no longer matches the desired state. Options are `correct` - update the OpenStack resource to match the desired state; `report` - do not modify the OpenStack resource, but report the fields which differ in status.drift and the Drifted condition. If not specified, the manager's default drift policy is used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"deletionProtection": {
+						SchemaProps: spec.SchemaProps{
+							Description: "deletionProtection prevents the controller from deleting the OpenStack resource. If it is true and onDelete is `delete`, the controller will not delete the OpenStack resource or remove its finalizer from the ORC object until deletionProtection is set to false.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -5944,13 +6215,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_NetworkSpec(ref common
 					},
 					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials",
-							Default:     map[string]interface{}{},
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
 							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
 				},
-				Required: []string{"cloudCredentialsRef"},
 			},
 		},
 		Dependencies: []string{
@@ -5977,7 +6246,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_NetworkStatus(ref comm
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -6008,20 +6277,47 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_NetworkStatus(ref comm
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.NetworkResourceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
-	}
-}
-
-func schema_openstack_resource_controller_v2_api_v1alpha1_NeutronStatusMetadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.NetworkResourceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_NeutronStatusMetadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
 					"createdAt": {
 						SchemaProps: spec.SchemaProps{
 							Description: "createdAt shows the date and time when the resource was created. The date and time stamp format is ISO 8601",
@@ -6049,6 +6345,221 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_NeutronStatusMetadata(
 	}
 }
 
+func schema_openstack_resource_controller_v2_api_v1alpha1_OpenStackCloud(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackCloud defines the default OpenStack credentials of objects in a set of namespaces which do not specify cloudCredentialsRef.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "metadata contains the object metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "spec specifies the default credentials.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.OpenStackCloudSpec"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.OpenStackCloudSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_OpenStackCloudAllowedNamespaces(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackCloudAllowedNamespaces selects namespaces. A namespace is selected if it is listed in names or matched by selector.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"names": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "names is a list of namespace names.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"selector": {
+						SchemaProps: spec.SchemaProps{
+							Description: "selector selects namespaces by their labels.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.LabelSelector"},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_OpenStackCloudList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackCloudList contains a list of OpenStackCloud.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "metadata contains the list metadata.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "items contains a list of OpenStackCloud.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.OpenStackCloud"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.OpenStackCloud", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_OpenStackCloudSecretReference(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackCloudSecretReference is a reference to a secret in any namespace.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"namespace": {
+						SchemaProps: spec.SchemaProps{
+							Description: "namespace is the namespace of the secret.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is the name of the secret.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"namespace", "name"},
+			},
+		},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_OpenStackCloudSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "OpenStackCloudSpec defines the default OpenStack credentials of a set of namespaces.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"secretRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "secretRef references a secret containing OpenStack credentials, in the same format as a secret referenced by cloudCredentialsRef. The secret may be in any namespace.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.OpenStackCloudSecretReference"),
+						},
+					},
+					"cloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "cloudName specifies the name of the entry in the clouds.yaml file to use. It is required if the secret contains a clouds.yaml file, and is ignored otherwise.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"regionName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "regionName is the OpenStack region to use. If specified, it overrides any region in the credentials. It is immutable, as resources using these credentials exist in a single region.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"caCert": {
+						SchemaProps: spec.SchemaProps{
+							Description: "caCert is a PEM-encoded CA bundle used to verify the OpenStack API endpoints. If specified, it overrides any CA bundle in the credentials secret.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"allowedNamespaces": {
+						SchemaProps: spec.SchemaProps{
+							Description: "allowedNamespaces selects the namespaces whose objects use these credentials when they do not specify cloudCredentialsRef. At most one OpenStackCloud may select any namespace.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.OpenStackCloudAllowedNamespaces"),
+						},
+					},
+				},
+				Required: []string{"secretRef", "allowedNamespaces"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.OpenStackCloudAllowedNamespaces", "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.OpenStackCloudSecretReference"},
+	}
+}
+
 func schema_openstack_resource_controller_v2_api_v1alpha1_Port(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
@@ -6812,13 +7323,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_PortSpec(ref common.Re
 					},
 					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials",
-							Default:     map[string]interface{}{},
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
 							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
 				},
-				Required: []string{"cloudCredentialsRef"},
 			},
 		},
 		Dependencies: []string{
@@ -6845,7 +7354,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_PortStatus(ref common.
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -6876,6 +7385,33 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_PortStatus(ref common.
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -7310,13 +7846,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_ProjectSpec(ref common
 					},
 					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials",
-							Default:     map[string]interface{}{},
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
 							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
 				},
-				Required: []string{"cloudCredentialsRef"},
 			},
 		},
 		Dependencies: []string{
@@ -7343,7 +7877,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_ProjectStatus(ref comm
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -7374,16 +7908,43 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_ProjectStatus(ref comm
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.ProjectResourceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
-	}
-}
-
-func schema_openstack_resource_controller_v2_api_v1alpha1_ProviderPropertiesStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.ProjectResourceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_ProviderPropertiesStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
 				Type: []string{"object"},
@@ -7774,13 +8335,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_RoleAssignmentSpec(ref
 					},
 					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials",
-							Default:     map[string]interface{}{},
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
 							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
 				},
-				Required: []string{"cloudCredentialsRef"},
 			},
 		},
 		Dependencies: []string{
@@ -7807,7 +8366,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_RoleAssignmentStatus(r
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -7831,6 +8390,33 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_RoleAssignmentStatus(r
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -8053,13 +8639,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_RoleSpec(ref common.Re
 					},
 					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials",
-							Default:     map[string]interface{}{},
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
 							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
 				},
-				Required: []string{"cloudCredentialsRef"},
 			},
 		},
 		Dependencies: []string{
@@ -8086,7 +8670,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_RoleStatus(ref common.
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -8117,6 +8701,33 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_RoleStatus(ref common.
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -8820,13 +9431,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_RouterSpec(ref common.
 					},
 					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials",
-							Default:     map[string]interface{}{},
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
 							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
 				},
-				Required: []string{"cloudCredentialsRef"},
 			},
 		},
 		Dependencies: []string{
@@ -8853,7 +9462,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_RouterStatus(ref commo
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -8884,6 +9493,33 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_RouterStatus(ref commo
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -9485,13 +10121,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_SecurityGroupSpec(ref
 					},
 					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials",
-							Default:     map[string]interface{}{},
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
 							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
 				},
-				Required: []string{"cloudCredentialsRef"},
 			},
 		},
 		Dependencies: []string{
@@ -9518,7 +10152,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_SecurityGroupStatus(re
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -9549,6 +10183,33 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_SecurityGroupStatus(re
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -10056,13 +10717,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_ServerGroupSpec(ref co
 					},
 					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials",
-							Default:     map[string]interface{}{},
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
 							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
 				},
-				Required: []string{"cloudCredentialsRef"},
 			},
 		},
 		Dependencies: []string{
@@ -10089,7 +10748,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_ServerGroupStatus(ref
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -10120,6 +10779,33 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_ServerGroupStatus(ref
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -10832,13 +11518,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_ServerSpec(ref common.
 					},
 					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials",
-							Default:     map[string]interface{}{},
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
 							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
 				},
-				Required: []string{"cloudCredentialsRef"},
 			},
 		},
 		Dependencies: []string{
@@ -10865,7 +11549,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_ServerStatus(ref commo
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -10896,6 +11580,33 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_ServerStatus(ref commo
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -11230,13 +11941,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_ServiceSpec(ref common
 					},
 					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials",
-							Default:     map[string]interface{}{},
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
 							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
 				},
-				Required: []string{"cloudCredentialsRef"},
 			},
 		},
 		Dependencies: []string{
@@ -11263,7 +11972,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_ServiceStatus(ref comm
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -11294,6 +12003,33 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_ServiceStatus(ref comm
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -11631,13 +12367,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_ShareNetworkSpec(ref c
 					},
 					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials",
-							Default:     map[string]interface{}{},
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
 							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
 				},
-				Required: []string{"cloudCredentialsRef"},
 			},
 		},
 		Dependencies: []string{
@@ -11664,7 +12398,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_ShareNetworkStatus(ref
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -11695,6 +12429,33 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_ShareNetworkStatus(ref
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -12415,13 +13176,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_SubnetSpec(ref common.
 					},
 					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials",
-							Default:     map[string]interface{}{},
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
 							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
 				},
-				Required: []string{"cloudCredentialsRef"},
 			},
 		},
 		Dependencies: []string{
@@ -12448,7 +13207,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_SubnetStatus(ref commo
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -12479,22 +13238,49 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_SubnetStatus(ref commo
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-				},
-			},
-		},
-		Dependencies: []string{
-			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.SubnetResourceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
-	}
-}
-
-func schema_openstack_resource_controller_v2_api_v1alpha1_Trunk(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Description: "Trunk is the Schema for an ORC resource.",
-				Type:        []string{"object"},
-				Properties: map[string]spec.Schema{
-					"kind": {
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.SubnetResourceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_Trunk(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "Trunk is the Schema for an ORC resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
 						SchemaProps: spec.SchemaProps{
 							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
 							Type:        []string{"string"},
@@ -12997,13 +13783,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_TrunkSpec(ref common.R
 					},
 					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials",
-							Default:     map[string]interface{}{},
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
 							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
 				},
-				Required: []string{"cloudCredentialsRef"},
 			},
 		},
 		Dependencies: []string{
@@ -13030,7 +13814,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_TrunkStatus(ref common
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -13061,6 +13845,33 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_TrunkStatus(ref common
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -13471,13 +14282,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_UserSpec(ref common.Re
 					},
 					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials",
-							Default:     map[string]interface{}{},
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
 							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
 				},
-				Required: []string{"cloudCredentialsRef"},
 			},
 		},
 		Dependencies: []string{
@@ -13504,7 +14313,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_UserStatus(ref common.
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -13535,6 +14344,33 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_UserStatus(ref common.
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
@@ -13638,11 +14474,62 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeAttachmentStatus
 	}
 }
 
-func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeBackup(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VolumeFilter defines an existing resource by its properties",
+				Description: "VolumeBackup is the Schema for an ORC resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "metadata contains the object metadata",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "spec specifies the desired state of the resource.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeBackupSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "status defines the observed state of the resource.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeBackupStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeBackupSpec", "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeBackupStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeBackupFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeBackupFilter defines an existing resource by its properties",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
@@ -13659,16 +14546,9 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeFilter(ref commo
 							Format:      "",
 						},
 					},
-					"size": {
-						SchemaProps: spec.SchemaProps{
-							Description: "size is the size of the volume in GiB.",
-							Type:        []string{"integer"},
-							Format:      "int32",
-						},
-					},
-					"availabilityZone": {
+					"volumeRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "availabilityZone is the availability zone of the existing resource",
+							Description: "volumeRef is a reference to the ORC Volume of the existing backup.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -13679,11 +14559,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeFilter(ref commo
 	}
 }
 
-func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeBackupImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VolumeImport specifies an existing resource which will be imported instead of creating a new one",
+				Description: "VolumeBackupImport specifies an existing resource which will be imported instead of creating a new one",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"id": {
@@ -13696,22 +14576,22 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeImport(ref commo
 					"filter": {
 						SchemaProps: spec.SchemaProps{
 							Description: "filter contains a resource query which is expected to return a single result. The controller will continue to retry if filter returns no results. If filter returns multiple results the controller will set an error state and will not continue to retry.",
-							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeFilter"),
+							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeBackupFilter"),
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeFilter"},
+			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeBackupFilter"},
 	}
 }
 
-func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeBackupList(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VolumeList contains a list of Volume.",
+				Description: "VolumeBackupList contains a list of VolumeBackup.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"kind": {
@@ -13737,13 +14617,13 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeList(ref common.
 					},
 					"items": {
 						SchemaProps: spec.SchemaProps{
-							Description: "items contains a list of Volume.",
+							Description: "items contains a list of VolumeBackup.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.Volume"),
+										Ref:     ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeBackup"),
 									},
 								},
 							},
@@ -13754,75 +14634,83 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeList(ref common.
 			},
 		},
 		Dependencies: []string{
-			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.Volume", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeBackup", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
 	}
 }
 
-func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeMetadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeBackupResourceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
+				Description: "VolumeBackupResourceSpec contains the desired state of the resource.",
+				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "name is the name of the metadata",
-							Default:     "",
+							Description: "name will be the name of the created resource. If not specified, the name of the ORC object will be used.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"value": {
+					"description": {
 						SchemaProps: spec.SchemaProps{
-							Description: "value is the value of the metadata",
-							Default:     "",
+							Description: "description is a human-readable description for the resource.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"name", "value"},
-			},
-		},
-	}
-}
-
-func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeMetadataStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
-	return common.OpenAPIDefinition{
-		Schema: spec.Schema{
-			SchemaProps: spec.SchemaProps{
-				Type: []string{"object"},
-				Properties: map[string]spec.Schema{
-					"name": {
+					"volumeRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "name is the name of the metadata",
+							Description: "volumeRef is a reference to the ORC Volume to back up.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"value": {
+					"snapshotRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "value is the value of the metadata",
+							Description: "snapshotRef is a reference to an ORC VolumeSnapshot of the volume. If specified, the backup is created from this snapshot instead of from the current contents of the volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"incremental": {
+						SchemaProps: spec.SchemaProps{
+							Description: "incremental creates an incremental backup, containing only the changes since the most recent backup of the volume. The volume must already have a full backup.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"container": {
+						SchemaProps: spec.SchemaProps{
+							Description: "container is the name of the container in the backup service in which to store the backup. If not specified, the backup service's default container is used.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
+					"force": {
+						SchemaProps: spec.SchemaProps{
+							Description: "force allows the backup of a volume which is attached to a server to be created. The backup of an attached volume may not be consistent.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
 				},
+				Required: []string{"volumeRef"},
 			},
 		},
 	}
 }
 
-func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeResourceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeBackupResourceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VolumeResourceSpec contains the desired state of the resource.",
+				Description: "VolumeBackupResourceStatus represents the observed state of the resource.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
 						SchemaProps: spec.SchemaProps{
-							Description: "name will be the name of the created resource. If not specified, the name of the ORC object will be used.",
+							Description: "name is a Human-readable name for the resource. Might not be unique.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
@@ -13834,67 +14722,1033 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeResourceSpec(ref
 							Format:      "",
 						},
 					},
+					"volumeID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "volumeID is the ID of the volume from which the backup was created.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"snapshotID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "snapshotID is the ID of the snapshot from which the backup was created.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"size": {
 						SchemaProps: spec.SchemaProps{
-							Description: "size is the size of the volume, in gibibytes (GiB).",
+							Description: "size is the size of the backup in GiB.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
-					"volumeTypeRef": {
+					"objectCount": {
+						SchemaProps: spec.SchemaProps{
+							Description: "objectCount is the number of objects in the backup service which store the backup.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "volumeTypeRef is a reference to the ORC VolumeType which this resource is associated with.",
+							Description: "status represents the current status of the backup.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"availabilityZone": {
+					"failReason": {
 						SchemaProps: spec.SchemaProps{
-							Description: "availabilityZone is the availability zone in which to create the volume.",
+							Description: "failReason is the reason the backup failed, if its status is error.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"metadata": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "atomic",
-							},
+					"container": {
+						SchemaProps: spec.SchemaProps{
+							Description: "container is the container in the backup service in which the backup is stored.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
+					},
+					"incremental": {
 						SchemaProps: spec.SchemaProps{
-							Description: "metadata key and value pairs to be associated with the volume. NOTE(mandre): gophercloud can't clear all metadata at the moment, we thus can't allow mutability for metadata as we might end up in a state that is not reconciliable",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeMetadata"),
-									},
-								},
-							},
+							Description: "incremental is whether the backup is incremental.",
+							Type:        []string{"boolean"},
+							Format:      "",
 						},
 					},
-					"imageRef": {
+					"hasDependentBackups": {
 						SchemaProps: spec.SchemaProps{
-							Description: "imageRef is a reference to an ORC Image. If specified, creates a bootable volume from this image. The volume size must be >= the image's min_disk requirement.",
+							Description: "hasDependentBackups is whether there are incremental backups which depend on this backup. A backup with dependent backups cannot be deleted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"availabilityZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "availabilityZone is the availability zone of the backup.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-				},
-				Required: []string{"size"},
-			},
+					"projectID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "projectID is the ID of the project that owns the backup.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"createdAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "createdAt shows the date and time when the resource was created. The date and time stamp format is ISO 8601",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"updatedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "updatedAt shows the date and time when the resource was updated. The date and time stamp format is ISO 8601",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"dataTimestamp": {
+						SchemaProps: spec.SchemaProps{
+							Description: "dataTimestamp shows the date and time of the data in the volume when the backup was taken. The date and time stamp format is ISO 8601",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeBackupSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeBackupSpec defines the desired state of an ORC object.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"import": {
+						SchemaProps: spec.SchemaProps{
+							Description: "import refers to an existing OpenStack resource which will be imported instead of creating a new one.",
+							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeBackupImport"),
+						},
+					},
+					"resource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "resource specifies the desired state of the resource.\n\nresource may not be specified if the management policy is `unmanaged`.\n\nresource must be specified if the management policy is `managed`.",
+							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeBackupResourceSpec"),
+						},
+					},
+					"managementPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "managementPolicy defines how ORC will treat the object. Valid values are `managed`: ORC will create, update, and delete the resource; `unmanaged`: ORC will import an existing resource, and will not apply updates to it or delete it.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"managedOptions": {
+						SchemaProps: spec.SchemaProps{
+							Description: "managedOptions specifies options which may be applied to managed objects.",
+							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.ManagedOptions"),
+						},
+					},
+					"resyncPeriod": {
+						SchemaProps: spec.SchemaProps{
+							Description: "resyncPeriod defines how frequently the controller will re-reconcile this resource even when no changes have been detected. This overrides the global default resync period. The value must be a valid Go duration string, e.g. \"10m\", \"1h\". Set to \"0s\" to disable periodic resync for this resource. Very low values may cause excessive OpenStack API load.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
+						},
+					},
+					"cloudCredentialsRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
+							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference", "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.ManagedOptions", "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeBackupImport", "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeBackupResourceSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeBackupStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeBackupStatus defines the observed state of an ORC resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type":       "map",
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
+						},
+					},
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "id is the unique identifier of the OpenStack resource.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"resource": {
+						SchemaProps: spec.SchemaProps{
+							Description: "resource contains the observed state of the OpenStack resource.",
+							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeBackupResourceStatus"),
+						},
+					},
+					"lastSyncTime": {
+						SchemaProps: spec.SchemaProps{
+							Description: "lastSyncTime is the timestamp of the last successful reconciliation that fetched state from OpenStack. It is updated each time the controller successfully reads the resource state from the OpenStack API.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeBackupResourceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeFilter defines an existing resource by its properties",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name of the existing resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "description of the existing resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "size is the size of the volume in GiB.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"availabilityZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "availabilityZone is the availability zone of the existing resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeImport specifies an existing resource which will be imported instead of creating a new one",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "id contains the unique identifier of an existing OpenStack resource. Note that when specifying an import by ID, the resource MUST already exist. The ORC object will enter an error state if the resource does not exist.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "filter contains a resource query which is expected to return a single result. The controller will continue to retry if filter returns no results. If filter returns multiple results the controller will set an error state and will not continue to retry.",
+							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeFilter"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeFilter"},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeList contains a list of Volume.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "metadata contains the list metadata",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "items contains a list of Volume.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.Volume"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.Volume", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeMetadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is the name of the metadata",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "value is the value of the metadata",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "value"},
+			},
+		},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeMetadataStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is the name of the metadata",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "value is the value of the metadata",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeResourceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeResourceSpec contains the desired state of the resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name will be the name of the created resource. If not specified, the name of the ORC object will be used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "description is a human-readable description for the resource.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "size is the size of the volume, in gibibytes (GiB). The size of an existing volume may be increased, which extends the volume, but it may not be decreased. Extending a volume which is attached to a server requires block storage API microversion 3.42.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"volumeTypeRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "volumeTypeRef is a reference to the ORC VolumeType which this resource is associated with. Changing the volume type of an existing volume retypes the volume, subject to migrationPolicy. It may not be removed once set.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"migrationPolicy": {
+						SchemaProps: spec.SchemaProps{
+							Description: "migrationPolicy specifies whether the volume may be migrated to another backend when its volume type is changed. If not specified, the volume will not be migrated.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"availabilityZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "availabilityZone is the availability zone in which to create the volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "metadata key and value pairs to be associated with the volume. NOTE(mandre): gophercloud can't clear all metadata at the moment, we thus can't allow mutability for metadata as we might end up in a state that is not reconciliable",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeMetadata"),
+									},
+								},
+							},
+						},
+					},
+					"imageRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "imageRef is a reference to an ORC Image. If specified, creates a bootable volume from this image. The volume size must be >= the image's min_disk requirement.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"snapshotRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "snapshotRef is a reference to an ORC VolumeSnapshot. If specified, creates the volume from this snapshot. The volume size must be >= the snapshot's size.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sourceVolumeRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "sourceVolumeRef is a reference to an ORC Volume. If specified, creates the volume as a clone of this volume. The volume size must be >= the source volume's size.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"backupRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "backupRef is a reference to an ORC VolumeBackup. If specified, restores the backup into the new volume. The volume size must be >= the backup's size.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"size"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeMetadata"},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeResourceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeResourceStatus represents the observed state of the resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is a Human-readable name for the resource. Might not be unique.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "description is a human-readable description for the resource.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"size": {
+						SchemaProps: spec.SchemaProps{
+							Description: "size is the size of the volume in GiB.",
+							Type:        []string{"integer"},
+							Format:      "int32",
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "status represents the current status of the volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"availabilityZone": {
+						SchemaProps: spec.SchemaProps{
+							Description: "availabilityZone is which availability zone the volume is in.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"attachments": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "attachments is a list of attachments for the volume.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeAttachmentStatus"),
+									},
+								},
+							},
+						},
+					},
+					"volumeType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "volumeType is the name of associated the volume type.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"snapshotID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "snapshotID is the ID of the snapshot from which the volume was created",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"sourceVolID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "sourceVolID is the ID of another block storage volume from which the current volume was created",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"backupID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "backupID is the ID of the backup from which the volume was restored",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "metadata key and value pairs to be associated with the volume.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeMetadataStatus"),
+									},
+								},
+							},
+						},
+					},
+					"userID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "userID is the ID of the user who created the volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"bootable": {
+						SchemaProps: spec.SchemaProps{
+							Description: "bootable indicates whether this is a bootable volume.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"imageID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "imageID is the ID of the image this volume was created from, if any.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"encrypted": {
+						SchemaProps: spec.SchemaProps{
+							Description: "encrypted denotes if the volume is encrypted.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"replicationStatus": {
+						SchemaProps: spec.SchemaProps{
+							Description: "replicationStatus is the status of replication.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"consistencyGroupID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "consistencyGroupID is the consistency group ID.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"multiattach": {
+						SchemaProps: spec.SchemaProps{
+							Description: "multiattach denotes if the volume is multi-attach capable.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"host": {
+						SchemaProps: spec.SchemaProps{
+							Description: "host is the identifier of the host holding the volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"tenantID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "tenantID is the ID of the project that owns the volume.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"createdAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "createdAt shows the date and time when the resource was created. The date and time stamp format is ISO 8601",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+					"updatedAt": {
+						SchemaProps: spec.SchemaProps{
+							Description: "updatedAt shows the date and time when the resource was updated. The date and time stamp format is ISO 8601",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeAttachmentStatus", "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeMetadataStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeRetypeAttempt(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"volumeType": {
+						SchemaProps: spec.SchemaProps{
+							Description: "volumeType is the name of the volume type the volume was retyped to.",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"generation": {
+						SchemaProps: spec.SchemaProps{
+							Description: "generation is the generation of the object when the retype was requested.",
+							Default:     0,
+							Type:        []string{"integer"},
+							Format:      "int64",
+						},
+					},
+				},
+				Required: []string{"volumeType", "generation"},
+			},
+		},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeSnapshot(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeSnapshot is the Schema for an ORC resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "metadata contains the object metadata",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"),
+						},
+					},
+					"spec": {
+						SchemaProps: spec.SchemaProps{
+							Description: "spec specifies the desired state of the resource.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshotSpec"),
+						},
+					},
+					"status": {
+						SchemaProps: spec.SchemaProps{
+							Description: "status defines the observed state of the resource.",
+							Default:     map[string]interface{}{},
+							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshotStatus"),
+						},
+					},
+				},
+				Required: []string{"spec"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshotSpec", "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshotStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.ObjectMeta"},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeSnapshotFilter(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeSnapshotFilter defines an existing resource by its properties",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name of the existing resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "description of the existing resource",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "volumeRef is a reference to the ORC Volume of the existing snapshot.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeSnapshotImport(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeSnapshotImport specifies an existing resource which will be imported instead of creating a new one",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"id": {
+						SchemaProps: spec.SchemaProps{
+							Description: "id contains the unique identifier of an existing OpenStack resource. Note that when specifying an import by ID, the resource MUST already exist. The ORC object will enter an error state if the resource does not exist.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"filter": {
+						SchemaProps: spec.SchemaProps{
+							Description: "filter contains a resource query which is expected to return a single result. The controller will continue to retry if filter returns no results. If filter returns multiple results the controller will set an error state and will not continue to retry.",
+							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshotFilter"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshotFilter"},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeSnapshotList(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeSnapshotList contains a list of VolumeSnapshot.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"kind": {
+						SchemaProps: spec.SchemaProps{
+							Description: "Kind is a string value representing the REST resource this object represents. Servers may infer this from the endpoint the client submits requests to. Cannot be updated. In CamelCase. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"apiVersion": {
+						SchemaProps: spec.SchemaProps{
+							Description: "APIVersion defines the versioned schema of this representation of an object. Servers should convert recognized schemas to the latest internal value, and may reject unrecognized values. More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						SchemaProps: spec.SchemaProps{
+							Description: "metadata contains the list metadata",
+							Default:     map[string]interface{}{},
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"),
+						},
+					},
+					"items": {
+						SchemaProps: spec.SchemaProps{
+							Description: "items contains a list of VolumeSnapshot.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshot"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"items"},
+			},
+		},
+		Dependencies: []string{
+			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshot", "k8s.io/apimachinery/pkg/apis/meta/v1.ListMeta"},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeSnapshotMetadata(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is the name of the metadata",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "value is the value of the metadata",
+							Default:     "",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+				Required: []string{"name", "value"},
+			},
+		},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeSnapshotMetadataStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name is the name of the metadata",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"value": {
+						SchemaProps: spec.SchemaProps{
+							Description: "value is the value of the metadata",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeSnapshotResourceSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeSnapshotResourceSpec contains the desired state of the resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"name": {
+						SchemaProps: spec.SchemaProps{
+							Description: "name will be the name of the created resource. If not specified, the name of the ORC object will be used.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"description": {
+						SchemaProps: spec.SchemaProps{
+							Description: "description is a human-readable description for the resource.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"volumeRef": {
+						SchemaProps: spec.SchemaProps{
+							Description: "volumeRef is a reference to the ORC Volume to snapshot.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"force": {
+						SchemaProps: spec.SchemaProps{
+							Description: "force allows the snapshot of a volume which is attached to a server to be created. The snapshot of an attached volume may not be consistent.",
+							Type:        []string{"boolean"},
+							Format:      "",
+						},
+					},
+					"metadata": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "atomic",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "metadata key and value pairs to be associated with the snapshot.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshotMetadata"),
+									},
+								},
+							},
+						},
+					},
+				},
+				Required: []string{"volumeRef"},
+			},
 		},
 		Dependencies: []string{
-			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeMetadata"},
+			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshotMetadata"},
 	}
 }
 
-func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeResourceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeSnapshotResourceStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
 	return common.OpenAPIDefinition{
 		Schema: spec.Schema{
 			SchemaProps: spec.SchemaProps{
-				Description: "VolumeResourceStatus represents the observed state of the resource.",
+				Description: "VolumeSnapshotResourceStatus represents the observed state of the resource.",
 				Type:        []string{"object"},
 				Properties: map[string]spec.Schema{
 					"name": {
@@ -13911,173 +15765,221 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeResourceStatus(r
 							Format:      "",
 						},
 					},
+					"volumeID": {
+						SchemaProps: spec.SchemaProps{
+							Description: "volumeID is the ID of the volume from which the snapshot was created.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 					"size": {
 						SchemaProps: spec.SchemaProps{
-							Description: "size is the size of the volume in GiB.",
+							Description: "size is the size of the snapshot in GiB.",
 							Type:        []string{"integer"},
 							Format:      "int32",
 						},
 					},
 					"status": {
 						SchemaProps: spec.SchemaProps{
-							Description: "status represents the current status of the volume.",
+							Description: "status represents the current status of the snapshot.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"availabilityZone": {
+					"progress": {
 						SchemaProps: spec.SchemaProps{
-							Description: "availabilityZone is which availability zone the volume is in.",
+							Description: "progress is the percentage of the snapshot which has been created.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"attachments": {
+					"metadata": {
 						VendorExtensible: spec.VendorExtensible{
 							Extensions: spec.Extensions{
 								"x-kubernetes-list-type": "atomic",
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "attachments is a list of attachments for the volume.",
+							Description: "metadata key and value pairs associated with the snapshot.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
 									SchemaProps: spec.SchemaProps{
 										Default: map[string]interface{}{},
-										Ref:     ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeAttachmentStatus"),
+										Ref:     ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshotMetadataStatus"),
 									},
 								},
 							},
 						},
 					},
-					"volumeType": {
+					"projectID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "volumeType is the name of associated the volume type.",
+							Description: "projectID is the ID of the project that owns the snapshot.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"snapshotID": {
+					"userID": {
 						SchemaProps: spec.SchemaProps{
-							Description: "snapshotID is the ID of the snapshot from which the volume was created",
+							Description: "userID is the ID of the user who created the snapshot.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"sourceVolID": {
+					"createdAt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "sourceVolID is the ID of another block storage volume from which the current volume was created",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "createdAt shows the date and time when the resource was created. The date and time stamp format is ISO 8601",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"backupID": {
+					"updatedAt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "backupID is the ID of the backup from which the volume was restored",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "updatedAt shows the date and time when the resource was updated. The date and time stamp format is ISO 8601",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"metadata": {
-						VendorExtensible: spec.VendorExtensible{
-							Extensions: spec.Extensions{
-								"x-kubernetes-list-type": "atomic",
-							},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshotMetadataStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeSnapshotSpec(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeSnapshotSpec defines the desired state of an ORC object.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"import": {
+						SchemaProps: spec.SchemaProps{
+							Description: "import refers to an existing OpenStack resource which will be imported instead of creating a new one.",
+							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshotImport"),
 						},
+					},
+					"resource": {
 						SchemaProps: spec.SchemaProps{
-							Description: "metadata key and value pairs to be associated with the volume.",
-							Type:        []string{"array"},
-							Items: &spec.SchemaOrArray{
-								Schema: &spec.Schema{
-									SchemaProps: spec.SchemaProps{
-										Default: map[string]interface{}{},
-										Ref:     ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeMetadataStatus"),
-									},
-								},
-							},
+							Description: "resource specifies the desired state of the resource.\n\nresource may not be specified if the management policy is `unmanaged`.\n\nresource must be specified if the management policy is `managed`.",
+							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshotResourceSpec"),
 						},
 					},
-					"userID": {
+					"managementPolicy": {
 						SchemaProps: spec.SchemaProps{
-							Description: "userID is the ID of the user who created the volume.",
+							Description: "managementPolicy defines how ORC will treat the object. Valid values are `managed`: ORC will create, update, and delete the resource; `unmanaged`: ORC will import an existing resource, and will not apply updates to it or delete it.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"bootable": {
+					"managedOptions": {
 						SchemaProps: spec.SchemaProps{
-							Description: "bootable indicates whether this is a bootable volume.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "managedOptions specifies options which may be applied to managed objects.",
+							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.ManagedOptions"),
 						},
 					},
-					"imageID": {
+					"resyncPeriod": {
 						SchemaProps: spec.SchemaProps{
-							Description: "imageID is the ID of the image this volume was created from, if any.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "resyncPeriod defines how frequently the controller will re-reconcile this resource even when no changes have been detected. This overrides the global default resync period. The value must be a valid Go duration string, e.g. \"10m\", \"1h\". Set to \"0s\" to disable periodic resync for this resource. Very low values may cause excessive OpenStack API load.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Duration"),
 						},
 					},
-					"encrypted": {
+					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "encrypted denotes if the volume is encrypted.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
+							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
-					"replicationStatus": {
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference", "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.ManagedOptions", "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshotImport", "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshotResourceSpec", "k8s.io/apimachinery/pkg/apis/meta/v1.Duration"},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeSnapshotStatus(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Description: "VolumeSnapshotStatus defines the observed state of an ORC resource.",
+				Type:        []string{"object"},
+				Properties: map[string]spec.Schema{
+					"conditions": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-map-keys": []interface{}{
+									"type",
+								},
+								"x-kubernetes-list-type":       "map",
+								"x-kubernetes-patch-merge-key": "type",
+								"x-kubernetes-patch-strategy":  "merge",
+							},
+						},
 						SchemaProps: spec.SchemaProps{
-							Description: "replicationStatus is the status of replication.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: map[string]interface{}{},
+										Ref:     ref("k8s.io/apimachinery/pkg/apis/meta/v1.Condition"),
+									},
+								},
+							},
 						},
 					},
-					"consistencyGroupID": {
+					"id": {
 						SchemaProps: spec.SchemaProps{
-							Description: "consistencyGroupID is the consistency group ID.",
+							Description: "id is the unique identifier of the OpenStack resource.",
 							Type:        []string{"string"},
 							Format:      "",
 						},
 					},
-					"multiattach": {
+					"resource": {
 						SchemaProps: spec.SchemaProps{
-							Description: "multiattach denotes if the volume is multi-attach capable.",
-							Type:        []string{"boolean"},
-							Format:      "",
+							Description: "resource contains the observed state of the OpenStack resource.",
+							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshotResourceStatus"),
 						},
 					},
-					"host": {
+					"lastSyncTime": {
 						SchemaProps: spec.SchemaProps{
-							Description: "host is the identifier of the host holding the volume.",
-							Type:        []string{"string"},
-							Format:      "",
+							Description: "lastSyncTime is the timestamp of the last successful reconciliation that fetched state from OpenStack. It is updated each time the controller successfully reads the resource state from the OpenStack API.",
+							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
-					"tenantID": {
-						SchemaProps: spec.SchemaProps{
-							Description: "tenantID is the ID of the project that owns the volume.",
-							Type:        []string{"string"},
-							Format:      "",
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
 						},
-					},
-					"createdAt": {
 						SchemaProps: spec.SchemaProps{
-							Description: "createdAt shows the date and time when the resource was created. The date and time stamp format is ISO 8601",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
 						},
 					},
-					"updatedAt": {
+					"openStackCloudName": {
 						SchemaProps: spec.SchemaProps{
-							Description: "updatedAt shows the date and time when the resource was updated. The date and time stamp format is ISO 8601",
-							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
 						},
 					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeAttachmentStatus", "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeMetadataStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeSnapshotResourceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
 	}
 }
 
@@ -14121,13 +16023,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeSpec(ref common.
 					},
 					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials",
-							Default:     map[string]interface{}{},
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
 							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
 				},
-				Required: []string{"cloudCredentialsRef"},
 			},
 		},
 		Dependencies: []string{
@@ -14154,7 +16054,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeStatus(ref commo
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -14185,11 +16085,64 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeStatus(ref commo
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
+					"lastRetype": {
+						SchemaProps: spec.SchemaProps{
+							Description: "lastRetype is the most recent retype requested by the controller. It is used to detect a retype which Cinder accepted but did not perform.",
+							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeRetypeAttempt"),
+						},
+					},
+				},
+			},
+		},
+		Dependencies: []string{
+			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeResourceStatus", "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeRetypeAttempt", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+	}
+}
+
+func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeStatusExtra(ref common.ReferenceCallback) common.OpenAPIDefinition {
+	return common.OpenAPIDefinition{
+		Schema: spec.Schema{
+			SchemaProps: spec.SchemaProps{
+				Type: []string{"object"},
+				Properties: map[string]spec.Schema{
+					"lastRetype": {
+						SchemaProps: spec.SchemaProps{
+							Description: "lastRetype is the most recent retype requested by the controller. It is used to detect a retype which Cinder accepted but did not perform.",
+							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeRetypeAttempt"),
+						},
+					},
 				},
 			},
 		},
 		Dependencies: []string{
-			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeResourceStatus", "k8s.io/apimachinery/pkg/apis/meta/v1.Condition", "k8s.io/apimachinery/pkg/apis/meta/v1.Time"},
+			"github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.VolumeRetypeAttempt"},
 	}
 }
 
@@ -14562,13 +16515,11 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeTypeSpec(ref com
 					},
 					"cloudCredentialsRef": {
 						SchemaProps: spec.SchemaProps{
-							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials",
-							Default:     map[string]interface{}{},
+							Description: "cloudCredentialsRef points to a secret containing OpenStack credentials. If not specified, the object uses the credentials of the OpenStackCloud which allows its namespace.",
 							Ref:         ref("github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1.CloudCredentialsReference"),
 						},
 					},
 				},
-				Required: []string{"cloudCredentialsRef"},
 			},
 		},
 		Dependencies: []string{
@@ -14595,7 +16546,7 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeTypeStatus(ref c
 							},
 						},
 						SchemaProps: spec.SchemaProps{
-							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.",
+							Description: "conditions represents the observed status of the object. Known .status.conditions.type are: \"Available\", \"Progressing\", \"Drifted\", \"CredentialsFailing\"\n\nAvailable represents the availability of the OpenStack resource. If it is true then the resource is ready for use.\n\nProgressing indicates whether the controller is still attempting to reconcile the current state of the OpenStack resource to the desired state. Progressing will be False either because the desired state has been achieved, or because some terminal error prevents it from ever being achieved and the controller is no longer attempting to reconcile. If Progressing is True, an observer waiting on the resource should continue to wait.\n\nDrifted is only present on managed objects whose drift policy is `report`. If it is True, the OpenStack resource does not match the desired state and status.drift lists the fields which differ.\n\nCredentialsFailing is only present while OpenStack rejects the credentials used to reconcile the object. It is removed once the object has been reconciled with working credentials.",
 							Type:        []string{"array"},
 							Items: &spec.SchemaOrArray{
 								Schema: &spec.Schema{
@@ -14626,6 +16577,33 @@ func schema_openstack_resource_controller_v2_api_v1alpha1_VolumeTypeStatus(ref c
 							Ref:         ref("k8s.io/apimachinery/pkg/apis/meta/v1.Time"),
 						},
 					},
+					"drift": {
+						VendorExtensible: spec.VendorExtensible{
+							Extensions: spec.Extensions{
+								"x-kubernetes-list-type": "set",
+							},
+						},
+						SchemaProps: spec.SchemaProps{
+							Description: "drift contains the paths of spec fields whose desired value does not match the observed state of the OpenStack resource. It is only populated for managed objects whose drift policy is `report`.",
+							Type:        []string{"array"},
+							Items: &spec.SchemaOrArray{
+								Schema: &spec.Schema{
+									SchemaProps: spec.SchemaProps{
+										Default: "",
+										Type:    []string{"string"},
+										Format:  "",
+									},
+								},
+							},
+						},
+					},
+					"openStackCloudName": {
+						SchemaProps: spec.SchemaProps{
+							Description: "openStackCloudName is the name of the OpenStackCloud whose credentials are used by the object. It is only set when cloudCredentialsRef is not specified. Once the object has an OpenStack resource, the object will not use the credentials of a different OpenStackCloud.",
+							Type:        []string{"string"},
+							Format:      "",
+						},
+					},
 				},
 			},
 		},
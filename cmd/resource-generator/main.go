@@ -71,6 +71,11 @@ type templateFields struct {
 	// OpenStack-assigned ID. When true, the generator omits import.id,
 	// status.ID, and the ID print column from the generated API types.
 	NoResourceID bool
+	// ClusterScoped indicates that this is a cluster-scoped resource.
+	ClusterScoped bool
+	// NoController indicates that this resource has no controller, and
+	// therefore no controller tests and no OpenStack client.
+	NoController bool
 }
 
 var resources []templateFields = []templateFields{
@@ -161,7 +166,14 @@ var resources []templateFields = []templateFields{
 		Name: "User",
 	},
 	{
-		Name: "Volume",
+		Name:            "Volume",
+		StatusExtraType: "VolumeStatusExtra",
+	},
+	{
+		Name: "VolumeBackup",
+	},
+	{
+		Name: "VolumeSnapshot",
 	},
 	{
 		Name: "VolumeType",
@@ -193,6 +205,11 @@ var resources []templateFields = []templateFields{
 
 // These resources won't be generated
 var specialResources []templateFields = []templateFields{
+	{
+		Name:          "OpenStackCloud",
+		ClusterScoped: true,
+		NoController:  true,
+	},
 	{
 		Name:             "RouterInterface",
 		ExistingOSClient: true,
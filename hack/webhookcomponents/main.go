@@ -0,0 +1,134 @@
+// webhookcomponents splits the ValidatingWebhookConfiguration generated by
+// controller-gen into one kustomize component per webhook, so that the
+// webhooks registered with the API server match the webhooks enabled with the
+// manager's --webhooks flag.
+//
+// Usage: webhookcomponents <config/webhook directory>
+package main
+
+import (
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+)
+
+const header = "# Code generated by hack/webhookcomponents. DO NOT EDIT.\n"
+
+const componentKustomization = header + `# Registers the %[1]s validating webhook and enables it in the manager.
+apiVersion: kustomize.config.k8s.io/v1alpha1
+kind: Component
+
+patches:
+- path: webhook_patch.yaml
+  target:
+    kind: ValidatingWebhookConfiguration
+    name: %[2]s
+- path: manager_patch.yaml
+  target:
+    kind: Deployment
+`
+
+type jsonPatch struct {
+	Op    string `json:"op"`
+	Path  string `json:"path"`
+	Value any    `json:"value"`
+}
+
+func main() {
+	if len(os.Args) != 2 {
+		fmt.Fprintln(os.Stderr, "usage: webhookcomponents <config/webhook directory>")
+		os.Exit(1)
+	}
+
+	if err := run(os.Args[1]); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+func run(dir string) error {
+	b, err := os.ReadFile(filepath.Join(dir, "manifests.yaml"))
+	if err != nil {
+		return err
+	}
+
+	var configuration struct {
+		APIVersion string `json:"apiVersion"`
+		Kind       string `json:"kind"`
+		Metadata   struct {
+			Name string `json:"name"`
+		} `json:"metadata"`
+		Webhooks []map[string]any `json:"webhooks"`
+	}
+	if err := yaml.Unmarshal(b, &configuration); err != nil {
+		return fmt.Errorf("parsing manifests.yaml: %w", err)
+	}
+
+	// The base contains the configuration without any webhooks. Each
+	// component appends its own.
+	base := map[string]any{
+		"apiVersion": configuration.APIVersion,
+		"kind":       configuration.Kind,
+		"metadata":   map[string]any{"name": configuration.Metadata.Name},
+		"webhooks":   []any{},
+	}
+	if err := writeYAML(filepath.Join(dir, "validating_webhook_configuration.yaml"), base); err != nil {
+		return err
+	}
+
+	for _, webhook := range configuration.Webhooks {
+		name, err := webhookName(webhook)
+		if err != nil {
+			return err
+		}
+
+		componentDir := filepath.Join(dir, name)
+		if err := os.MkdirAll(componentDir, 0o755); err != nil {
+			return err
+		}
+
+		kustomization := fmt.Sprintf(componentKustomization, name, configuration.Metadata.Name)
+		if err := os.WriteFile(filepath.Join(componentDir, "kustomization.yaml"), []byte(kustomization), 0o644); err != nil {
+			return err
+		}
+
+		webhookPatch := []jsonPatch{{Op: "add", Path: "/webhooks/-", Value: webhook}}
+		if err := writeYAML(filepath.Join(componentDir, "webhook_patch.yaml"), webhookPatch); err != nil {
+			return err
+		}
+
+		managerPatch := []jsonPatch{{Op: "add", Path: "/spec/template/spec/containers/0/args/-", Value: "--webhooks=" + name}}
+		if err := writeYAML(filepath.Join(componentDir, "manager_patch.yaml"), managerPatch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// webhookName returns the name of the webhook as accepted by the --webhooks
+// flag. It is the last element of the webhook's path, e.g.
+// /validate-openstack-k-orc-cloud-v1alpha1-subnet.
+func webhookName(webhook map[string]any) (string, error) {
+	clientConfig, _ := webhook["clientConfig"].(map[string]any)
+	service, _ := clientConfig["service"].(map[string]any)
+	webhookPath, _ := service["path"].(string)
+	if webhookPath == "" {
+		return "", fmt.Errorf("webhook %v has no service path", webhook["name"])
+	}
+
+	base := path.Base(webhookPath)
+	return base[strings.LastIndex(base, "-")+1:], nil
+}
+
+func writeYAML(filename string, obj any) error {
+	b, err := yaml.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filename, append([]byte(header), b...), 0o644)
+}
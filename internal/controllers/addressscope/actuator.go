@@ -32,6 +32,7 @@ import (
 	"github.com/k-orc/openstack-resource-controller/v2/internal/logging"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/osclients"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dependency"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dryrun"
 	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
 )
 
@@ -193,6 +194,7 @@ func (actuator addressscopeActuator) updateResource(ctx context.Context, obj orc
 		return nil
 	}
 
+	ctx = dryrun.WithAction(ctx, "update OpenStack "+controllerName)
 	_, err = actuator.osClient.UpdateAddressScope(ctx, osResource.ID, updateOpts)
 
 	if err != nil {
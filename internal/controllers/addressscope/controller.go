@@ -19,7 +19,6 @@ package addressscope
 import (
 	"context"
 	"errors"
-	"time"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -41,8 +40,8 @@ const controllerName = "addressscope"
 // +kubebuilder:rbac:groups=openstack.k-orc.cloud,resources=addressscopes/status,verbs=get;update;patch
 
 type addressscopeReconcilerConstructor struct {
-	scopeFactory        scope.Factory
-	defaultResyncPeriod time.Duration
+	scopeFactory scope.Factory
+	defaults     interfaces.ControllerDefaults
 }
 
 func New(scopeFactory scope.Factory) interfaces.Controller {
@@ -53,8 +52,8 @@ func (addressscopeReconcilerConstructor) GetName() string {
 	return controllerName
 }
 
-func (c *addressscopeReconcilerConstructor) SetDefaultResyncPeriod(d time.Duration) {
-	c.defaultResyncPeriod = d
+func (c *addressscopeReconcilerConstructor) SetDefaults(d interfaces.ControllerDefaults) {
+	c.defaults = d
 }
 
 var projectDependency = dependency.NewDeletionGuardDependency[*orcv1alpha1.AddressScopeList, *orcv1alpha1.Project](
@@ -115,6 +114,6 @@ func (c *addressscopeReconcilerConstructor) SetupWithManager(ctx context.Context
 		return err
 	}
 
-	r := reconciler.NewController(controllerName, mgr.GetClient(), c.scopeFactory, addressscopeHelperFactory{}, addressscopeStatusWriter{}, c.defaultResyncPeriod)
+	r := reconciler.NewController(controllerName, mgr.GetClient(), mgr.GetEventRecorderFor(controllerName), c.scopeFactory, addressscopeHelperFactory{}, addressscopeStatusWriter{}, c.defaults)
 	return builder.Complete(&r)
 }
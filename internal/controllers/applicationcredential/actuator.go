@@ -17,12 +17,16 @@ limitations under the License.
 package applicationcredential
 
 import (
+	"bytes"
+	"cmp"
 	"context"
 	"fmt"
 	"iter"
 
 	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/applicationcredentials"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	corev1ac "k8s.io/client-go/applyconfigurations/core/v1"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -30,18 +34,25 @@ import (
 	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/interfaces"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/progress"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/logging"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/osclients"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/scope"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/applyconfigs"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dependency"
 	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
+	orcstrings "github.com/k-orc/openstack-resource-controller/v2/internal/util/strings"
 )
 
 // OpenStack resource types
 type (
 	osResourceT = applicationcredentials.ApplicationCredential
 
-	createResourceActuator = interfaces.CreateResourceActuator[orcObjectPT, orcObjectT, filterT, osResourceT]
-	deleteResourceActuator = interfaces.DeleteResourceActuator[orcObjectPT, orcObjectT, osResourceT]
-	helperFactory          = interfaces.ResourceHelperFactory[orcObjectPT, orcObjectT, resourceSpecT, filterT, osResourceT]
+	createResourceActuator    = interfaces.CreateResourceActuator[orcObjectPT, orcObjectT, filterT, osResourceT]
+	deleteResourceActuator    = interfaces.DeleteResourceActuator[orcObjectPT, orcObjectT, osResourceT]
+	reconcileResourceActuator = interfaces.ReconcileResourceActuator[orcObjectPT, osResourceT]
+	driftReportActuator       = interfaces.DriftReportResourceActuator[orcObjectPT, osResourceT]
+	resourceReconciler        = interfaces.ResourceReconciler[orcObjectPT, osResourceT]
+	helperFactory             = interfaces.ResourceHelperFactory[orcObjectPT, orcObjectT, resourceSpecT, filterT, osResourceT]
 )
 
 type applicationcredentialActuator struct {
@@ -51,6 +62,8 @@ type applicationcredentialActuator struct {
 
 var _ createResourceActuator = applicationcredentialActuator{}
 var _ deleteResourceActuator = applicationcredentialActuator{}
+var _ reconcileResourceActuator = applicationcredentialActuator{}
+var _ driftReportActuator = applicationcredentialActuator{}
 
 func (applicationcredentialActuator) GetResourceID(osResource *osResourceT) string {
 	return osResource.ID
@@ -256,6 +269,138 @@ func (actuator applicationcredentialActuator) DeleteResource(ctx context.Context
 	return progress.WrapError(actuator.osClient.DeleteApplicationCredential(ctx, ptr.Deref(user.Status.ID, ""), resource.ID))
 }
 
+func (actuator applicationcredentialActuator) GetResourceReconcilers(ctx context.Context, orcObject orcObjectPT, osResource *osResourceT, controller interfaces.ResourceController) ([]resourceReconciler, progress.ReconcileStatus) {
+	return []resourceReconciler{
+		actuator.reconcileCredentialsSecret,
+	}, nil
+}
+
+// GetDriftReportReconcilers writes the credentials secret even when drift is
+// only being reported, as it does not modify the application credential.
+func (actuator applicationcredentialActuator) GetDriftReportReconcilers(ctx context.Context, orcObject orcObjectPT, osResource *osResourceT, controller interfaces.ResourceController) ([]resourceReconciler, progress.ReconcileStatus) {
+	return []resourceReconciler{
+		actuator.reconcileCredentialsSecret,
+	}, nil
+}
+
+// reconcileCredentialsSecret writes the credentials of the application
+// credential to its secret, alongside the secret value, so that the secret can
+// be used as cloud credentials by other ORC objects.
+//
+// ORC will not write to a secret which already contains cloud credentials
+// which it did not write for this application credential. This prevents an
+// application credential from overwriting the credentials in another secret,
+// including its own cloud credentials.
+func (actuator applicationcredentialActuator) reconcileCredentialsSecret(ctx context.Context, obj orcObjectPT, osResource *osResourceT) progress.ReconcileStatus {
+	log := ctrl.LoggerFrom(ctx)
+
+	// We don't know the secret of an imported application credential
+	if obj.Spec.Import != nil {
+		return nil
+	}
+
+	resource := obj.Spec.Resource
+	if resource == nil {
+		// Should have been caught by API validation
+		return progress.WrapError(
+			orcerrors.Terminal(orcv1alpha1.ConditionReasonInvalidConfiguration, "Update requested, but spec.resource is not set"))
+	}
+
+	secret, reconcileStatus := dependency.FetchDependency(
+		ctx, actuator.k8sClient, obj.Namespace,
+		&resource.SecretRef, "Secret",
+		func(*corev1.Secret) bool { return true }, // Secrets don't have availability status
+	)
+	if needsReschedule, _ := reconcileStatus.NeedsReschedule(); needsReschedule {
+		return reconcileStatus
+	}
+
+	owner, hasOwner := secret.Annotations[orcv1alpha1.CloudCredentialsApplicationCredentialAnnotation]
+	if hasOwner && owner != obj.Name {
+		return progress.WrapError(
+			orcerrors.Terminal(orcv1alpha1.ConditionReasonInvalidConfiguration,
+				fmt.Sprintf("secret %s contains the credentials of ApplicationCredential %s", secret.Name, owner)))
+	}
+	if !hasOwner && hasCloudCredentials(secret) {
+		return progress.WrapError(
+			orcerrors.Terminal(orcv1alpha1.ConditionReasonInvalidConfiguration,
+				fmt.Sprintf("secret %s already contains cloud credentials which were not written by ORC", secret.Name)))
+	}
+
+	value, ok := secret.Data["value"]
+	if !ok {
+		return progress.NewReconcileStatus().WithProgressMessage("Application credential secret does not contain \"value\" key")
+	}
+
+	data, err := actuator.credentialsSecretData(ctx, obj, osResource, value)
+	if err != nil {
+		return progress.WrapError(err)
+	}
+
+	needsUpdate := !hasOwner
+	for key, v := range data {
+		if !bytes.Equal(secret.Data[key], v) {
+			needsUpdate = true
+			break
+		}
+	}
+	if !needsUpdate {
+		log.V(logging.Debug).Info("Credentials secret is up to date")
+		return nil
+	}
+
+	log.V(logging.Info).Info("Writing credentials to secret", "secret", secret.Name)
+	applyConfig := corev1ac.Secret(secret.Name, secret.Namespace).
+		WithAnnotations(map[string]string{orcv1alpha1.CloudCredentialsApplicationCredentialAnnotation: obj.Name}).
+		WithData(data)
+	return progress.WrapError(actuator.k8sClient.Patch(ctx, secret,
+		applyconfigs.Patch(types.ApplyPatchType, applyConfig),
+		client.ForceOwnership, orcstrings.GetSSAFieldOwnerWithTxn(controllerName, orcstrings.SSATransactionCredentials)))
+}
+
+// hasCloudCredentials returns true if secret can be used as cloud credentials.
+func hasCloudCredentials(secret *corev1.Secret) bool {
+	for _, key := range []string{
+		orcv1alpha1.CloudCredentialsConfigSecretKey,
+		orcv1alpha1.CloudCredentialsAuthURLSecretKey,
+	} {
+		if _, ok := secret.Data[key]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// credentialsSecretData returns the cloud credentials secret keys which
+// authenticate with the application credential. The endpoint of the cloud is
+// taken from the credentials used to create the application credential.
+func (actuator applicationcredentialActuator) credentialsSecretData(ctx context.Context, obj orcObjectPT, osResource *osResourceT, value []byte) (map[string][]byte, error) {
+	cloud, caCert, err := scope.CloudFromObject(ctx, actuator.k8sClient, obj)
+	if err != nil {
+		return nil, err
+	}
+	if cloud.AuthInfo == nil || cloud.AuthInfo.AuthURL == "" {
+		return nil, orcerrors.Terminal(orcv1alpha1.ConditionReasonInvalidConfiguration,
+			"cloud credentials do not define an auth URL")
+	}
+
+	data := map[string][]byte{
+		orcv1alpha1.CloudCredentialsAuthURLSecretKey:                     []byte(cloud.AuthInfo.AuthURL),
+		orcv1alpha1.CloudCredentialsApplicationCredentialIDSecretKey:     []byte(osResource.ID),
+		orcv1alpha1.CloudCredentialsApplicationCredentialSecretSecretKey: value,
+	}
+	if cloud.RegionName != "" {
+		data[orcv1alpha1.CloudCredentialsRegionNameSecretKey] = []byte(cloud.RegionName)
+	}
+	if endpointType := cmp.Or(cloud.EndpointType, cloud.Interface); endpointType != "" {
+		data[orcv1alpha1.CloudCredentialsInterfaceSecretKey] = []byte(endpointType)
+	}
+	if caCert != nil {
+		data[orcv1alpha1.CloudCredencialsCASecretKey] = caCert
+	}
+	return data, nil
+}
+
 type applicationcredentialHelperFactory struct{}
 
 var _ helperFactory = applicationcredentialHelperFactory{}
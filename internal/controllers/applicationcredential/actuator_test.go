@@ -0,0 +1,200 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationcredential
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/applicationcredentials"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
+)
+
+func TestReconcileCredentialsSecret(t *testing.T) {
+	const (
+		namespace  = "test-ns"
+		secretName = "appcred-secret"
+		authURL    = "https://keystone.example.com:5000/v3"
+	)
+
+	newAppCred := func(modify func(*orcv1alpha1.ApplicationCredential)) *orcv1alpha1.ApplicationCredential {
+		appCred := &orcv1alpha1.ApplicationCredential{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "test-appcred",
+				Namespace: namespace,
+			},
+			Spec: orcv1alpha1.ApplicationCredentialSpec{
+				CloudCredentialsRef: &orcv1alpha1.CloudCredentialsReference{
+					SecretName: "openstack-credentials",
+				},
+				ManagementPolicy: orcv1alpha1.ManagementPolicyManaged,
+				Resource: &orcv1alpha1.ApplicationCredentialResourceSpec{
+					SecretRef: secretName,
+				},
+			},
+		}
+		if modify != nil {
+			modify(appCred)
+		}
+		return appCred
+	}
+
+	cloudCredentials := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "openstack-credentials",
+			Namespace: namespace,
+		},
+		Data: map[string][]byte{
+			orcv1alpha1.CloudCredentialsAuthURLSecretKey:    []byte(authURL),
+			orcv1alpha1.CloudCredentialsUsernameSecretKey:   []byte("admin"),
+			orcv1alpha1.CloudCredentialsPasswordSecretKey:   []byte("password"),
+			orcv1alpha1.CloudCredentialsRegionNameSecretKey: []byte("RegionOne"),
+		},
+	}
+
+	wantData := map[string][]byte{
+		"value": []byte("appcred-value"),
+		orcv1alpha1.CloudCredentialsAuthURLSecretKey:                     []byte(authURL),
+		orcv1alpha1.CloudCredentialsApplicationCredentialIDSecretKey:     []byte("appcred-id"),
+		orcv1alpha1.CloudCredentialsApplicationCredentialSecretSecretKey: []byte("appcred-value"),
+		orcv1alpha1.CloudCredentialsRegionNameSecretKey:                  []byte("RegionOne"),
+	}
+	ownedAnnotations := map[string]string{orcv1alpha1.CloudCredentialsApplicationCredentialAnnotation: "test-appcred"}
+
+	testCases := []struct {
+		name            string
+		orcObject       *orcv1alpha1.ApplicationCredential
+		secret          *corev1.Secret
+		wantTerminal    bool
+		wantData        map[string][]byte
+		wantAnnotations map[string]string
+	}{
+		{
+			name:      "Credentials are written to a new secret",
+			orcObject: newAppCred(nil),
+			secret: &corev1.Secret{
+				Data: map[string][]byte{"value": []byte("appcred-value")},
+			},
+			wantData:        wantData,
+			wantAnnotations: ownedAnnotations,
+		},
+		{
+			name:      "Outdated credentials are updated",
+			orcObject: newAppCred(nil),
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Annotations: ownedAnnotations},
+				Data: func() map[string][]byte {
+					data := maps.Clone(wantData)
+					data[orcv1alpha1.CloudCredentialsApplicationCredentialIDSecretKey] = []byte("previous-id")
+					return data
+				}(),
+			},
+			wantData:        wantData,
+			wantAnnotations: ownedAnnotations,
+		},
+		{
+			name:      "Secret owned by another ApplicationCredential is not modified",
+			orcObject: newAppCred(nil),
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Annotations: map[string]string{orcv1alpha1.CloudCredentialsApplicationCredentialAnnotation: "other-appcred"},
+				},
+				Data: map[string][]byte{"value": []byte("appcred-value")},
+			},
+			wantTerminal:    true,
+			wantData:        map[string][]byte{"value": []byte("appcred-value")},
+			wantAnnotations: map[string]string{orcv1alpha1.CloudCredentialsApplicationCredentialAnnotation: "other-appcred"},
+		},
+		{
+			name:      "Secret containing other credentials is not modified",
+			orcObject: newAppCred(nil),
+			secret: &corev1.Secret{
+				Data: map[string][]byte{
+					"value": []byte("appcred-value"),
+					orcv1alpha1.CloudCredentialsConfigSecretKey: []byte("clouds: {}"),
+				},
+			},
+			wantTerminal: true,
+			wantData: map[string][]byte{
+				"value": []byte("appcred-value"),
+				orcv1alpha1.CloudCredentialsConfigSecretKey: []byte("clouds: {}"),
+			},
+		},
+		{
+			name: "Imported application credential does not write a secret",
+			orcObject: newAppCred(func(appCred *orcv1alpha1.ApplicationCredential) {
+				appCred.Spec.ManagementPolicy = orcv1alpha1.ManagementPolicyUnmanaged
+				appCred.Spec.Resource = nil
+				appCred.Spec.Import = &orcv1alpha1.ApplicationCredentialImport{ID: ptr.To("appcred-id")}
+			}),
+			secret: &corev1.Secret{
+				Data: map[string][]byte{"value": []byte("appcred-value")},
+			},
+			wantData: map[string][]byte{"value": []byte("appcred-value")},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			_ = corev1.AddToScheme(scheme)
+			_ = orcv1alpha1.AddToScheme(scheme)
+
+			tt.secret.Name = secretName
+			tt.secret.Namespace = namespace
+
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tt.orcObject, cloudCredentials, tt.secret).
+				Build()
+
+			actuator := applicationcredentialActuator{k8sClient: k8sClient}
+			osResource := &applicationcredentials.ApplicationCredential{ID: "appcred-id"}
+
+			reconcileStatus := actuator.reconcileCredentialsSecret(context.TODO(), tt.orcObject, osResource)
+
+			var terminalError *orcerrors.TerminalError
+			err := reconcileStatus.GetError()
+			if gotTerminal := errors.As(err, &terminalError); gotTerminal != tt.wantTerminal {
+				t.Errorf("reconcileCredentialsSecret() error = %v, want terminal %t", err, tt.wantTerminal)
+			} else if !tt.wantTerminal && err != nil {
+				t.Errorf("reconcileCredentialsSecret() error = %v", err)
+			}
+
+			secret := &corev1.Secret{}
+			if err := k8sClient.Get(context.TODO(), types.NamespacedName{Namespace: namespace, Name: secretName}, secret); err != nil {
+				t.Fatal(err)
+			}
+			if !maps.EqualFunc(secret.Data, tt.wantData, func(a, b []byte) bool { return string(a) == string(b) }) {
+				t.Errorf("got secret data %q, want %q", secret.Data, tt.wantData)
+			}
+			if !maps.Equal(secret.Annotations, tt.wantAnnotations) {
+				t.Errorf("got secret annotations %v, want %v", secret.Annotations, tt.wantAnnotations)
+			}
+		})
+	}
+}
@@ -19,7 +19,6 @@ package applicationcredential
 import (
 	"context"
 	"errors"
-	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -95,8 +94,8 @@ var (
 )
 
 type applicationcredentialReconcilerConstructor struct {
-	scopeFactory        scope.Factory
-	defaultResyncPeriod time.Duration
+	scopeFactory scope.Factory
+	defaults     interfaces.ControllerDefaults
 }
 
 func New(scopeFactory scope.Factory) interfaces.Controller {
@@ -107,8 +106,8 @@ func (applicationcredentialReconcilerConstructor) GetName() string {
 	return controllerName
 }
 
-func (c *applicationcredentialReconcilerConstructor) SetDefaultResyncPeriod(d time.Duration) {
-	c.defaultResyncPeriod = d
+func (c *applicationcredentialReconcilerConstructor) SetDefaults(d interfaces.ControllerDefaults) {
+	c.defaults = d
 }
 
 var userDependency = dependency.NewDeletionGuardDependency[*orcv1alpha1.ApplicationCredentialList, *orcv1alpha1.User](
@@ -201,6 +200,6 @@ func (c *applicationcredentialReconcilerConstructor) SetupWithManager(ctx contex
 		return err
 	}
 
-	r := reconciler.NewController(controllerName, mgr.GetClient(), c.scopeFactory, applicationcredentialHelperFactory{}, applicationcredentialStatusWriter{}, c.defaultResyncPeriod)
+	r := reconciler.NewController(controllerName, mgr.GetClient(), mgr.GetEventRecorderFor(controllerName), c.scopeFactory, applicationcredentialHelperFactory{}, applicationcredentialStatusWriter{}, c.defaults)
 	return builder.Complete(&r)
 }
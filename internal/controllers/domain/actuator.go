@@ -31,6 +31,7 @@ import (
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/progress"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/logging"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/osclients"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dryrun"
 	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
 )
 
@@ -142,6 +143,7 @@ func (actuator domainActuator) updateResource(ctx context.Context, obj orcObject
 		return nil
 	}
 
+	ctx = dryrun.WithAction(ctx, "update OpenStack "+controllerName)
 	_, err = actuator.osClient.UpdateDomain(ctx, osResource.ID, updateOpts)
 
 	if err != nil {
@@ -19,7 +19,6 @@ package domain
 import (
 	"context"
 	"errors"
-	"time"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -38,8 +37,8 @@ const controllerName = "domain"
 // +kubebuilder:rbac:groups=openstack.k-orc.cloud,resources=domains/status,verbs=get;update;patch
 
 type domainReconcilerConstructor struct {
-	scopeFactory        scope.Factory
-	defaultResyncPeriod time.Duration
+	scopeFactory scope.Factory
+	defaults     interfaces.ControllerDefaults
 }
 
 func New(scopeFactory scope.Factory) interfaces.Controller {
@@ -50,8 +49,8 @@ func (domainReconcilerConstructor) GetName() string {
 	return controllerName
 }
 
-func (c *domainReconcilerConstructor) SetDefaultResyncPeriod(d time.Duration) {
-	c.defaultResyncPeriod = d
+func (c *domainReconcilerConstructor) SetDefaults(d interfaces.ControllerDefaults) {
+	c.defaults = d
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -69,6 +68,6 @@ func (c *domainReconcilerConstructor) SetupWithManager(ctx context.Context, mgr
 		return err
 	}
 
-	r := reconciler.NewController(controllerName, mgr.GetClient(), c.scopeFactory, domainHelperFactory{}, domainStatusWriter{}, c.defaultResyncPeriod)
+	r := reconciler.NewController(controllerName, mgr.GetClient(), mgr.GetEventRecorderFor(controllerName), c.scopeFactory, domainHelperFactory{}, domainStatusWriter{}, c.defaults)
 	return builder.Complete(&r)
 }
@@ -33,6 +33,7 @@ import (
 	"github.com/k-orc/openstack-resource-controller/v2/internal/logging"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/osclients"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dependency"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dryrun"
 	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
 )
 
@@ -199,6 +200,7 @@ func (actuator endpointActuator) updateResource(ctx context.Context, obj orcObje
 		return nil
 	}
 
+	ctx = dryrun.WithAction(ctx, "update OpenStack "+controllerName)
 	_, err = actuator.osClient.UpdateEndpoint(ctx, osResource.ID, updateOpts)
 
 	if err != nil {
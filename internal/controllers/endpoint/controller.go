@@ -19,7 +19,6 @@ package endpoint
 import (
 	"context"
 	"errors"
-	"time"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -41,8 +40,8 @@ const controllerName = "endpoint"
 // +kubebuilder:rbac:groups=openstack.k-orc.cloud,resources=endpoints/status,verbs=get;update;patch
 
 type endpointReconcilerConstructor struct {
-	scopeFactory        scope.Factory
-	defaultResyncPeriod time.Duration
+	scopeFactory scope.Factory
+	defaults     interfaces.ControllerDefaults
 }
 
 func New(scopeFactory scope.Factory) interfaces.Controller {
@@ -53,8 +52,8 @@ func (endpointReconcilerConstructor) GetName() string {
 	return controllerName
 }
 
-func (c *endpointReconcilerConstructor) SetDefaultResyncPeriod(d time.Duration) {
-	c.defaultResyncPeriod = d
+func (c *endpointReconcilerConstructor) SetDefaults(d interfaces.ControllerDefaults) {
+	c.defaults = d
 }
 
 var serviceDependency = dependency.NewDeletionGuardDependency[*orcv1alpha1.EndpointList, *orcv1alpha1.Service](
@@ -115,6 +114,6 @@ func (c *endpointReconcilerConstructor) SetupWithManager(ctx context.Context, mg
 		return err
 	}
 
-	r := reconciler.NewController(controllerName, mgr.GetClient(), c.scopeFactory, endpointHelperFactory{}, endpointStatusWriter{}, c.defaultResyncPeriod)
+	r := reconciler.NewController(controllerName, mgr.GetClient(), mgr.GetEventRecorderFor(controllerName), c.scopeFactory, endpointHelperFactory{}, endpointStatusWriter{}, c.defaults)
 	return builder.Complete(&r)
 }
@@ -19,7 +19,6 @@ package flavor
 import (
 	"context"
 	"errors"
-	"time"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -38,8 +37,8 @@ const controllerName = "flavor"
 // +kubebuilder:rbac:groups=openstack.k-orc.cloud,resources=flavors/status,verbs=get;update;patch
 
 type flavorReconcilerConstructor struct {
-	scopeFactory        scope.Factory
-	defaultResyncPeriod time.Duration
+	scopeFactory scope.Factory
+	defaults     interfaces.ControllerDefaults
 }
 
 func New(scopeFactory scope.Factory) interfaces.Controller {
@@ -50,8 +49,8 @@ func (flavorReconcilerConstructor) GetName() string {
 	return controllerName
 }
 
-func (c *flavorReconcilerConstructor) SetDefaultResyncPeriod(d time.Duration) {
-	c.defaultResyncPeriod = d
+func (c *flavorReconcilerConstructor) SetDefaults(d interfaces.ControllerDefaults) {
+	c.defaults = d
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -69,6 +68,6 @@ func (c *flavorReconcilerConstructor) SetupWithManager(ctx context.Context, mgr
 		return err
 	}
 
-	r := reconciler.NewController(controllerName, mgr.GetClient(), c.scopeFactory, flavorHelperFactory{}, flavorStatusWriter{}, c.defaultResyncPeriod)
+	r := reconciler.NewController(controllerName, mgr.GetClient(), mgr.GetEventRecorderFor(controllerName), c.scopeFactory, flavorHelperFactory{}, flavorStatusWriter{}, c.defaults)
 	return builder.Complete(&r)
 }
@@ -20,6 +20,7 @@ package flavor
 import (
 	corev1 "k8s.io/api/core/v1"
 
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/credentials"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dependency"
 	orcstrings "github.com/k-orc/openstack-resource-controller/v2/internal/util/strings"
 )
@@ -34,12 +35,21 @@ var (
 	// server-side-apply on objects we don't control
 	externalObjectFieldOwner = orcstrings.GetSSAFieldOwner(controllerName)
 
-	credentialsDependency = dependency.NewDeletionGuardDependency[*orcObjectListT, *corev1.Secret](
-		"spec.cloudCredentialsRef.secretName",
-		func(obj orcObjectPT) []string {
-			return []string{obj.Spec.CloudCredentialsRef.SecretName}
-		},
-		finalizer, externalObjectFieldOwner,
-		dependency.OverrideDependencyName("credentials"),
+	// credentialsDependency is the dependency on the secret referenced by
+	// cloudCredentialsRef or, if it is not specified, on the OpenStackCloud
+	// which allows the object's namespace and its secret
+	credentialsDependency = credentials.NewDependency(
+		dependency.NewDeletionGuardDependency[*orcObjectListT, *corev1.Secret](
+			"spec.cloudCredentialsRef.secretName",
+			func(obj orcObjectPT) []string {
+				if obj.Spec.CloudCredentialsRef == nil {
+					return nil
+				}
+				return []string{obj.Spec.CloudCredentialsRef.SecretName}
+			},
+			finalizer, externalObjectFieldOwner,
+			dependency.OverrideDependencyName("credentials"),
+		),
+		controllerName,
 	)
 )
@@ -27,6 +27,7 @@ import (
 	"github.com/k-orc/openstack-resource-controller/v2/internal/logging"
 	osclients "github.com/k-orc/openstack-resource-controller/v2/internal/osclients"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dependency"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dryrun"
 	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/util/tags"
 	corev1 "k8s.io/api/core/v1"
@@ -280,13 +281,11 @@ func (actuator floatingipActuator) updateResource(ctx context.Context, obj orcOb
 
 	updateOpts.RevisionNumber = &osResource.RevisionNumber
 
+	ctx = dryrun.WithAction(ctx, "update OpenStack "+controllerName)
 	_, err = actuator.osClient.UpdateFloatingIP(ctx, osResource.ID, updateOpts)
 
 	if err != nil {
-		if !orcerrors.IsRetryable(err) {
-			err = orcerrors.Terminal(orcv1alpha1.ConditionReasonInvalidConfiguration, "invalid configuration updating resource: "+err.Error(), err)
-		}
-		return progress.WrapError(err)
+		return progress.WrapUpdateError(err)
 	}
 
 	return progress.NeedsRefresh()
@@ -19,7 +19,6 @@ package floatingip
 import (
 	"context"
 	"errors"
-	"time"
 
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -40,8 +39,8 @@ import (
 // +kubebuilder:rbac:groups=openstack.k-orc.cloud,resources=floatingips/status,verbs=get;update;patch
 
 type floatingipReconcilerConstructor struct {
-	scopeFactory        scope.Factory
-	defaultResyncPeriod time.Duration
+	scopeFactory scope.Factory
+	defaults     interfaces.ControllerDefaults
 }
 
 func New(scopeFactory scope.Factory) interfaces.Controller {
@@ -52,8 +51,8 @@ func (floatingipReconcilerConstructor) GetName() string {
 	return controllerName
 }
 
-func (c *floatingipReconcilerConstructor) SetDefaultResyncPeriod(d time.Duration) {
-	c.defaultResyncPeriod = d
+func (c *floatingipReconcilerConstructor) SetDefaults(d interfaces.ControllerDefaults) {
+	c.defaults = d
 }
 
 const controllerName = "floatingip"
@@ -223,6 +222,6 @@ func (c *floatingipReconcilerConstructor) SetupWithManager(ctx context.Context,
 		return err
 	}
 
-	r := reconciler.NewController(controllerName, k8sClient, c.scopeFactory, floatingipHelperFactory{}, floatingipStatusWriter{}, c.defaultResyncPeriod)
+	r := reconciler.NewController(controllerName, k8sClient, mgr.GetEventRecorderFor(controllerName), c.scopeFactory, floatingipHelperFactory{}, floatingipStatusWriter{}, c.defaults)
 	return builder.Complete(&r)
 }
@@ -0,0 +1,379 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package drift computes the difference between the desired state of an ORC
+// object and the observed state of its OpenStack resource without reference
+// to a specific kind.
+//
+// Drift is computed by comparing each field of spec.resource with the field of
+// the same name in status.resource, as written by the kind's status writer.
+// Fields which have no counterpart of the same name in status.resource, for
+// example references to other ORC objects, are never reported as drifted,
+// even if status.resource reports their observed value under another name. A
+// status.resource field with the same name as a spec.resource field must have
+// the same meaning. Fields which are not set in
+// spec.resource are not compared, with the exception of name, which defaults
+// to the name of the ORC object.
+//
+// Lists are compared as unordered sets. IP addresses, CIDRs and MAC addresses
+// are normalised before comparison.
+package drift
+
+import (
+	"encoding/json"
+	"net"
+	"net/netip"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+const nameField = "name"
+
+// Compute returns the paths of all fields in desired whose value does not
+// match the field of the same name in observed. desired is typically a
+// pointer to an object's spec.resource, and observed a pointer to the
+// status.resource apply configuration generated from the OpenStack resource.
+//
+// basePath is prepended to all returned paths. defaultName is the value
+// expected in observed.name if desired.name is not set.
+//
+// The returned paths are sorted.
+func Compute(basePath string, desired, observed any, defaultName string) []string {
+	desiredV := indirect(reflect.ValueOf(desired))
+	observedT := reflect.TypeOf(observed)
+	if !desiredV.IsValid() || desiredV.Kind() != reflect.Struct || observedT == nil {
+		return nil
+	}
+	observedT = derefType(observedT)
+	if observedT.Kind() != reflect.Struct {
+		return nil
+	}
+	observedV := indirect(reflect.ValueOf(observed))
+
+	drift := compareStruct(basePath, desiredV, observedV, observedT)
+
+	// name is the only field which has an implicit default
+	if i, ok := jsonFields(desiredV.Type())[nameField]; ok && !isSet(desiredV.Field(i)) {
+		if j, ok := jsonFields(observedT)[nameField]; ok {
+			observedName := fieldByJSONName(observedV, observedT, nameField)
+			if !scalarEqual(reflect.ValueOf(defaultName), observedName, observedT.Field(j).Type) {
+				drift = append(drift, joinPath(basePath, nameField))
+			}
+		}
+	}
+
+	slices.Sort(drift)
+	return drift
+}
+
+// compareStruct compares every set field of desired with the corresponding
+// field of observed. observed may be invalid if it is not set, in which case
+// its fields are treated as having their zero value.
+func compareStruct(path string, desired, observed reflect.Value, observedT reflect.Type) []string {
+	var drift []string
+
+	observedFields := jsonFields(observedT)
+	for name, i := range jsonFields(desired.Type()) {
+		j, ok := observedFields[name]
+		if !ok {
+			continue
+		}
+
+		if !isSet(desired.Field(i)) {
+			continue
+		}
+		desiredField := indirect(desired.Field(i))
+
+		fieldPath := joinPath(path, name)
+		observedFieldT := observedT.Field(j).Type
+		observedField := fieldByJSONName(observed, observedT, name)
+
+		if !valueEqual(fieldPath, desiredField, observedField, observedFieldT, &drift) {
+			drift = append(drift, fieldPath)
+		}
+	}
+
+	return drift
+}
+
+// valueEqual returns false if desired and observed differ. Differences in
+// nested structs are appended to drift directly, and do not cause valueEqual to
+// return false.
+func valueEqual(path string, desired, observed reflect.Value, observedT reflect.Type, drift *[]string) bool {
+	observedElemT := derefType(observedT)
+
+	switch desired.Kind() {
+	case reflect.Struct:
+		if observedElemT.Kind() != reflect.Struct {
+			return true
+		}
+		*drift = append(*drift, compareStruct(path, desired, observed, observedElemT)...)
+		return true
+
+	case reflect.Slice:
+		if observedElemT.Kind() != reflect.Slice {
+			return true
+		}
+		return sliceEqual(desired, observed, observedElemT)
+
+	case reflect.Map:
+		if observedElemT.Kind() != reflect.Map {
+			return true
+		}
+		return mapEqual(desired, observed, observedElemT)
+
+	default:
+		switch observedElemT.Kind() {
+		case reflect.Struct, reflect.Slice, reflect.Map:
+			return true
+		}
+		return scalarEqual(desired, observed, observedT)
+	}
+}
+
+// sliceEqual compares slices as unordered sets. Slices of structs are equal if
+// every desired element has a matching observed element, and every observed
+// element has a matching desired element. Elements are matched on the fields
+// set in the desired element which are also present in observed. If any
+// desired element has no field in common with observed, the slice is not
+// compared.
+func sliceEqual(desired, observed reflect.Value, observedT reflect.Type) bool {
+	desiredElemT := derefType(desired.Type().Elem())
+	observedElemT := derefType(observedT.Elem())
+
+	if desiredElemT.Kind() != reflect.Struct {
+		return slices.Equal(sortedJSON(desired), sortedJSON(observed))
+	}
+	if observedElemT.Kind() != reflect.Struct {
+		return true
+	}
+
+	var desiredElems []map[string]string
+	for i := range desired.Len() {
+		elem := projectStruct(indirect(desired.Index(i)), desiredElemT, observedElemT, nil)
+		if len(elem) == 0 {
+			return true
+		}
+		desiredElems = append(desiredElems, elem)
+	}
+
+	var observedElems []reflect.Value
+	if observed.IsValid() {
+		for i := range observed.Len() {
+			observedElems = append(observedElems, indirect(observed.Index(i)))
+		}
+	}
+
+	matches := func(desiredElem map[string]string, observedElem reflect.Value) bool {
+		projection := projectStruct(observedElem, observedElemT, observedElemT, desiredElem)
+		for k, v := range desiredElem {
+			if projection[k] != v {
+				return false
+			}
+		}
+		return true
+	}
+
+	for _, desiredElem := range desiredElems {
+		if !slices.ContainsFunc(observedElems, func(observedElem reflect.Value) bool {
+			return matches(desiredElem, observedElem)
+		}) {
+			return false
+		}
+	}
+	for _, observedElem := range observedElems {
+		if !slices.ContainsFunc(desiredElems, func(desiredElem map[string]string) bool {
+			return matches(desiredElem, observedElem)
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+// projectStruct returns the JSON encoding of the set scalar fields of v which
+// are also present in targetT. If only is non-nil, the fields in only are
+// returned whether or not they are set, and all other fields are omitted.
+func projectStruct(v reflect.Value, t, targetT reflect.Type, only map[string]string) map[string]string {
+	projection := make(map[string]string)
+	targetFields := jsonFields(targetT)
+	for name, i := range jsonFields(t) {
+		if _, ok := targetFields[name]; !ok {
+			continue
+		}
+
+		fieldT := derefType(t.Field(i).Type)
+		switch fieldT.Kind() {
+		case reflect.Struct, reflect.Slice, reflect.Map:
+			continue
+		}
+
+		var field reflect.Value
+		if v.IsValid() {
+			field = v.Field(i)
+		}
+		if only == nil {
+			if !isSet(field) {
+				continue
+			}
+		} else if _, ok := only[name]; !ok {
+			continue
+		}
+
+		projection[name] = encode(field, fieldT)
+	}
+	return projection
+}
+
+// mapEqual returns true if every key in desired has the same value in observed.
+func mapEqual(desired, observed reflect.Value, observedT reflect.Type) bool {
+	iter := desired.MapRange()
+	for iter.Next() {
+		var observedValue reflect.Value
+		if observed.IsValid() {
+			observedValue = observed.MapIndex(iter.Key())
+		}
+		if encode(iter.Value(), iter.Value().Type()) != encode(observedValue, observedT.Elem()) {
+			return false
+		}
+	}
+	return true
+}
+
+// scalarEqual compares two scalar values by their JSON encoding, which allows
+// comparison between distinct named types with the same underlying type. An
+// unset observed value is compared as the zero value of its type.
+func scalarEqual(desired, observed reflect.Value, observedT reflect.Type) bool {
+	return encode(indirect(desired), derefType(desired.Type())) == encode(indirect(observed), derefType(observedT))
+}
+
+// encode returns the JSON encoding of v, or of the zero value of t if v is not
+// set. Strings are normalised first.
+func encode(v reflect.Value, t reflect.Type) string {
+	v = indirect(v)
+	if !v.IsValid() {
+		v = reflect.Zero(t)
+	}
+
+	value := v.Interface()
+	if v.Kind() == reflect.String {
+		value = normalize(v.String())
+	}
+
+	b, err := json.Marshal(value)
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+// normalize returns the canonical form of s if it is an IP address, CIDR or
+// MAC address, so that equivalent values written differently do not drift.
+// For example, OpenStack returns IPv6 addresses in their compressed lower
+// case form regardless of how they were specified. A CIDR containing a single
+// address is equivalent to the address.
+func normalize(s string) string {
+	if addr, err := netip.ParseAddr(s); err == nil {
+		return addr.String()
+	}
+	if prefix, err := netip.ParsePrefix(s); err == nil {
+		if prefix.IsSingleIP() {
+			return prefix.Addr().String()
+		}
+		return prefix.String()
+	}
+	if strings.Count(s, ":") == 5 {
+		if mac, err := net.ParseMAC(s); err == nil {
+			return mac.String()
+		}
+	}
+	return s
+}
+
+func sortedJSON(v reflect.Value) []string {
+	if !v.IsValid() {
+		return nil
+	}
+	values := make([]string, v.Len())
+	for i := range v.Len() {
+		elem := v.Index(i)
+		values[i] = encode(elem, derefType(elem.Type()))
+	}
+	slices.Sort(values)
+	return slices.Compact(values)
+}
+
+// isSet returns true if v has been set in the desired state. A non-nil pointer
+// is set even if it points to a zero value. Any other zero value is not set.
+func isSet(v reflect.Value) bool {
+	if !v.IsValid() {
+		return false
+	}
+	if v.Kind() == reflect.Pointer {
+		return !v.IsNil()
+	}
+	return !v.IsZero()
+}
+
+func fieldByJSONName(v reflect.Value, t reflect.Type, name string) reflect.Value {
+	if !v.IsValid() {
+		return reflect.Value{}
+	}
+	return v.Field(jsonFields(t)[name])
+}
+
+// jsonFields returns a map of the JSON names of the fields of struct type t to
+// their field index.
+func jsonFields(t reflect.Type) map[string]int {
+	fields := make(map[string]int)
+	for i := range t.NumField() {
+		f := t.Field(i)
+		if !f.IsExported() || f.Anonymous {
+			continue
+		}
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			continue
+		}
+		fields[name] = i
+	}
+	return fields
+}
+
+func indirect(v reflect.Value) reflect.Value {
+	for v.IsValid() && (v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface) {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
+
+func derefType(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return t
+}
+
+func joinPath(base, name string) string {
+	if base == "" {
+		return name
+	}
+	return base + "." + name
+}
@@ -0,0 +1,225 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package drift
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	orcapplyconfigv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/pkg/clients/applyconfiguration/api/v1alpha1"
+)
+
+func TestCompute(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "spec.resource"
+	const objectName = "my-port"
+
+	tests := []struct {
+		name     string
+		desired  *orcv1alpha1.PortResourceSpec
+		observed *orcapplyconfigv1alpha1.PortResourceStatusApplyConfiguration
+		want     []string
+	}{
+		{
+			name:     "no drift with defaulted name",
+			desired:  &orcv1alpha1.PortResourceSpec{},
+			observed: orcapplyconfigv1alpha1.PortResourceStatus().WithName(objectName),
+			want:     nil,
+		},
+		{
+			name:     "defaulted name drifted",
+			desired:  &orcv1alpha1.PortResourceSpec{},
+			observed: orcapplyconfigv1alpha1.PortResourceStatus().WithName("renamed"),
+			want:     []string{"spec.resource.name"},
+		},
+		{
+			name: "explicit name and description drifted",
+			desired: &orcv1alpha1.PortResourceSpec{
+				Name:        ptr.To[orcv1alpha1.OpenStackName]("port-a"),
+				Description: ptr.To[orcv1alpha1.NeutronDescription]("desc"),
+			},
+			observed: orcapplyconfigv1alpha1.PortResourceStatus().WithName("port-b"),
+			want:     []string{"spec.resource.description", "spec.resource.name"},
+		},
+		{
+			name: "false is a set value",
+			desired: &orcv1alpha1.PortResourceSpec{
+				AdminStateUp: ptr.To(false),
+			},
+			observed: orcapplyconfigv1alpha1.PortResourceStatus().WithName(objectName).WithAdminStateUp(true),
+			want:     []string{"spec.resource.adminStateUp"},
+		},
+		{
+			name: "tags are compared as a set",
+			desired: &orcv1alpha1.PortResourceSpec{
+				Tags: []orcv1alpha1.NeutronTag{"a", "b"},
+			},
+			observed: orcapplyconfigv1alpha1.PortResourceStatus().WithName(objectName).WithTags("b", "a"),
+			want:     nil,
+		},
+		{
+			name: "tags drifted",
+			desired: &orcv1alpha1.PortResourceSpec{
+				Tags: []orcv1alpha1.NeutronTag{"a", "b"},
+			},
+			observed: orcapplyconfigv1alpha1.PortResourceStatus().WithName(objectName).WithTags("a"),
+			want:     []string{"spec.resource.tags"},
+		},
+		{
+			name: "references are not compared",
+			desired: &orcv1alpha1.PortResourceSpec{
+				NetworkRef:        "network",
+				SecurityGroupRefs: []orcv1alpha1.KubernetesNameRef{"sg"},
+			},
+			observed: orcapplyconfigv1alpha1.PortResourceStatus().WithName(objectName).WithNetworkID("network-id"),
+			want:     nil,
+		},
+		{
+			name: "struct elements match on common fields",
+			desired: &orcv1alpha1.PortResourceSpec{
+				AllowedAddressPairs: []orcv1alpha1.AllowedAddressPair{
+					{IP: "192.168.0.1"},
+				},
+			},
+			observed: orcapplyconfigv1alpha1.PortResourceStatus().WithName(objectName).
+				WithAllowedAddressPairs(
+					orcapplyconfigv1alpha1.AllowedAddressPairStatus().WithIP("192.168.0.1").WithMAC("fa:16:3e:00:00:01"),
+				),
+			want: nil,
+		},
+		{
+			name: "struct elements drifted",
+			desired: &orcv1alpha1.PortResourceSpec{
+				AllowedAddressPairs: []orcv1alpha1.AllowedAddressPair{
+					{IP: "192.168.0.1"},
+				},
+			},
+			observed: orcapplyconfigv1alpha1.PortResourceStatus().WithName(objectName).
+				WithAllowedAddressPairs(
+					orcapplyconfigv1alpha1.AllowedAddressPairStatus().WithIP("192.168.0.2"),
+				),
+			want: []string{"spec.resource.allowedAddressPairs"},
+		},
+		{
+			name: "struct elements are compared as a set",
+			desired: &orcv1alpha1.PortResourceSpec{
+				AllowedAddressPairs: []orcv1alpha1.AllowedAddressPair{
+					{IP: "192.168.0.1"},
+				},
+			},
+			observed: orcapplyconfigv1alpha1.PortResourceStatus().WithName(objectName).
+				WithAllowedAddressPairs(
+					orcapplyconfigv1alpha1.AllowedAddressPairStatus().WithIP("192.168.0.1"),
+					orcapplyconfigv1alpha1.AllowedAddressPairStatus().WithIP("192.168.0.2"),
+				),
+			want: []string{"spec.resource.allowedAddressPairs"},
+		},
+		{
+			name: "addresses are normalised",
+			desired: &orcv1alpha1.PortResourceSpec{
+				AllowedAddressPairs: []orcv1alpha1.AllowedAddressPair{
+					{IP: "2001:0DB8::0001", MAC: ptr.To[orcv1alpha1.MAC]("FA:16:3E:00:00:01")},
+					{IP: "192.168.0.1/32"},
+				},
+			},
+			observed: orcapplyconfigv1alpha1.PortResourceStatus().WithName(objectName).
+				WithAllowedAddressPairs(
+					orcapplyconfigv1alpha1.AllowedAddressPairStatus().WithIP("192.168.0.1").WithMAC("fa:16:3e:00:00:02"),
+					orcapplyconfigv1alpha1.AllowedAddressPairStatus().WithIP("2001:db8::1").WithMAC("fa:16:3e:00:00:01"),
+				),
+			want: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Compute(basePath, tc.desired, tc.observed, objectName)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Compute() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCompute_Subnet(t *testing.T) {
+	t.Parallel()
+
+	const basePath = "spec.resource"
+	const objectName = "my-subnet"
+
+	tests := []struct {
+		name     string
+		desired  *orcv1alpha1.SubnetResourceSpec
+		observed *orcapplyconfigv1alpha1.SubnetResourceStatusApplyConfiguration
+		want     []string
+	}{
+		{
+			name: "equivalent IPv6 values",
+			desired: &orcv1alpha1.SubnetResourceSpec{
+				CIDR:           "2001:DB8:0:0::/64",
+				DNSNameservers: []orcv1alpha1.IPvAny{"2001:4860:4860:0:0:0:0:8888", "2001:4860:4860::8888"},
+				HostRoutes: []orcv1alpha1.HostRoute{
+					{Destination: "2001:DB8:1::/64", NextHop: "2001:DB8::1"},
+				},
+			},
+			observed: orcapplyconfigv1alpha1.SubnetResourceStatus().WithName(objectName).
+				WithCIDR("2001:db8::/64").
+				WithDNSNameservers("2001:4860:4860::8888").
+				WithHostRoutes(orcapplyconfigv1alpha1.HostRouteStatus().WithDestination("2001:db8:1::/64").WithNextHop("2001:db8::1")),
+			want: nil,
+		},
+		{
+			name: "cidr drifted",
+			desired: &orcv1alpha1.SubnetResourceSpec{
+				CIDR: "192.168.1.0/24",
+			},
+			observed: orcapplyconfigv1alpha1.SubnetResourceStatus().WithName(objectName).WithCIDR("192.168.0.0/24"),
+			want:     []string{"spec.resource.cidr"},
+		},
+		{
+			name: "additional host route drifted",
+			desired: &orcv1alpha1.SubnetResourceSpec{
+				HostRoutes: []orcv1alpha1.HostRoute{
+					{Destination: "10.0.0.0/8", NextHop: "192.168.0.1"},
+				},
+			},
+			observed: orcapplyconfigv1alpha1.SubnetResourceStatus().WithName(objectName).
+				WithHostRoutes(
+					orcapplyconfigv1alpha1.HostRouteStatus().WithDestination("10.0.0.0/8").WithNextHop("192.168.0.1"),
+					orcapplyconfigv1alpha1.HostRouteStatus().WithDestination("172.16.0.0/12").WithNextHop("192.168.0.1"),
+				),
+			want: []string{"spec.resource.hostRoutes"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := Compute(basePath, tc.desired, tc.observed, objectName)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Compute() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -95,6 +95,24 @@ type BaseResourceActuator[
 	ListOSResourcesForAdoption(ctx context.Context, orcObject orcObjectPT) (iter.Seq2[*osResourceT, error], bool)
 }
 
+// ListResourceActuator is optionally implemented by a CreateResourceActuator
+// whose OpenStack resources can be listed in bulk. If the list cache is
+// enabled, the generic controller fetches an up to date object's resource
+// from a periodically refreshed list of all resources in the actuator's
+// scope, and only calls GetOSResourceByID for resources which are not in the
+// list.
+type ListResourceActuator[osResourceT any] interface {
+	// GetListCacheKey returns a key identifying the resources returned by
+	// ListOSResourcesForCache, typically the project of the actuator's scope.
+	// Actuators with the same key share cached resources. An empty key
+	// disables the list cache for this actuator.
+	GetListCacheKey() string
+
+	// ListOSResourcesForCache returns all OpenStack resources identified by
+	// the actuator's list cache key.
+	ListOSResourcesForCache(ctx context.Context) iter.Seq2[*osResourceT, error]
+}
+
 // CreateResourceActuator provides methods required by the generic controller
 // during the create and update flows.
 type CreateResourceActuator[
@@ -211,3 +229,19 @@ type ReconcileResourceActuator[orcObjectPT, osResourceT any] interface {
 	// objects and returned a separate ResourceReconciler for each of them.
 	GetResourceReconcilers(ctx context.Context, orcObject orcObjectPT, osResource *osResourceT, controller ResourceController) ([]ResourceReconciler[orcObjectPT, osResourceT], progress.ReconcileStatus)
 }
+
+// DriftReportResourceActuator is implemented by an actuator which has
+// ResourceReconcilers which must be executed even when the object's drift
+// policy is `report`.
+//
+// When the drift policy is `report` and the object's spec has not changed
+// since it was last successfully reconciled, any update performed by a
+// ResourceReconciler would correct drift, so GetResourceReconcilers is not
+// called. GetDriftReportReconcilers is called instead.
+type DriftReportResourceActuator[orcObjectPT, osResourceT any] interface {
+	// GetDriftReportReconcilers returns zero or more ResourceReconcilers to be
+	// executed in place of those returned by GetResourceReconcilers. They must
+	// not modify the OpenStack resource. They are executed in the same way as
+	// those returned by GetResourceReconcilers.
+	GetDriftReportReconcilers(ctx context.Context, orcObject orcObjectPT, osResource *osResourceT, controller ResourceController) ([]ResourceReconciler[orcObjectPT, osResourceT], progress.ReconcileStatus)
+}
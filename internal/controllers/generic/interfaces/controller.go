@@ -18,19 +18,67 @@ package interfaces
 
 import (
 	"context"
+	"strconv"
 	"time"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/scope"
 )
 
 type Controller interface {
 	SetupWithManager(context.Context, ctrl.Manager, controller.Options) error
 	GetName() string
-	SetDefaultResyncPeriod(time.Duration)
+	SetDefaults(ControllerDefaults)
+}
+
+// ControllerDefaults contains operator-level defaults passed from the manager
+// options. They apply to every object reconciled by a controller unless
+// overridden by the object itself.
+type ControllerDefaults struct {
+	// ResyncPeriod is used as the fallback in resync.DetermineResyncPeriod
+	// when a resource does not specify its own spec.resyncPeriod. A value of
+	// 0 means periodic resync is disabled by default.
+	ResyncPeriod time.Duration
+
+	// DriftPolicy is used when a managed resource does not specify its own
+	// spec.managedOptions.driftPolicy.
+	DriftPolicy orcv1alpha1.DriftPolicy
+
+	// DryRun is used when an object does not have its own dry run
+	// annotation.
+	DryRun bool
+
+	// ListCacheTTL is the maximum age of a cached list of OpenStack
+	// resources used to resync objects which are up to date. A value of 0
+	// means the list cache is disabled.
+	ListCacheTTL time.Duration
+}
+
+// GetDriftPolicy returns the default drift policy, or DriftPolicyCorrect if
+// none was set.
+func (d ControllerDefaults) GetDriftPolicy() orcv1alpha1.DriftPolicy {
+	if d.DriftPolicy == "" {
+		return orcv1alpha1.DriftPolicyCorrect
+	}
+	return d.DriftPolicy
+}
+
+// IsDryRun returns true if changes to OpenStack on behalf of obj must be
+// reported instead of made.
+func (d ControllerDefaults) IsDryRun(obj metav1.Object) bool {
+	value, ok := obj.GetAnnotations()[orcv1alpha1.DryRunAnnotation]
+	if !ok {
+		return d.DryRun
+	}
+
+	// Fail safe: an unrecognised value enables dry run
+	dryRun, err := strconv.ParseBool(value)
+	return err != nil || dryRun
 }
 
 type ResourceController interface {
@@ -47,6 +47,13 @@ type ORCStatusApplyConfigWithLastSyncTime[statusApplyPT any] interface {
 	WithLastSyncTime(metav1.Time) statusApplyPT
 }
 
+// ORCStatusApplyConfigWithDrift extends ORCStatusApplyConfigWithLastSyncTime
+// with a Drift field.
+type ORCStatusApplyConfigWithDrift[statusApplyPT any] interface {
+	ORCStatusApplyConfigWithLastSyncTime[statusApplyPT]
+	WithDrift(...string) statusApplyPT
+}
+
 // ORCStatusApplyConfigWithID extends ORCStatusApplyConfigWithLastSyncTime with
 // an ID field.
 // This is required by resources that have an OpenStack-assigned ID stored in
@@ -24,7 +24,9 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/go-logr/logr"
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/logging"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dryrun"
 	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
 )
 
@@ -44,6 +46,9 @@ type reconcileStatus struct {
 	err error
 
 	externallyDeleted bool
+	refresh           bool
+	rateLimited       bool
+	dryRunActions     []string
 }
 
 // NewReconcileStatus returns an empty ReconcileStatus
@@ -160,11 +165,28 @@ func (r ReconcileStatus) WithRequeue(requeue time.Duration) ReconcileStatus {
 
 // WithError returns a ReconcileStatus containing the given error joined to any
 // existing errors.
+//
+// If err is a response from OpenStack asking us to retry after a specific
+// interval, it is not added as an error. Instead the returned ReconcileStatus
+// is RateLimited, so we requeue after the requested interval rather than the
+// controller-runtime error backoff.
+//
+// If err contains a request which was not sent in dry run mode, it is not
+// added as an error. Instead the request is added as a DryRun action.
 func (r ReconcileStatus) WithError(err error) ReconcileStatus {
 	if err == nil {
 		return r
 	}
 
+	var dryRunErr *dryrun.Error
+	if errors.As(err, &dryRunErr) {
+		return r.DryRun(dryRunErr.Action())
+	}
+
+	if retryAfter, ok := orcerrors.RetryAfter(err); ok {
+		return r.RateLimited(retryAfter)
+	}
+
 	if r == nil {
 		r = &reconcileStatus{}
 	}
@@ -183,6 +205,9 @@ func (r ReconcileStatus) WithReconcileStatus(o ReconcileStatus) ReconcileStatus
 		WithRequeue(o.GetRequeue()).
 		WithError(o.GetError())
 	r.externallyDeleted = r.IsExternallyDeleted() || o.IsExternallyDeleted()
+	r.refresh = r.IsRefreshNeeded() || o.IsRefreshNeeded()
+	r.rateLimited = r.IsRateLimited() || o.IsRateLimited()
+	r.dryRunActions = append(r.dryRunActions, o.GetDryRunActions()...)
 	return r
 }
 
@@ -285,10 +310,106 @@ func WaitingOnOpenStack(waitingOn WaitingOnEvent, pollingPeriod time.Duration) R
 // sets an appropriate progress message and ensures that the object will be
 // reconciled again immediately.
 func (r ReconcileStatus) NeedsRefresh() ReconcileStatus {
-	return r.WithProgressMessage("Resource status will be refreshed")
+	r = r.WithProgressMessage("Resource status will be refreshed")
+	r.refresh = true
+	return r
 }
 
 // NeedsRefresh is a convenience method which returns a new ReconcileStatus with NeedsRefresh.
 func NeedsRefresh() ReconcileStatus {
 	return NewReconcileStatus().NeedsRefresh()
 }
+
+// ModifiedConcurrently indicates that an update was rejected because the
+// OpenStack resource was modified after we fetched it. err is added as a
+// transient error, so the object is reconciled again with the controller's
+// exponential backoff. The resource will be fetched again and the update
+// recomputed from its current state rather than overwriting the concurrent
+// change, and we will not retry in a tight loop if the resource is modified
+// continuously.
+func (r ReconcileStatus) ModifiedConcurrently(err error) ReconcileStatus {
+	return r.WithError(fmt.Errorf("OpenStack resource was modified concurrently, update will be retried: %w", err))
+}
+
+// ModifiedConcurrently is a convenience method which returns a new ReconcileStatus with ModifiedConcurrently.
+func ModifiedConcurrently(err error) ReconcileStatus {
+	return NewReconcileStatus().ModifiedConcurrently(err)
+}
+
+// WrapUpdateError returns a ReconcileStatus for an error returned by OpenStack
+// when updating a resource. A conditional update which was rejected because the
+// resource was modified concurrently is ModifiedConcurrently. Other errors which
+// are not retryable are terminal.
+func WrapUpdateError(err error) ReconcileStatus {
+	if err == nil {
+		return nil
+	}
+
+	// The resource was modified since we fetched it
+	if orcerrors.IsPreconditionFailed(err) {
+		return ModifiedConcurrently(err)
+	}
+
+	if !orcerrors.IsRetryable(err) {
+		err = orcerrors.Terminal(orcv1alpha1.ConditionReasonInvalidConfiguration, "invalid configuration updating resource: "+err.Error(), err)
+	}
+	return WrapError(err)
+}
+
+// IsRefreshNeeded returns true if the ReconcileStatus indicates that the
+// resource status needs to be refreshed, typically because the OpenStack
+// resource was modified.
+func (r ReconcileStatus) IsRefreshNeeded() bool {
+	if r == nil {
+		return false
+	}
+	return r.refresh
+}
+
+// RateLimited indicates that OpenStack throttled our requests and asked us to
+// retry after the given interval. It sets an appropriate progress message and
+// a requeue after the interval.
+func (r ReconcileStatus) RateLimited(retryAfter time.Duration) ReconcileStatus {
+	r = r.WithProgressMessage(fmt.Sprintf("Rate limited by OpenStack: retrying after %s", retryAfter)).
+		WithRequeue(retryAfter)
+	r.rateLimited = true
+	return r
+}
+
+// RateLimited is a convenience method which returns a new ReconcileStatus with RateLimited.
+func RateLimited(retryAfter time.Duration) ReconcileStatus {
+	return NewReconcileStatus().RateLimited(retryAfter)
+}
+
+// IsRateLimited returns true if the ReconcileStatus indicates that OpenStack
+// throttled our requests.
+func (r ReconcileStatus) IsRateLimited() bool {
+	if r == nil {
+		return false
+	}
+	return r.rateLimited
+}
+
+// DryRun indicates that the controller did not perform the given action on
+// OpenStack because it is in dry run mode. It adds an appropriate progress
+// message. It does not add a requeue: the object will be reconciled again
+// when dry run is disabled.
+func (r ReconcileStatus) DryRun(action string) ReconcileStatus {
+	r = r.WithProgressMessage("Dry run: " + action)
+	r.dryRunActions = append(r.dryRunActions, action)
+	return r
+}
+
+// DryRun is a convenience method which returns a new ReconcileStatus with DryRun.
+func DryRun(action string) ReconcileStatus {
+	return NewReconcileStatus().DryRun(action)
+}
+
+// GetDryRunActions returns all actions which were not performed because the
+// controller is in dry run mode.
+func (r ReconcileStatus) GetDryRunActions() []string {
+	if r == nil {
+		return nil
+	}
+	return r.dryRunActions
+}
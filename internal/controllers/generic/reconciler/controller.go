@@ -19,21 +19,28 @@ package reconciler
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"time"
 
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/drift"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/interfaces"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/progress"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/resync"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/status"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/logging"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/metrics"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/scope"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dryrun"
 )
 
 type ResourceController interface {
@@ -41,6 +48,7 @@ type ResourceController interface {
 
 	GetK8sClient() client.Client
 	GetScopeFactory() scope.Factory
+	GetEventRecorder() record.EventRecorder
 }
 
 func NewController[
@@ -57,19 +65,24 @@ func NewController[
 	}, statusApplyT any,
 	osResourceT any,
 ](
-	name string, k8sClient client.Client, scopeFactory scope.Factory,
+	name string, k8sClient client.Client, recorder record.EventRecorder, scopeFactory scope.Factory,
 	helperFactory interfaces.ResourceHelperFactory[orcObjectPT, orcObjectT, resourceSpecT, filterT, osResourceT],
 	statusWriter interfaces.ResourceStatusWriter[orcObjectPT, *osResourceT, objectApplyPT, statusApplyPT],
-	defaultResyncPeriod time.Duration,
+	defaults interfaces.ControllerDefaults,
 ) Controller[orcObjectPT, orcObjectT, resourceSpecT, filterT, objectApplyPT, statusApplyPT, statusApplyT, osResourceT] {
-	return Controller[orcObjectPT, orcObjectT, resourceSpecT, filterT, objectApplyPT, statusApplyPT, statusApplyT, osResourceT]{
-		name:                name,
-		client:              k8sClient,
-		scopeFactory:        scopeFactory,
-		helperFactory:       helperFactory,
-		statusWriter:        statusWriter,
-		defaultResyncPeriod: defaultResyncPeriod,
+	c := Controller[orcObjectPT, orcObjectT, resourceSpecT, filterT, objectApplyPT, statusApplyPT, statusApplyT, osResourceT]{
+		name:          name,
+		client:        k8sClient,
+		recorder:      recorder,
+		scopeFactory:  scopeFactory,
+		helperFactory: helperFactory,
+		statusWriter:  statusWriter,
+		defaults:      defaults,
 	}
+	if defaults.ListCacheTTL > 0 {
+		c.listCache = newListCache[osResourceT](defaults.ListCacheTTL)
+	}
+	return c
 }
 
 type Controller[
@@ -91,17 +104,19 @@ type Controller[
 ] struct {
 	name         string
 	client       client.Client
+	recorder     record.EventRecorder
 	scopeFactory scope.Factory
 
 	helperFactory interfaces.ResourceHelperFactory[orcObjectPT, orcObjectT, resourceSpecT, filterT, osResourceT]
 	statusWriter  interfaces.ResourceStatusWriter[orcObjectPT, *osResourceT, objectApplyPT, statusApplyPT]
 
-	// defaultResyncPeriod is the operator-level default resync period passed
-	// from the manager options. It is used as the fallback in
-	// resync.DetermineResyncPeriod when a resource does not specify its own
-	// spec.resyncPeriod. A value of 0 means periodic resync is disabled by
-	// default.
-	defaultResyncPeriod time.Duration
+	// defaults are the operator-level defaults passed from the manager
+	// options.
+	defaults interfaces.ControllerDefaults
+
+	// listCache is the cache of listed OpenStack resources used during
+	// resync, or nil if the list cache is disabled.
+	listCache *listCache[osResourceT]
 }
 
 func (c *Controller[_, _, _, _, _, _, _, _]) GetName() string {
@@ -116,6 +131,10 @@ func (c *Controller[_, _, _, _, _, _, _, _]) GetScopeFactory() scope.Factory {
 	return c.scopeFactory
 }
 
+func (c *Controller[_, _, _, _, _, _, _, _]) GetEventRecorder() record.EventRecorder {
+	return c.recorder
+}
+
 func (c *Controller[
 	orcObjectPT, orcObjectT,
 	resourceSpecT, filterT,
@@ -127,19 +146,32 @@ func (c *Controller[
 	err := c.client.Get(ctx, req.NamespacedName, orcObject)
 	if err != nil {
 		if apierrors.IsNotFound(err) {
+			metrics.ForgetObject(c.name, req.NamespacedName)
 			return ctrl.Result{}, nil
 		}
 		return ctrl.Result{}, err
 	}
 
+	// A paused object is not reconciled, including deletion, until the
+	// annotation is removed
+	if orcv1alpha1.IsPaused(orcObject) {
+		ctrl.LoggerFrom(ctx).V(logging.Verbose).Info("Reconciliation is paused")
+		metrics.SetObjectReason(c.name, req.NamespacedName, orcv1alpha1.ConditionReasonPaused)
+		return ctrl.Result{}, status.SetPaused(ctx, c.client, orcObject)
+	}
+
 	adapter := c.helperFactory.NewAPIObjectAdapter(orcObject)
 
-	log := ctrl.LoggerFrom(ctx)
+	var reconcileStatus progress.ReconcileStatus
 	if !orcObject.GetDeletionTimestamp().IsZero() {
-		return c.reconcileDelete(ctx, adapter).Return(log)
+		reconcileStatus = c.reconcileDelete(ctx, adapter)
+	} else {
+		reconcileStatus = c.reconcileNormal(ctx, adapter)
 	}
 
-	return c.reconcileNormal(ctx, adapter).Return(log)
+	recordTerminalError(c.recorder, orcObject, reconcileStatus)
+	RecordDryRunActions(c.recorder, orcObject, reconcileStatus)
+	return reconcileStatus.Return(ctrl.LoggerFrom(ctx))
 }
 
 // ShouldReconcile filters events when the object status is up to date, and its
@@ -148,6 +180,7 @@ func (c *Controller[
 // Specifically it looks at the Progressing condition. It has the following behaviour:
 // - Progressing condition is not present -> reconcile
 // - Progressing condition is present and True -> reconcile
+// - Progressing condition is present with reason Paused -> reconcile
 // - Progressing condition is present and False, but observedGeneration is old -> reconcile
 // - Progressing condition is false and observedGeneration is up to date -> do not reconcile
 //
@@ -176,6 +209,11 @@ func ShouldReconcile(obj orcv1alpha1.ObjectWithConditions, lastSyncTime *metav1.
 		return true
 	}
 
+	// The object was paused, and is now being resumed
+	if progressing.Reason == orcv1alpha1.ConditionReasonPaused {
+		return true
+	}
+
 	if progressing.ObservedGeneration != obj.GetGeneration() {
 		return true
 	}
@@ -206,9 +244,12 @@ func (c *Controller[
 	// We do this here rather than in a predicate because predicates only cover
 	// a single watch. Doing it here means we cover all sources of
 	// reconciliation, including our dependencies.
-	effectiveResyncPeriod := resync.DetermineResyncPeriod(objAdapter.GetResyncPeriod(), c.defaultResyncPeriod)
+	effectiveResyncPeriod := resync.DetermineResyncPeriod(objAdapter.GetResyncPeriod(), c.defaults.ResyncPeriod)
 	if !ShouldReconcile(objAdapter.GetObject(), objAdapter.GetLastSyncTime(), effectiveResyncPeriod) {
 		log.V(logging.Verbose).Info("Status is up to date: not reconciling")
+		if progressing := meta.FindStatusCondition(objAdapter.GetObject().GetConditions(), orcv1alpha1.ConditionProgressing); progressing != nil {
+			metrics.SetObjectReason(c.name, client.ObjectKeyFromObject(objAdapter.GetObject()), progressing.Reason)
+		}
 		if remaining := resync.RemainingUntilNextSync(objAdapter.GetLastSyncTime(), effectiveResyncPeriod); remaining > 0 {
 			return reconcileStatus.WithRequeue(remaining)
 		}
@@ -218,11 +259,13 @@ func (c *Controller[
 	log.V(logging.Verbose).Info("Reconciling resource")
 
 	var osResource *osResourceT
+	var updateStatusOpts []status.UpdateStatusOpt
 
 	// Ensure we always update status
 	defer func() {
 		reconcileStatus = reconcileStatus.WithReconcileStatus(
-			status.UpdateStatus(ctx, c, c.statusWriter, objAdapter.GetObject(), osResource, reconcileStatus))
+			status.UpdateStatus(ctx, c, c.statusWriter, objAdapter.GetObject(), osResource, reconcileStatus, updateStatusOpts...))
+		metrics.SetObjectReason(c.name, client.ObjectKeyFromObject(objAdapter.GetObject()), status.ProgressingReason(reconcileStatus))
 	}()
 
 	actuator, actuatorRS := c.helperFactory.NewCreateActuator(ctx, objAdapter.GetObject(), c)
@@ -233,9 +276,20 @@ func (c *Controller[
 		return actuatorRS.WithReconcileStatus(reconcileStatus)
 	}
 
-	osResource, getOSResourceRS := GetOrCreateOSResource(ctx, log, c, objAdapter, actuator)
+	dryRun := c.defaults.IsDryRun(objAdapter.GetObject())
+	if dryRun {
+		// Any request which would modify OpenStack is not sent, and is
+		// reported as a dry run action instead
+		ctx = dryrun.WithDryRun(ctx)
+	}
+
+	getActuator := withListCache(c.listCache, actuator, objAdapter.GetObject(), objAdapter.GetLastSyncTime())
+	osResource, getOSResourceRS := GetOrCreateOSResource(ctx, log, c, objAdapter, getActuator, dryRun)
 	if getOSResourceRS.IsExternallyDeleted() {
-		if objAdapter.GetStatusID() != nil {
+		metrics.RecordExternalDeletion(c.name)
+		if statusID := objAdapter.GetStatusID(); statusID != nil {
+			c.recorder.Eventf(objAdapter.GetObject(), corev1.EventTypeWarning, EventReasonExternallyDeleted,
+				"OpenStack resource %s was deleted externally and will be recreated", *statusID)
 			log.V(logging.Info).Info("Clearing status.id after external deletion to enable recreation")
 			if err := status.ClearStatusID(ctx, c, objAdapter.GetObject()); err != nil {
 				return reconcileStatus.WithError(fmt.Errorf("clearing status ID after external deletion: %w", err))
@@ -261,17 +315,34 @@ func (c *Controller[
 	log.V(logging.Debug).Info("Got resource")
 	ctx = ctrl.LoggerInto(ctx, log)
 
-	if objAdapter.GetManagementPolicy() == orcv1alpha1.ManagementPolicyManaged {
+	managed := objAdapter.GetManagementPolicy() == orcv1alpha1.ManagementPolicyManaged
+	reportDrift := objAdapter.GetManagedOptions().GetDriftPolicy(c.defaults.GetDriftPolicy()) == orcv1alpha1.DriftPolicyReport
+
+	// If the spec has not changed since it was last successfully
+	// reconciled, any update is correcting drift
+	specUnchanged := isSpecReconciled(objAdapter.GetObject())
+
+	if managed && reportDrift && specUnchanged {
+		// Report drift instead of executing reconcilers which would correct
+		// it. Changes to the spec are still applied, because they are not
+		// drift.
+		driftedFields := c.computeDrift(log, objAdapter, osResource)
+		if len(driftedFields) > 0 {
+			log.V(logging.Info).Info("Drift detected", "fields", driftedFields)
+		}
+		updateStatusOpts = append(updateStatusOpts, status.WithDriftReport(driftedFields))
+
+		if reconciler, ok := actuator.(interfaces.DriftReportResourceActuator[orcObjectPT, osResourceT]); ok {
+			reconcilers, getReconcilersRS := reconciler.GetDriftReportReconcilers(ctx, objAdapter.GetObject(), osResource, c)
+			reconcileStatus = getReconcilersRS.WithReconcileStatus(reconcileStatus)
+			reconcileStatus = c.executeReconcilers(ctx, objAdapter.GetObject(), osResource, actuator.GetResourceID(osResource), reconcilers, false).WithReconcileStatus(reconcileStatus)
+		}
+	} else if managed {
 		if reconciler, ok := actuator.(interfaces.ReconcileResourceActuator[orcObjectPT, osResourceT]); ok {
 			// We deliberately execute all reconcilers returned by GetResourceReconcilers, even if it returns an error.
 			reconcilers, getReconcilersRS := reconciler.GetResourceReconcilers(ctx, objAdapter.GetObject(), osResource, c)
 			reconcileStatus = getReconcilersRS.WithReconcileStatus(reconcileStatus)
-
-			// We execute all returned updaters, even if some return errors
-			for _, updater := range reconcilers {
-				updaterRS := updater(ctx, objAdapter.GetObject(), osResource)
-				reconcileStatus = updaterRS.WithReconcileStatus(reconcileStatus)
-			}
+			reconcileStatus = c.executeReconcilers(ctx, objAdapter.GetObject(), osResource, actuator.GetResourceID(osResource), reconcilers, specUnchanged).WithReconcileStatus(reconcileStatus)
 		}
 	}
 
@@ -286,6 +357,62 @@ func (c *Controller[
 	return reconcileStatus
 }
 
+// executeReconcilers executes all the given reconcilers, even if some return
+// errors, and aggregates their results. correctsDrift indicates that any update
+// they perform is correcting drift rather than applying a change to the spec.
+func (c *Controller[
+	orcObjectPT, orcObjectT,
+	resourceSpecT, filterT,
+	objectApplyPT,
+	statusApplyPT, statusApplyT,
+	osResourceT,
+]) executeReconcilers(ctx context.Context, orcObject orcObjectPT, osResource *osResourceT, resourceID string, reconcilers []interfaces.ResourceReconciler[orcObjectPT, osResourceT], correctsDrift bool) progress.ReconcileStatus {
+	var reconcileStatus progress.ReconcileStatus
+	for _, updater := range reconcilers {
+		updaterRS := updater(ctx, orcObject, osResource)
+		if updaterRS.IsRefreshNeeded() {
+			c.recorder.Eventf(orcObject, corev1.EventTypeNormal, EventReasonUpdated,
+				"Updated OpenStack resource %s", resourceID)
+			if correctsDrift {
+				metrics.RecordDriftCorrection(c.name)
+			}
+		}
+		reconcileStatus = updaterRS.WithReconcileStatus(reconcileStatus)
+	}
+	return reconcileStatus
+}
+
+// isSpecReconciled returns true if the current generation of obj was
+// previously reconciled successfully.
+func isSpecReconciled(obj orcv1alpha1.ObjectWithConditions) bool {
+	progressing := meta.FindStatusCondition(obj.GetConditions(), orcv1alpha1.ConditionProgressing)
+	return progressing != nil &&
+		progressing.Reason == orcv1alpha1.ConditionReasonSuccess &&
+		progressing.ObservedGeneration == obj.GetGeneration()
+}
+
+// computeDrift returns the paths of all fields in spec.resource which differ
+// from the observed state of osResource, as written to status.resource by the
+// status writer. Fields are matched by JSON name: see the drift package for
+// which fields are never reported.
+func (c *Controller[
+	orcObjectPT, orcObjectT,
+	resourceSpecT, filterT,
+	objectApplyPT,
+	statusApplyPT, statusApplyT,
+	osResourceT,
+]) computeDrift(log logr.Logger, objAdapter interfaces.APIObjectAdapter[orcObjectPT, resourceSpecT, filterT], osResource *osResourceT) []string {
+	var observedStatus statusApplyPT = new(statusApplyT)
+	c.statusWriter.ApplyResourceStatus(log, osResource, observedStatus)
+
+	observedResource := reflect.ValueOf(observedStatus).Elem().FieldByName("Resource")
+	if !observedResource.IsValid() {
+		return nil
+	}
+
+	return drift.Compute("spec.resource", objAdapter.GetResourceSpec(), observedResource.Interface(), objAdapter.GetName())
+}
+
 func (c *Controller[
 	orcObjectPT, orcObjectT,
 	resourceSpecT,
@@ -305,6 +432,9 @@ func (c *Controller[
 		if !deleted {
 			reconcileStatus = reconcileStatus.WithReconcileStatus(
 				status.UpdateStatus(ctx, c, c.statusWriter, objAdapter.GetObject(), osResource, reconcileStatus))
+			metrics.SetObjectReason(c.name, client.ObjectKeyFromObject(objAdapter.GetObject()), status.ProgressingReason(reconcileStatus))
+		} else {
+			metrics.ForgetObject(c.name, client.ObjectKeyFromObject(objAdapter.GetObject()))
 		}
 	}()
 
@@ -316,7 +446,12 @@ func (c *Controller[
 		return reconcileStatus
 	}
 
-	deleted, osResource, reconcileStatus = DeleteResource(ctx, log, c, objAdapter, actuator)
+	dryRun := c.defaults.IsDryRun(objAdapter.GetObject())
+	if dryRun {
+		ctx = dryrun.WithDryRun(ctx)
+	}
+
+	deleted, osResource, reconcileStatus = DeleteResource(ctx, log, c, objAdapter, actuator, dryRun)
 	if needsReschedule, err := reconcileStatus.NeedsReschedule(); needsReschedule && err == nil {
 		log.V(logging.Verbose).Info("Waiting on events before deletion")
 	}
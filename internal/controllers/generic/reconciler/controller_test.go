@@ -102,6 +102,19 @@ func TestShouldReconcile_ConditionBased(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name:       "Progressing=False paused: should reconcile",
+			generation: 1,
+			conditions: []metav1.Condition{
+				{
+					Type:               orcv1alpha1.ConditionProgressing,
+					Status:             metav1.ConditionFalse,
+					ObservedGeneration: 1,
+					Reason:             orcv1alpha1.ConditionReasonPaused,
+				},
+			},
+			want: true,
+		},
 	}
 
 	for _, tc := range tests {
@@ -0,0 +1,180 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/interfaces"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/progress"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dryrun"
+	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
+)
+
+// noDeleteActuator is a noWriteActuator which also implements
+// DeleteResourceActuator, failing the test if DeleteResource is called.
+type noDeleteActuator struct {
+	*noWriteActuator
+}
+
+func (a noDeleteActuator) DeleteResource(_ context.Context, _ *orcv1alpha1.Flavor, _ *fakeOSResource) progress.ReconcileStatus {
+	a.t.Fatal("DeleteResource was called in dry run mode")
+	return nil
+}
+
+// TestGetOrCreateOSResource_DryRun verifies that a managed resource which does
+// not exist is not created in dry run mode, and that the intended creation is
+// reported.
+func TestGetOrCreateOSResource_DryRun(t *testing.T) {
+	t.Parallel()
+
+	actuator := &noWriteActuator{t: t}
+	adapter := fakeAdapter{
+		Flavor: &orcv1alpha1.Flavor{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       "test-flavor",
+				Namespace:  "default",
+				Finalizers: []string{finalizerFor()},
+			},
+			Spec: orcv1alpha1.FlavorSpec{
+				ManagementPolicy: orcv1alpha1.ManagementPolicyManaged,
+				Resource:         &orcv1alpha1.FlavorResourceSpec{},
+			},
+		},
+	}
+
+	got, rs := GetOrCreateOSResource(context.Background(), logr.Discard(), &fakeResourceController{}, adapter, actuator, true)
+
+	if got != nil {
+		t.Errorf("expected nil osResource, got %v", got)
+	}
+	want := "Would create OpenStack " + testControllerName
+	if actions := rs.GetDryRunActions(); len(actions) != 1 || actions[0] != want {
+		t.Errorf("got dry run actions %q, want [%q]", actions, want)
+	}
+	if needsReschedule, err := rs.NeedsReschedule(); !needsReschedule || err != nil {
+		t.Errorf("expected reschedule without error, got %v, %v", needsReschedule, err)
+	}
+}
+
+// TestDeleteResource_DryRun verifies that a managed resource is not deleted in
+// dry run mode, and that the object's finalizer is retained.
+func TestDeleteResource_DryRun(t *testing.T) {
+	t.Parallel()
+
+	const resourceID = "flavor-id"
+
+	actuator := noDeleteActuator{&noWriteActuator{t: t, readByIDResult: &fakeOSResource{ID: resourceID}}}
+	adapter := managedFlavorWithStatusID(resourceID)
+
+	deleted, _, rs := DeleteResource(context.Background(), logr.Discard(), &fakeResourceController{}, adapter, actuator, true)
+
+	if deleted {
+		t.Error("expected finalizer to be retained in dry run mode")
+	}
+	want := "Would delete OpenStack " + testControllerName + " " + resourceID
+	if actions := rs.GetDryRunActions(); len(actions) != 1 || actions[0] != want {
+		t.Errorf("got dry run actions %q, want [%q]", actions, want)
+	}
+}
+
+// TestDryRunUpdate verifies that a request which was not sent by an update
+// reconciler in dry run mode is reported as an action instead of an error,
+// including when the reconciler wraps it in a terminal error.
+func TestDryRunUpdate(t *testing.T) {
+	t.Parallel()
+
+	requestErr := &dryrun.Error{Method: http.MethodPut, Path: "/v2.0/networks/net-id"}
+	for _, err := range []error{
+		fmt.Errorf("updating network: %w", requestErr),
+		orcerrors.Terminal(orcv1alpha1.ConditionReasonInvalidConfiguration, "invalid configuration", requestErr),
+	} {
+		rs := progress.WrapError(err)
+		if rsErr := rs.GetError(); rsErr != nil {
+			t.Errorf("expected no error, got %v", rsErr)
+		}
+		want := "Would send PUT /v2.0/networks/net-id"
+		if actions := rs.GetDryRunActions(); len(actions) != 1 || actions[0] != want {
+			t.Errorf("got dry run actions %q, want [%q]", actions, want)
+		}
+	}
+}
+
+func TestRecordDryRunActions(t *testing.T) {
+	t.Parallel()
+
+	recorder := record.NewFakeRecorder(10)
+	reconcileStatus := progress.DryRun("Would create OpenStack flavor").
+		WithReconcileStatus(progress.DryRun("Would update OpenStack flavor"))
+	RecordDryRunActions(recorder, &orcv1alpha1.Flavor{}, reconcileStatus)
+
+	events := drainEvents(recorder)
+	want := []string{
+		"Normal " + EventReasonDryRun + " Would create OpenStack flavor",
+		"Normal " + EventReasonDryRun + " Would update OpenStack flavor",
+	}
+	if len(events) != len(want) {
+		t.Fatalf("got events %q, want %q", events, want)
+	}
+	for i := range events {
+		if events[i] != want[i] {
+			t.Errorf("got event %q, want %q", events[i], want[i])
+		}
+	}
+}
+
+func TestControllerDefaultsIsDryRun(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		defaultDryRun bool
+		annotation    *string
+		want          bool
+	}{
+		{name: "no annotation", want: false},
+		{name: "no annotation with default", defaultDryRun: true, want: true},
+		{name: "annotation true", annotation: ptr.To("true"), want: true},
+		{name: "annotation false overrides default", defaultDryRun: true, annotation: ptr.To("false"), want: false},
+		{name: "unrecognised annotation", annotation: ptr.To("yes please"), want: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			obj := &orcv1alpha1.Flavor{}
+			if tc.annotation != nil {
+				obj.SetAnnotations(map[string]string{orcv1alpha1.DryRunAnnotation: *tc.annotation})
+			}
+
+			defaults := interfaces.ControllerDefaults{DryRun: tc.defaultDryRun}
+			if got := defaults.IsDryRun(obj); got != tc.want {
+				t.Errorf("IsDryRun() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
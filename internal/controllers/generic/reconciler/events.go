@@ -0,0 +1,80 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"errors"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+
+	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/progress"
+	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
+)
+
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+
+// Reasons of Kubernetes Events emitted by the generic reconciler. Terminal
+// errors are emitted with the reason of the terminal error.
+const (
+	// EventReasonCreated indicates that the controller created an OpenStack
+	// resource, either for the first time or after external deletion.
+	EventReasonCreated = "Created"
+
+	// EventReasonAdopted indicates that the controller adopted an OpenStack
+	// resource which it previously created.
+	EventReasonAdopted = "Adopted"
+
+	// EventReasonImported indicates that the controller imported an existing
+	// OpenStack resource.
+	EventReasonImported = "Imported"
+
+	// EventReasonUpdated indicates that the controller modified an OpenStack
+	// resource to match the desired state.
+	EventReasonUpdated = "Updated"
+
+	// EventReasonExternallyDeleted indicates that a managed OpenStack resource
+	// was deleted outside of ORC and will be recreated.
+	EventReasonExternallyDeleted = "ExternallyDeleted"
+
+	// EventReasonDeleted indicates that the controller deleted an OpenStack
+	// resource.
+	EventReasonDeleted = "Deleted"
+
+	// EventReasonDryRun indicates that the controller did not modify an
+	// OpenStack resource because it is in dry run mode.
+	EventReasonDryRun = "DryRun"
+)
+
+// recordTerminalError emits a Warning event if reconcileStatus contains a
+// terminal error.
+func recordTerminalError(recorder record.EventRecorder, object runtime.Object, reconcileStatus progress.ReconcileStatus) {
+	var terminalError *orcerrors.TerminalError
+	if errors.As(reconcileStatus.GetError(), &terminalError) {
+		recorder.Event(object, corev1.EventTypeWarning, terminalError.Reason, terminalError.Message)
+	}
+}
+
+// RecordDryRunActions emits an event for each action which reconcileStatus
+// indicates was not performed due to dry run. It is exported for controllers
+// which do not use the generic reconciler.
+func RecordDryRunActions(recorder record.EventRecorder, object runtime.Object, reconcileStatus progress.ReconcileStatus) {
+	for _, action := range reconcileStatus.GetDryRunActions() {
+		recorder.Event(object, corev1.EventTypeNormal, EventReasonDryRun, action)
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"k8s.io/client-go/tools/record"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/progress"
+	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
+)
+
+// drainEvents returns all events currently buffered in recorder.
+func drainEvents(recorder *record.FakeRecorder) []string {
+	var events []string
+	for {
+		select {
+		case event := <-recorder.Events:
+			events = append(events, event)
+		default:
+			return events
+		}
+	}
+}
+
+// TestGetOrCreateOSResource_ImportByIDEvent verifies that importing a resource
+// by ID emits an Imported event.
+func TestGetOrCreateOSResource_ImportByIDEvent(t *testing.T) {
+	t.Parallel()
+
+	const importID = "imported-flavor-id"
+	recorder := record.NewFakeRecorder(10)
+
+	actuator := &noWriteActuator{t: t, readByIDResult: &fakeOSResource{ID: importID}}
+	adapter := unmanagedFlavorWithImportID(importID)
+
+	_, _ = GetOrCreateOSResource(context.Background(), logr.Discard(), &fakeResourceController{recorder: recorder}, adapter, actuator, false)
+
+	events := drainEvents(recorder)
+	want := "Normal " + EventReasonImported + " Imported OpenStack resource " + importID + " by ID"
+	if len(events) != 1 || events[0] != want {
+		t.Errorf("got events %q, want [%q]", events, want)
+	}
+}
+
+// TestGetOrCreateOSResource_ExistingResourceNoEvent verifies that fetching a
+// resource which is already referenced by status.id does not emit an event.
+func TestGetOrCreateOSResource_ExistingResourceNoEvent(t *testing.T) {
+	t.Parallel()
+
+	const resourceID = "flavor-id"
+	recorder := record.NewFakeRecorder(10)
+
+	actuator := &noWriteActuator{t: t, readByIDResult: &fakeOSResource{ID: resourceID}}
+	adapter := unmanagedFlavorWithStatusID(resourceID)
+
+	_, _ = GetOrCreateOSResource(context.Background(), logr.Discard(), &fakeResourceController{recorder: recorder}, adapter, actuator, false)
+
+	if events := drainEvents(recorder); len(events) != 0 {
+		t.Errorf("got events %q, want none", events)
+	}
+}
+
+func TestRecordTerminalError(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		reconcileStatus progress.ReconcileStatus
+		want            []string
+	}{
+		{
+			name: "no error",
+		},
+		{
+			name:            "transient error",
+			reconcileStatus: progress.WrapError(errors.New("transient")),
+		},
+		{
+			name: "terminal error",
+			reconcileStatus: progress.WrapError(
+				orcerrors.Terminal(orcv1alpha1.ConditionReasonInvalidConfiguration, "invalid spec")),
+			want: []string{"Warning " + orcv1alpha1.ConditionReasonInvalidConfiguration + " invalid spec"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			recorder := record.NewFakeRecorder(10)
+			recordTerminalError(recorder, &orcv1alpha1.Flavor{}, tc.reconcileStatus)
+
+			events := drainEvents(recorder)
+			if len(events) != len(tc.want) {
+				t.Fatalf("got events %q, want %q", events, tc.want)
+			}
+			for i := range events {
+				if events[i] != tc.want[i] {
+					t.Errorf("got event %q, want %q", events[i], tc.want[i])
+				}
+			}
+		})
+	}
+}
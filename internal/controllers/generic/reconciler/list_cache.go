@@ -0,0 +1,187 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/interfaces"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/progress"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/logging"
+)
+
+// listCache caches lists of all the OpenStack resources of a single kind,
+// indexed by the list cache key of the actuator which listed them.
+type listCache[osResourceT any] struct {
+	ttl time.Duration
+
+	mu    sync.Mutex
+	lists map[string]*resourceList[osResourceT]
+}
+
+// resourceList is a snapshot of all the resources with a list cache key.
+type resourceList[osResourceT any] struct {
+	// mu is held while listing, so concurrent reconciles with the same key
+	// wait for a single list instead of each listing the resources
+	mu        sync.Mutex
+	listedAt  time.Time
+	resources map[string]*osResourceT
+}
+
+func newListCache[osResourceT any](ttl time.Duration) *listCache[osResourceT] {
+	return &listCache[osResourceT]{
+		ttl:   ttl,
+		lists: make(map[string]*resourceList[osResourceT]),
+	}
+}
+
+// get returns the resource with the given id from the list of resources
+// identified by key, listing the resources again if the list is older than
+// the cache's ttl. It returns nil if the resource is not in the list, or if
+// the list was not listed after notBefore.
+func (c *listCache[osResourceT]) get(
+	ctx context.Context, log logr.Logger, key string, id string, notBefore time.Time,
+	list func(context.Context) ([]*osResourceT, error), getID func(*osResourceT) string,
+) *osResourceT {
+	c.mu.Lock()
+	resources, ok := c.lists[key]
+	if !ok {
+		resources = &resourceList[osResourceT]{}
+		c.lists[key] = resources
+	}
+	c.mu.Unlock()
+
+	resources.mu.Lock()
+	defer resources.mu.Unlock()
+
+	if time.Since(resources.listedAt) >= c.ttl {
+		log.V(logging.Verbose).Info("Listing OpenStack resources for list cache")
+		listed, err := list(ctx)
+		if err != nil {
+			// Fall back to fetching resources individually until the list
+			// is due to be refreshed again
+			log.Error(err, "listing OpenStack resources for list cache")
+			listed = nil
+		}
+
+		resources.resources = make(map[string]*osResourceT, len(listed))
+		for _, osResource := range listed {
+			resources.resources[getID(osResource)] = osResource
+		}
+		resources.listedAt = time.Now()
+	}
+
+	if !resources.listedAt.After(notBefore) {
+		return nil
+	}
+
+	osResource, ok := resources.resources[id]
+	if !ok {
+		return nil
+	}
+
+	// Resources are shared by concurrent reconciles, so return a copy
+	resourceCopy := *osResource
+	return &resourceCopy
+}
+
+// listCachingActuator is a CreateResourceActuator whose GetOSResourceByID
+// returns resources from a list cache if possible.
+type listCachingActuator[
+	orcObjectPT interface {
+		*orcObjectT
+		client.Object
+		orcv1alpha1.ObjectWithConditions
+	}, orcObjectT any,
+	filterT any,
+	osResourceT any,
+] struct {
+	interfaces.CreateResourceActuator[orcObjectPT, orcObjectT, filterT, osResourceT]
+
+	lister    interfaces.ListResourceActuator[osResourceT]
+	cache     *listCache[osResourceT]
+	notBefore time.Time
+}
+
+func (a listCachingActuator[_, _, _, osResourceT]) GetOSResourceByID(ctx context.Context, id string) (*osResourceT, progress.ReconcileStatus) {
+	list := func(ctx context.Context) ([]*osResourceT, error) {
+		var listed []*osResourceT
+		for osResource, err := range a.lister.ListOSResourcesForCache(ctx) {
+			if err != nil {
+				return nil, err
+			}
+			listed = append(listed, osResource)
+		}
+		return listed, nil
+	}
+
+	log := ctrl.LoggerFrom(ctx)
+	if osResource := a.cache.get(ctx, log, a.lister.GetListCacheKey(), id, a.notBefore, list, a.GetResourceID); osResource != nil {
+		log.V(logging.Debug).Info("Got OpenStack resource from list cache")
+		return osResource, nil
+	}
+
+	// The resource may have been created since the list was fetched, or may
+	// have been deleted. Either way, we must fetch it to be sure.
+	return a.CreateResourceActuator.GetOSResourceByID(ctx, id)
+}
+
+// withListCache returns an actuator which serves GetOSResourceByID from the
+// controller's list cache if the list cache is enabled and supported by the
+// actuator. The list cache is only used to resync objects which are up to
+// date, so that an object whose resource is being updated or is changing
+// state in OpenStack always observes its current state.
+func withListCache[
+	orcObjectPT interface {
+		*orcObjectT
+		client.Object
+		orcv1alpha1.ObjectWithConditions
+	}, orcObjectT any,
+	filterT any,
+	osResourceT any,
+](
+	cache *listCache[osResourceT], actuator interfaces.CreateResourceActuator[orcObjectPT, orcObjectT, filterT, osResourceT],
+	obj orcObjectPT, lastSyncTime *metav1.Time,
+) interfaces.CreateResourceActuator[orcObjectPT, orcObjectT, filterT, osResourceT] {
+	if cache == nil || lastSyncTime == nil || !isSpecReconciled(obj) {
+		return actuator
+	}
+
+	lister, ok := actuator.(interfaces.ListResourceActuator[osResourceT])
+	if !ok || lister.GetListCacheKey() == "" {
+		return actuator
+	}
+
+	return listCachingActuator[orcObjectPT, orcObjectT, filterT, osResourceT]{
+		CreateResourceActuator: actuator,
+		lister:                 lister,
+		cache:                  cache,
+
+		// The resource must have been listed after we last observed it, or
+		// we could observe a state older than the one reflected in its
+		// status. lastSyncTime only has a resolution of one second.
+		notBefore: lastSyncTime.Add(time.Second),
+	}
+}
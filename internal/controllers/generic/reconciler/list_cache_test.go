@@ -0,0 +1,180 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reconciler
+
+import (
+	"context"
+	"iter"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+)
+
+// listingActuator is a noWriteActuator which also implements
+// ListResourceActuator.
+type listingActuator struct {
+	*noWriteActuator
+
+	listCacheKey string
+	listed       []*fakeOSResource
+	listCalls    int
+}
+
+func (a *listingActuator) GetListCacheKey() string {
+	return a.listCacheKey
+}
+
+func (a *listingActuator) ListOSResourcesForCache(_ context.Context) iter.Seq2[*fakeOSResource, error] {
+	a.listCalls++
+	return func(yield func(*fakeOSResource, error) bool) {
+		for _, r := range a.listed {
+			if !yield(r, nil) {
+				return
+			}
+		}
+	}
+}
+
+// upToDateFlavor returns an object which was successfully reconciled at
+// lastSyncTime.
+func upToDateFlavor(lastSyncTime *metav1.Time) *orcv1alpha1.Flavor {
+	flavor := managedFlavorWithStatusID("cached-id").Flavor
+	flavor.Generation = 1
+	flavor.Status.LastSyncTime = lastSyncTime
+	flavor.Status.Conditions = []metav1.Condition{{
+		Type:               orcv1alpha1.ConditionProgressing,
+		Status:             metav1.ConditionFalse,
+		Reason:             orcv1alpha1.ConditionReasonSuccess,
+		ObservedGeneration: 1,
+	}}
+	return flavor
+}
+
+func TestWithListCache(t *testing.T) {
+	t.Parallel()
+
+	cache := newListCache[fakeOSResource](time.Hour)
+	actuator := &listingActuator{
+		noWriteActuator: &noWriteActuator{t: t, readByIDResult: &fakeOSResource{ID: "fetched-id"}},
+		listCacheKey:    "project",
+		listed:          []*fakeOSResource{{ID: "cached-id"}, {ID: "other-id"}},
+	}
+	flavor := upToDateFlavor(agoPtr(time.Hour))
+	ctx := context.Background()
+
+	getActuator := withListCache(cache, actuator, flavor, flavor.Status.LastSyncTime)
+	for _, id := range []string{"cached-id", "other-id"} {
+		osResource, rs := getActuator.GetOSResourceByID(ctx, id)
+		if rs != nil || osResource == nil || osResource.ID != id {
+			t.Errorf("%s: expected resource from list cache, got %v, %v", id, osResource, rs)
+		}
+	}
+	if actuator.listCalls != 1 {
+		t.Errorf("expected resources to be listed once, listed %d times", actuator.listCalls)
+	}
+	if actuator.getByIDCalled {
+		t.Error("GetOSResourceByID was called for a resource in the list cache")
+	}
+
+	// A resource which is not in the list is fetched
+	osResource, _ := getActuator.GetOSResourceByID(ctx, "new-id")
+	if osResource == nil || osResource.ID != "fetched-id" || !actuator.getByIDCalled {
+		t.Errorf("expected resource missing from the list cache to be fetched, got %v", osResource)
+	}
+}
+
+func TestWithListCache_NotUsed(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		listCacheKey string
+		flavor       func() *orcv1alpha1.Flavor
+	}{
+		{
+			name:         "no list cache key",
+			listCacheKey: "",
+			flavor:       func() *orcv1alpha1.Flavor { return upToDateFlavor(agoPtr(time.Hour)) },
+		},
+		{
+			name:         "never synced",
+			listCacheKey: "project",
+			flavor:       func() *orcv1alpha1.Flavor { return upToDateFlavor(nil) },
+		},
+		{
+			name:         "spec not reconciled",
+			listCacheKey: "project",
+			flavor: func() *orcv1alpha1.Flavor {
+				flavor := upToDateFlavor(agoPtr(time.Hour))
+				flavor.Generation = 2
+				return flavor
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			actuator := &listingActuator{
+				noWriteActuator: &noWriteActuator{t: t, readByIDResult: &fakeOSResource{ID: "cached-id"}},
+				listCacheKey:    tc.listCacheKey,
+				listed:          []*fakeOSResource{{ID: "cached-id"}},
+			}
+			flavor := tc.flavor()
+
+			getActuator := withListCache(newListCache[fakeOSResource](time.Hour), actuator, flavor, flavor.Status.LastSyncTime)
+			if _, rs := getActuator.GetOSResourceByID(context.Background(), "cached-id"); rs != nil {
+				t.Fatalf("unexpected reconcile status: %v", rs)
+			}
+			if actuator.listCalls != 0 || !actuator.getByIDCalled {
+				t.Error("expected resource to be fetched without the list cache")
+			}
+		})
+	}
+}
+
+func TestWithListCache_ListedBeforeLastSync(t *testing.T) {
+	t.Parallel()
+
+	cache := newListCache[fakeOSResource](time.Hour)
+	actuator := &listingActuator{
+		noWriteActuator: &noWriteActuator{t: t, readByIDResult: &fakeOSResource{ID: "cached-id"}},
+		listCacheKey:    "project",
+		listed:          []*fakeOSResource{{ID: "cached-id"}},
+	}
+	ctx := context.Background()
+
+	// Populate the list cache
+	flavor := upToDateFlavor(agoPtr(time.Hour))
+	if _, rs := withListCache(cache, actuator, flavor, flavor.Status.LastSyncTime).GetOSResourceByID(ctx, "cached-id"); rs != nil {
+		t.Fatalf("unexpected reconcile status: %v", rs)
+	}
+
+	// The object was synced after the list, so the list may be older than
+	// its status
+	flavor = upToDateFlavor(nowPtr())
+	if _, rs := withListCache(cache, actuator, flavor, flavor.Status.LastSyncTime).GetOSResourceByID(ctx, "cached-id"); rs != nil {
+		t.Fatalf("unexpected reconcile status: %v", rs)
+	}
+	if actuator.listCalls != 1 || !actuator.getByIDCalled {
+		t.Error("expected resource to be fetched instead of using a list older than the last sync")
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
@@ -30,6 +31,7 @@ import (
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/interfaces"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/progress"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/logging"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/metrics"
 	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/util/finalizers"
 	orcstrings "github.com/k-orc/openstack-resource-controller/v2/internal/util/strings"
@@ -55,6 +57,7 @@ func GetOrCreateOSResource[
 	ctx context.Context, log logr.Logger, controller ResourceController,
 	objAdapter interfaces.APIObjectAdapter[orcObjectPT, resourceSpecT, filterT],
 	actuator interfaces.CreateResourceActuator[orcObjectPT, orcObjectT, filterT, osResourceT],
+	dryRun bool,
 ) (*osResourceT, progress.ReconcileStatus) {
 	k8sClient := controller.GetK8sClient()
 
@@ -80,6 +83,7 @@ func GetOrCreateOSResource[
 					log.V(logging.Info).Info("OpenStack resource was deleted externally; will signal caller to clear status ID and trigger recreation")
 					return nil, progress.ExternallyDeleted()
 				}
+				metrics.RecordExternalDeletion(controller.GetName())
 				return osResource, progress.WrapError(
 					orcerrors.Terminal(orcv1alpha1.ConditionReasonUnrecoverableError, "resource has been deleted from OpenStack"))
 			} else {
@@ -107,6 +111,8 @@ func GetOrCreateOSResource[
 		}
 		if osResource != nil {
 			log.V(logging.Verbose).Info("Imported existing OpenStack resource by ID", "ID", actuator.GetResourceID(osResource))
+			controller.GetEventRecorder().Eventf(objAdapter.GetObject(), corev1.EventTypeNormal, EventReasonImported,
+				"Imported OpenStack resource %s by ID", actuator.GetResourceID(osResource))
 		}
 		return osResource, nil
 	}
@@ -126,6 +132,8 @@ func GetOrCreateOSResource[
 		if osResource == nil {
 			return nil, progress.WaitingOnOpenStack(progress.WaitingOnCreation, externalUpdatePollingPeriod)
 		}
+		controller.GetEventRecorder().Eventf(objAdapter.GetObject(), corev1.EventTypeNormal, EventReasonImported,
+			"Imported OpenStack resource %s by filter", actuator.GetResourceID(osResource))
 		return osResource, reconcileStatus
 	}
 
@@ -143,11 +151,24 @@ func GetOrCreateOSResource[
 	}
 	if osResource != nil {
 		log.V(logging.Info).Info("Adopted previously created resource")
+		metrics.RecordAdoption(controller.GetName())
+		controller.GetEventRecorder().Eventf(objAdapter.GetObject(), corev1.EventTypeNormal, EventReasonAdopted,
+			"Adopted previously created OpenStack resource %s", actuator.GetResourceID(osResource))
 		return osResource, nil
 	}
 
+	if dryRun {
+		log.V(logging.Info).Info("Dry run: not creating resource")
+		return nil, progress.DryRun(fmt.Sprintf("Would create OpenStack %s", controller.GetName()))
+	}
+
 	log.V(logging.Info).Info("Creating resource")
-	return actuator.CreateResource(ctx, objAdapter.GetObject())
+	osResource, reconcileStatus := actuator.CreateResource(ctx, objAdapter.GetObject())
+	if osResource != nil {
+		controller.GetEventRecorder().Eventf(objAdapter.GetObject(), corev1.EventTypeNormal, EventReasonCreated,
+			"Created OpenStack resource %s", actuator.GetResourceID(osResource))
+	}
+	return osResource, reconcileStatus
 }
 
 func DeleteResource[
@@ -162,6 +183,7 @@ func DeleteResource[
 	ctx context.Context, log logr.Logger, controller ResourceController,
 	objAdapter interfaces.APIObjectAdapter[orcObjectPT, resourceSpecT, filterT],
 	actuator interfaces.DeleteResourceActuator[orcObjectPT, orcObjectT, osResourceT],
+	dryRun bool,
 ) (bool, *osResourceT, progress.ReconcileStatus) {
 	var osResource *osResourceT
 
@@ -237,10 +259,30 @@ func DeleteResource[
 
 	if osResource == nil {
 		log.V(logging.Info).Info("Resource deletion confirmed")
+		if statusID != nil {
+			controller.GetEventRecorder().Eventf(objAdapter.GetObject(), corev1.EventTypeNormal, EventReasonDeleted,
+				"Deleted OpenStack resource %s", *statusID)
+		}
 
 		return true, osResource, removeFinalizer(reconcileStatus)
 	}
 
+	// We keep the finalizer so the resource will be deleted when deletion
+	// protection is disabled
+	if managedOptions.GetDeletionProtection() {
+		log.V(logging.Info).Info("Not deleting OpenStack resource due to deletion protection")
+		return false, osResource, reconcileStatus.WithError(orcerrors.Terminal(orcv1alpha1.ConditionReasonDeletionProtected,
+			fmt.Sprintf("Not deleting OpenStack %s %s: spec.managedOptions.deletionProtection is enabled", controller.GetName(), actuator.GetResourceID(osResource))))
+	}
+
+	// We keep the finalizer so the resource will be deleted when dry run is
+	// disabled
+	if dryRun {
+		log.V(logging.Info).Info("Dry run: not deleting OpenStack resource")
+		return false, osResource, reconcileStatus.DryRun(
+			fmt.Sprintf("Would delete OpenStack %s %s", controller.GetName(), actuator.GetResourceID(osResource)))
+	}
+
 	log.V(logging.Info).Info("Deleting OpenStack resource")
 	deleteRS := actuator.DeleteResource(ctx, objAdapter.GetObject(), osResource)
 	if needsReschedule, _ := deleteRS.NeedsReschedule(); needsReschedule {
@@ -31,7 +31,9 @@ import (
 	"testing"
 
 	"github.com/go-logr/logr"
+	"k8s.io/utils/ptr"
 
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
 	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
 )
 
@@ -51,7 +53,7 @@ func TestGetOrCreateOSResource_ExternalDeletion_ManagedOrcCreated(t *testing.T)
 	actuator := &noWriteActuator{t: t, readByIDErr: notFoundErr()}
 	adapter := managedFlavorWithStatusID(resourceID)
 
-	got, rs := GetOrCreateOSResource(context.Background(), logr.Discard(), &fakeResourceController{}, adapter, actuator)
+	got, rs := GetOrCreateOSResource(context.Background(), logr.Discard(), &fakeResourceController{}, adapter, actuator, false)
 
 	if !rs.IsExternallyDeleted() {
 		t.Fatal("expected IsExternallyDeleted for externally-deleted managed resource")
@@ -75,7 +77,7 @@ func TestGetOrCreateOSResource_ExternalDeletion_Unmanaged(t *testing.T) {
 	actuator := &noWriteActuator{t: t, readByIDErr: notFoundErr()}
 	adapter := unmanagedFlavorWithStatusID(resourceID)
 
-	_, rs := GetOrCreateOSResource(context.Background(), logr.Discard(), &fakeResourceController{}, adapter, actuator)
+	_, rs := GetOrCreateOSResource(context.Background(), logr.Discard(), &fakeResourceController{}, adapter, actuator, false)
 
 	_, err := rs.NeedsReschedule()
 	if err == nil {
@@ -104,7 +106,7 @@ func TestGetOrCreateOSResource_ExternalDeletion_ManagedResourceExists(t *testing
 	actuator := &noWriteActuator{t: t, readByIDResult: osResource}
 	adapter := managedFlavorWithStatusID(resourceID)
 
-	got, rs := GetOrCreateOSResource(context.Background(), logr.Discard(), &fakeResourceController{}, adapter, actuator)
+	got, rs := GetOrCreateOSResource(context.Background(), logr.Discard(), &fakeResourceController{}, adapter, actuator, false)
 
 	needsReschedule, err := rs.NeedsReschedule()
 	if needsReschedule {
@@ -117,3 +119,29 @@ func TestGetOrCreateOSResource_ExternalDeletion_ManagedResourceExists(t *testing
 		t.Error("GetOSResourceByID was not called")
 	}
 }
+
+// TestDeleteResource_DeletionProtection verifies that a managed resource with
+// deletion protection is not deleted, that the object's finalizer is retained,
+// and that a terminal error is reported until protection is lifted.
+func TestDeleteResource_DeletionProtection(t *testing.T) {
+	t.Parallel()
+
+	const resourceID = "flavor-id"
+
+	actuator := noDeleteActuator{&noWriteActuator{t: t, readByIDResult: &fakeOSResource{ID: resourceID}}}
+	adapter := managedFlavorWithStatusID(resourceID)
+	adapter.Spec.ManagedOptions = &orcv1alpha1.ManagedOptions{DeletionProtection: ptr.To(true)}
+
+	deleted, _, rs := DeleteResource(context.Background(), logr.Discard(), &fakeResourceController{}, adapter, actuator, false)
+
+	if deleted {
+		t.Error("expected finalizer to be retained with deletion protection")
+	}
+	var terminalError *orcerrors.TerminalError
+	if !errors.As(rs.GetError(), &terminalError) {
+		t.Fatalf("expected terminal error, got %v", rs.GetError())
+	}
+	if terminalError.Reason != orcv1alpha1.ConditionReasonDeletionProtected {
+		t.Errorf("got reason %s, want %s", terminalError.Reason, orcv1alpha1.ConditionReasonDeletionProtected)
+	}
+}
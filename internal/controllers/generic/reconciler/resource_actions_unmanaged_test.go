@@ -38,6 +38,7 @@ import (
 	"github.com/gophercloud/gophercloud/v2"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -196,7 +197,9 @@ func (a fakeAdapter) GetImportFilter() *orcv1alpha1.FlavorFilter {
 // the finalizer on the ORC object so that no Kubernetes Patch is needed.
 // --------------------------------------------------------------------------
 
-type fakeResourceController struct{}
+type fakeResourceController struct {
+	recorder record.EventRecorder
+}
 
 var _ ResourceController = &fakeResourceController{}
 
@@ -209,6 +212,15 @@ func (c *fakeResourceController) GetK8sClient() client.Client { return nil }
 
 func (c *fakeResourceController) GetScopeFactory() scope.Factory { return nil }
 
+// GetEventRecorder returns the test's recorder if one was set, or a recorder
+// which discards all events.
+func (c *fakeResourceController) GetEventRecorder() record.EventRecorder {
+	if c.recorder != nil {
+		return c.recorder
+	}
+	return &record.FakeRecorder{}
+}
+
 // --------------------------------------------------------------------------
 // Helpers for building test Flavors.
 //
@@ -348,7 +360,7 @@ func TestGetOrCreateOSResource_UnmanagedByStatusID(t *testing.T) {
 	actuator := &noWriteActuator{t: t, readByIDResult: osResource}
 	adapter := unmanagedFlavorWithStatusID(resourceID)
 
-	got, rs := GetOrCreateOSResource(context.Background(), logr.Discard(), &fakeResourceController{}, adapter, actuator)
+	got, rs := GetOrCreateOSResource(context.Background(), logr.Discard(), &fakeResourceController{}, adapter, actuator, false)
 
 	if needsReschedule, err := rs.NeedsReschedule(); needsReschedule {
 		t.Fatalf("unexpected reconcile status: needsReschedule=%v err=%v", needsReschedule, err)
@@ -378,7 +390,7 @@ func TestGetOrCreateOSResource_UnmanagedByImportID(t *testing.T) {
 	actuator := &noWriteActuator{t: t, readByIDResult: osResource}
 	adapter := unmanagedFlavorWithImportID(importID)
 
-	got, rs := GetOrCreateOSResource(context.Background(), logr.Discard(), &fakeResourceController{}, adapter, actuator)
+	got, rs := GetOrCreateOSResource(context.Background(), logr.Discard(), &fakeResourceController{}, adapter, actuator, false)
 
 	if needsReschedule, err := rs.NeedsReschedule(); needsReschedule {
 		t.Fatalf("unexpected reconcile status: needsReschedule=%v err=%v", needsReschedule, err)
@@ -405,7 +417,7 @@ func TestGetOrCreateOSResource_UnmanagedByFilter(t *testing.T) {
 	actuator := &noWriteActuator{t: t, listResult: []*fakeOSResource{osResource}}
 	adapter := unmanagedFlavorWithFilter(filter)
 
-	got, rs := GetOrCreateOSResource(context.Background(), logr.Discard(), &fakeResourceController{}, adapter, actuator)
+	got, rs := GetOrCreateOSResource(context.Background(), logr.Discard(), &fakeResourceController{}, adapter, actuator, false)
 
 	if needsReschedule, err := rs.NeedsReschedule(); needsReschedule {
 		t.Fatalf("unexpected reconcile status: needsReschedule=%v err=%v", needsReschedule, err)
@@ -428,7 +440,7 @@ func TestGetOrCreateOSResource_UnmanagedNoImport(t *testing.T) {
 	actuator := &noWriteActuator{t: t}
 	adapter := unmanagedFlavorNoImport()
 
-	_, rs := GetOrCreateOSResource(context.Background(), logr.Discard(), &fakeResourceController{}, adapter, actuator)
+	_, rs := GetOrCreateOSResource(context.Background(), logr.Discard(), &fakeResourceController{}, adapter, actuator, false)
 
 	_, err := rs.NeedsReschedule()
 	if err == nil {
@@ -462,7 +474,7 @@ func TestGetOrCreateOSResource_UnmanagedStatusIDDeleted(t *testing.T) {
 	actuator := &noWriteActuator{t: t, readByIDErr: notFoundErr()}
 	adapter := unmanagedFlavorWithStatusID(resourceID)
 
-	_, rs := GetOrCreateOSResource(context.Background(), logr.Discard(), &fakeResourceController{}, adapter, actuator)
+	_, rs := GetOrCreateOSResource(context.Background(), logr.Discard(), &fakeResourceController{}, adapter, actuator, false)
 
 	_, err := rs.NeedsReschedule()
 	if err == nil {
@@ -495,7 +507,7 @@ func TestGetOrCreateOSResource_ManagedStatusIDDeleted(t *testing.T) {
 	actuator := &noWriteActuator{t: t, readByIDErr: notFoundErr()}
 	adapter := managedFlavorWithStatusID(resourceID)
 
-	got, rs := GetOrCreateOSResource(context.Background(), logr.Discard(), &fakeResourceController{}, adapter, actuator)
+	got, rs := GetOrCreateOSResource(context.Background(), logr.Discard(), &fakeResourceController{}, adapter, actuator, false)
 
 	// Expect a typed signal: status.id should be cleared and recreation triggered.
 	if !rs.IsExternallyDeleted() {
@@ -53,41 +53,14 @@ func SetCommonConditions[T any](
 		WithType(orcv1alpha1.ConditionAvailable).
 		WithStatus(availableStatus).
 		WithObservedGeneration(orcObject.GetGeneration())
+	progressingStatus, progressingReason, progressingMessage := progressingState(reconcileStatus)
 	progressingCondition := applyconfigv1.Condition().
 		WithType(orcv1alpha1.ConditionProgressing).
+		WithStatus(progressingStatus).
+		WithReason(progressingReason).
+		WithMessage(progressingMessage).
 		WithObservedGeneration(orcObject.GetGeneration())
 
-	// We are Progressing iff we are anticipating being reconciled again. This
-	// means one of:
-	// - err contains a non-terminal error, so we expect an error backoff
-	// - reconcileStatus does not indicate that we are waiting on some condition
-
-	if needsReschedule, _ := reconcileStatus.NeedsReschedule(); !needsReschedule {
-		progressingCondition.
-			WithStatus(metav1.ConditionFalse).
-			WithReason(orcv1alpha1.ConditionReasonSuccess).
-			WithMessage("OpenStack resource is up to date")
-	} else {
-		err := reconcileStatus.GetError()
-		var terminalError *orcerrors.TerminalError
-		if errors.As(err, &terminalError) {
-			progressingCondition.
-				WithStatus(metav1.ConditionFalse).
-				WithReason(terminalError.Reason).
-				WithMessage(terminalError.Message)
-		} else if err != nil {
-			progressingCondition.
-				WithStatus(metav1.ConditionTrue).
-				WithReason(orcv1alpha1.ConditionReasonTransientError).
-				WithMessage(err.Error())
-		} else {
-			progressingCondition.
-				WithStatus(metav1.ConditionTrue).
-				WithReason(orcv1alpha1.ConditionReasonProgressing).
-				WithMessage(strings.Join(reconcileStatus.GetProgressMessages(), "\n"))
-		}
-	}
-
 	if availableStatus == metav1.ConditionTrue {
 		availableCondition.
 			WithReason(orcv1alpha1.ConditionReasonSuccess).
@@ -112,3 +85,99 @@ func SetCommonConditions[T any](
 
 	applyConfig.WithConditions(availableCondition, progressingCondition)
 }
+
+// ProgressingReason returns the reason of the Progressing condition which will
+// be set for the given reconcileStatus.
+func ProgressingReason(reconcileStatus progress.ReconcileStatus) string {
+	_, reason, _ := progressingState(reconcileStatus)
+	return reason
+}
+
+func progressingState(reconcileStatus progress.ReconcileStatus) (metav1.ConditionStatus, string, string) {
+	// We are Progressing iff we are anticipating being reconciled again. This
+	// means one of:
+	// - err contains a non-terminal error, so we expect an error backoff
+	// - reconcileStatus does not indicate that we are waiting on some condition
+
+	if needsReschedule, _ := reconcileStatus.NeedsReschedule(); !needsReschedule {
+		return metav1.ConditionFalse, orcv1alpha1.ConditionReasonSuccess, "OpenStack resource is up to date"
+	}
+
+	err := reconcileStatus.GetError()
+	var terminalError *orcerrors.TerminalError
+	if errors.As(err, &terminalError) {
+		return metav1.ConditionFalse, terminalError.Reason, terminalError.Message
+	} else if err != nil {
+		return metav1.ConditionTrue, orcv1alpha1.ConditionReasonTransientError, err.Error()
+	} else if reconcileStatus.IsRateLimited() {
+		return metav1.ConditionTrue, orcv1alpha1.ConditionReasonRateLimited, strings.Join(reconcileStatus.GetProgressMessages(), "\n")
+	} else if len(reconcileStatus.GetDryRunActions()) > 0 {
+		return metav1.ConditionTrue, orcv1alpha1.ConditionReasonDryRun, strings.Join(reconcileStatus.GetProgressMessages(), "\n")
+	}
+	return metav1.ConditionTrue, orcv1alpha1.ConditionReasonProgressing, strings.Join(reconcileStatus.GetProgressMessages(), "\n")
+}
+
+// SetDriftCondition sets the Drifted condition from a list of drifted field
+// paths. It is only used when drift is reported rather than corrected.
+func SetDriftCondition[T any](
+	orcObject orcv1alpha1.ObjectWithConditions,
+	applyConfig WithConditionsApplyConfiguration[T],
+	drift []string,
+	now metav1.Time,
+) {
+	driftedCondition := applyconfigv1.Condition().
+		WithType(orcv1alpha1.ConditionDrifted).
+		WithObservedGeneration(orcObject.GetGeneration())
+
+	if len(drift) > 0 {
+		driftedCondition.
+			WithStatus(metav1.ConditionTrue).
+			WithReason(orcv1alpha1.ConditionReasonDriftDetected).
+			WithMessage("OpenStack resource differs from spec: " + strings.Join(drift, ", "))
+	} else {
+		driftedCondition.
+			WithStatus(metav1.ConditionFalse).
+			WithReason(orcv1alpha1.ConditionReasonNoDrift).
+			WithMessage("OpenStack resource matches spec")
+	}
+
+	previous := meta.FindStatusCondition(orcObject.GetConditions(), orcv1alpha1.ConditionDrifted)
+	if previous != nil && applyconfigs.ConditionsEqual(previous, driftedCondition) {
+		driftedCondition.WithLastTransitionTime(previous.LastTransitionTime)
+	} else {
+		driftedCondition.WithLastTransitionTime(now)
+	}
+
+	applyConfig.WithConditions(driftedCondition)
+}
+
+// SetCredentialsCondition sets the CredentialsFailing condition if the
+// reconcile failed because OpenStack rejected the object's credentials. The
+// condition is omitted otherwise, which removes it if it was previously set.
+func SetCredentialsCondition[T any](
+	orcObject orcv1alpha1.ObjectWithConditions,
+	applyConfig WithConditionsApplyConfiguration[T],
+	reconcileStatus progress.ReconcileStatus,
+	now metav1.Time,
+) {
+	err := reconcileStatus.GetError()
+	if !orcerrors.IsAuthenticationError(err) {
+		return
+	}
+
+	credentialsCondition := applyconfigv1.Condition().
+		WithType(orcv1alpha1.ConditionCredentialsFailing).
+		WithStatus(metav1.ConditionTrue).
+		WithReason(orcv1alpha1.ConditionReasonAuthenticationFailed).
+		WithMessage(err.Error()).
+		WithObservedGeneration(orcObject.GetGeneration())
+
+	previous := meta.FindStatusCondition(orcObject.GetConditions(), orcv1alpha1.ConditionCredentialsFailing)
+	if previous != nil && applyconfigs.ConditionsEqual(previous, credentialsCondition) {
+		credentialsCondition.WithLastTransitionTime(previous.LastTransitionTime)
+	} else {
+		credentialsCondition.WithLastTransitionTime(now)
+	}
+
+	applyConfig.WithConditions(credentialsCondition)
+}
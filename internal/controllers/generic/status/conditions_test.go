@@ -17,7 +17,12 @@ limitations under the License.
 package status
 
 import (
+	"fmt"
+	"net/http"
 	"testing"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -114,6 +119,61 @@ func TestSetCommonConditions_TerminalErrorWithFalseRemainingFalse(t *testing.T)
 	}
 }
 
+// TestProgressingReason_RateLimited verifies that a throttling response from
+// OpenStack is reported with the RateLimited reason and requeued after the
+// requested interval instead of being returned as an error.
+func TestProgressingReason_RateLimited(t *testing.T) {
+	t.Parallel()
+
+	throttled := gophercloud.ErrUnexpectedResponseCode{
+		Actual:         http.StatusTooManyRequests,
+		ResponseHeader: http.Header{"Retry-After": []string{"30"}},
+	}
+
+	tests := []struct {
+		name        string
+		status      progress.ReconcileStatus
+		wantReason  string
+		wantRequeue time.Duration
+	}{
+		{
+			name:        "throttled",
+			status:      progress.WrapError(fmt.Errorf("fetching resource: %w", throttled)),
+			wantReason:  orcv1alpha1.ConditionReasonRateLimited,
+			wantRequeue: 30 * time.Second,
+		},
+		{
+			name:        "throttled and waiting",
+			status:      progress.WaitingOnOpenStack(progress.WaitingOnReady, time.Minute).WithError(throttled),
+			wantReason:  orcv1alpha1.ConditionReasonRateLimited,
+			wantRequeue: 30 * time.Second,
+		},
+		{
+			name:       "throttled with another error",
+			status:     progress.WrapError(throttled).WithError(fmt.Errorf("another error")),
+			wantReason: orcv1alpha1.ConditionReasonTransientError,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if reason := ProgressingReason(tc.status); reason != tc.wantReason {
+				t.Errorf("reason = %q; want %q", reason, tc.wantReason)
+			}
+			if tc.wantRequeue != 0 {
+				if err := tc.status.GetError(); err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+				if requeue := tc.status.GetRequeue(); requeue != tc.wantRequeue {
+					t.Errorf("requeue = %s; want %s", requeue, tc.wantRequeue)
+				}
+			}
+		})
+	}
+}
+
 // TestSetCommonConditions_NoTerminalErrorKeepsUnknown verifies that when
 // ResourceAvailableStatus returns ConditionUnknown and no terminal error is
 // present (e.g. a transient error or progress), the Available condition remains
@@ -153,3 +213,122 @@ func TestSetCommonConditions_NoTerminalErrorKeepsUnknown(t *testing.T) {
 			*availableCondition, metav1.ConditionUnknown)
 	}
 }
+
+// TestSetDriftCondition verifies the Drifted condition set for reported drift.
+func TestSetDriftCondition(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		drift      []string
+		wantStatus metav1.ConditionStatus
+		wantReason string
+	}{
+		{
+			name:       "no drift",
+			wantStatus: metav1.ConditionFalse,
+			wantReason: orcv1alpha1.ConditionReasonNoDrift,
+		},
+		{
+			name:       "drift",
+			drift:      []string{"spec.resource.description", "spec.resource.name"},
+			wantStatus: metav1.ConditionTrue,
+			wantReason: orcv1alpha1.ConditionReasonDriftDetected,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			flavor := &orcv1alpha1.Flavor{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-flavor",
+					Namespace:  "default",
+					Generation: 2,
+				},
+			}
+
+			applyConfigStatus := orcapplyconfigv1alpha1.FlavorStatus()
+			SetDriftCondition(flavor, applyConfigStatus, tc.drift, metav1.Now())
+
+			if len(applyConfigStatus.Conditions) != 1 {
+				t.Fatalf("got %d conditions; want 1", len(applyConfigStatus.Conditions))
+			}
+			condition := applyConfigStatus.Conditions[0]
+			if *condition.Type != orcv1alpha1.ConditionDrifted {
+				t.Errorf("condition type = %q; want %q", *condition.Type, orcv1alpha1.ConditionDrifted)
+			}
+			if *condition.Status != tc.wantStatus {
+				t.Errorf("condition status = %q; want %q", *condition.Status, tc.wantStatus)
+			}
+			if *condition.Reason != tc.wantReason {
+				t.Errorf("condition reason = %q; want %q", *condition.Reason, tc.wantReason)
+			}
+			if *condition.ObservedGeneration != 2 {
+				t.Errorf("condition observedGeneration = %d; want 2", *condition.ObservedGeneration)
+			}
+		})
+	}
+}
+
+func TestSetCredentialsCondition(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name            string
+		reconcileStatus progress.ReconcileStatus
+		wantCondition   bool
+	}{
+		{
+			name: "no error",
+		},
+		{
+			name:            "other error",
+			reconcileStatus: progress.WrapError(gophercloud.ErrUnexpectedResponseCode{Actual: http.StatusInternalServerError}),
+		},
+		{
+			name:            "authentication error",
+			reconcileStatus: progress.WrapError(fmt.Errorf("providerClient authentication err: %w", gophercloud.ErrUnexpectedResponseCode{Actual: http.StatusUnauthorized})),
+			wantCondition:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			flavor := &orcv1alpha1.Flavor{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-flavor",
+					Namespace:  "default",
+					Generation: 2,
+				},
+			}
+
+			applyConfigStatus := orcapplyconfigv1alpha1.FlavorStatus()
+			SetCredentialsCondition(flavor, applyConfigStatus, tc.reconcileStatus, metav1.Now())
+
+			if !tc.wantCondition {
+				if len(applyConfigStatus.Conditions) != 0 {
+					t.Errorf("got %d conditions; want 0", len(applyConfigStatus.Conditions))
+				}
+				return
+			}
+
+			if len(applyConfigStatus.Conditions) != 1 {
+				t.Fatalf("got %d conditions; want 1", len(applyConfigStatus.Conditions))
+			}
+			condition := applyConfigStatus.Conditions[0]
+			if *condition.Type != orcv1alpha1.ConditionCredentialsFailing {
+				t.Errorf("condition type = %q; want %q", *condition.Type, orcv1alpha1.ConditionCredentialsFailing)
+			}
+			if *condition.Status != metav1.ConditionTrue {
+				t.Errorf("condition status = %q; want %q", *condition.Status, metav1.ConditionTrue)
+			}
+			if *condition.Reason != orcv1alpha1.ConditionReasonAuthenticationFailed {
+				t.Errorf("condition reason = %q; want %q", *condition.Reason, orcv1alpha1.ConditionReasonAuthenticationFailed)
+			}
+		})
+	}
+}
@@ -18,8 +18,11 @@ package status
 
 import (
 	"context"
+	"encoding/json"
+	"slices"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -84,6 +87,24 @@ func shouldSetLastSyncTime(reconcileStatus progress.ReconcileStatus) bool {
 	return !needsReschedule
 }
 
+type updateStatusOpts struct {
+	reportDrift bool
+	drift       []string
+}
+
+// UpdateStatusOpt is an optional argument to UpdateStatus.
+type UpdateStatusOpt func(*updateStatusOpts)
+
+// WithDriftReport causes UpdateStatus to write the given drifted field paths to
+// status.drift, and to set the Drifted condition. If it is not passed, both
+// are removed from the object's status.
+func WithDriftReport(drift []string) UpdateStatusOpt {
+	return func(opts *updateStatusOpts) {
+		opts.reportDrift = true
+		opts.drift = drift
+	}
+}
+
 func UpdateStatus[
 	orcObjectPT interface {
 		client.Object
@@ -103,10 +124,16 @@ func UpdateStatus[
 	statusWriter interfaces.ResourceStatusWriter[orcObjectPT, osResourcePT, objectApplyPT, statusApplyPT],
 	orcObject orcObjectPT, osResource osResourcePT,
 	reconcileStatus progress.ReconcileStatus,
+	opts ...UpdateStatusOpt,
 ) progress.ReconcileStatus {
 	log := ctrl.LoggerFrom(ctx)
 	now := metav1.NewTime(time.Now())
 
+	var updateOpts updateStatusOpts
+	for _, opt := range opts {
+		opt(&updateOpts)
+	}
+
 	// Create a new apply configuration for this status transaction
 	var applyConfigStatus statusApplyPT = new(statusApply)
 	applyConfig := statusWriter.GetApplyConfig(orcObject.GetName(), orcObject.GetNamespace()).
@@ -121,6 +148,16 @@ func UpdateStatus[
 	available, availableReconcileStatus := statusWriter.ResourceAvailableStatus(orcObject, osResource)
 	reconcileStatus = reconcileStatus.WithReconcileStatus(availableReconcileStatus)
 	SetCommonConditions(orcObject, applyConfigStatus, available, reconcileStatus, now)
+	SetCredentialsCondition(orcObject, applyConfigStatus, reconcileStatus, now)
+
+	// Report drift only if it was computed. Omitting drift from the apply
+	// configuration removes any previously reported drift.
+	if updateOpts.reportDrift {
+		if withDrift, ok := any(applyConfigStatus).(interfaces.ORCStatusApplyConfigWithDrift[statusApplyPT]); ok {
+			withDrift.WithDrift(updateOpts.drift...)
+			SetDriftCondition(orcObject, applyConfigStatus, updateOpts.drift, now)
+		}
+	}
 
 	// Set lastSyncTime only on successful reconciliation: no errors and no
 	// progress messages indicate that the controller successfully fetched the
@@ -135,3 +172,44 @@ func UpdateStatus[
 	return reconcileStatus.
 		WithError(k8sClient.Status().Patch(ctx, orcObject, applyconfigs.Patch(types.ApplyPatchType, applyConfig), client.ForceOwnership, ssaFieldOwner))
 }
+
+// SetPaused sets the Progressing condition of an ORC object to indicate that
+// its reconciliation is paused. The rest of its status, including the
+// Available condition, is left unchanged so that it continues to reflect the
+// last observed state of the OpenStack resource.
+//
+// The condition is written with a JSON merge patch rather than as part of the
+// status transaction because a status transaction would remove any fields it
+// does not set, and we have not fetched the OpenStack resource. As the patch
+// replaces all conditions, it is made with the object's resourceVersion as a
+// precondition in the same way as client.MergeFromWithOptimisticLock, so that
+// it fails with a conflict instead of overwriting a concurrent status update.
+func SetPaused(ctx context.Context, k8sClient client.Client, orcObject interface {
+	client.Object
+	orcv1alpha1.ObjectWithConditions
+}) error {
+	conditions := slices.Clone(orcObject.GetConditions())
+	changed := meta.SetStatusCondition(&conditions, metav1.Condition{
+		Type:               orcv1alpha1.ConditionProgressing,
+		Status:             metav1.ConditionFalse,
+		Reason:             orcv1alpha1.ConditionReasonPaused,
+		Message:            "Reconciliation is paused by the " + orcv1alpha1.PausedAnnotation + " annotation",
+		ObservedGeneration: orcObject.GetGeneration(),
+	})
+	if !changed {
+		return nil
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"resourceVersion": orcObject.GetResourceVersion(),
+		},
+		"status": map[string]any{
+			"conditions": conditions,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return k8sClient.Status().Patch(ctx, orcObject, client.RawPatch(types.MergePatchType, patch))
+}
@@ -22,6 +22,8 @@ import (
 	"testing"
 	"time"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -226,3 +228,94 @@ func TestClearStatusID_IdempotentWhenAlreadyNil(t *testing.T) {
 		t.Errorf("status.id = %q after ClearStatusID on already-nil ID; want nil", *updated.Status.ID)
 	}
 }
+
+// TestSetPaused verifies that SetPaused sets the Progressing condition without
+// modifying the rest of the object's status.
+func TestSetPaused(t *testing.T) {
+	t.Parallel()
+
+	const resourceID = "some-os-id"
+
+	available := metav1.Condition{
+		Type:               orcv1alpha1.ConditionAvailable,
+		Status:             metav1.ConditionTrue,
+		Reason:             orcv1alpha1.ConditionReasonSuccess,
+		ObservedGeneration: 1,
+		LastTransitionTime: metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second)),
+	}
+	flavor := &orcv1alpha1.Flavor{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        "test-flavor",
+			Namespace:   "default",
+			Generation:  1,
+			Annotations: map[string]string{orcv1alpha1.PausedAnnotation: "true"},
+		},
+		Status: orcv1alpha1.FlavorStatus{
+			ID: ptr.To(resourceID),
+			Conditions: []metav1.Condition{
+				available,
+				{
+					Type:               orcv1alpha1.ConditionProgressing,
+					Status:             metav1.ConditionFalse,
+					Reason:             orcv1alpha1.ConditionReasonSuccess,
+					ObservedGeneration: 1,
+				},
+			},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	if err := orcv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add orcv1alpha1 to scheme: %v", err)
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&orcv1alpha1.Flavor{}).
+		WithObjects(flavor).
+		Build()
+
+	stale := &orcv1alpha1.Flavor{}
+	if err := fakeClient.Get(context.Background(), client.ObjectKeyFromObject(flavor), stale); err != nil {
+		t.Fatalf("failed to get flavor: %v", err)
+	}
+	current := stale.DeepCopy()
+	current.Status.Conditions = append(current.Status.Conditions, metav1.Condition{
+		Type:               orcv1alpha1.ConditionDrifted,
+		Status:             metav1.ConditionFalse,
+		Reason:             orcv1alpha1.ConditionReasonSuccess,
+		LastTransitionTime: metav1.Now(),
+	})
+	if err := fakeClient.Status().Update(context.Background(), current); err != nil {
+		t.Fatalf("failed to update flavor status: %v", err)
+	}
+
+	// A patch based on an outdated object must not overwrite the conditions
+	// written since it was fetched
+	if err := SetPaused(context.Background(), fakeClient, stale); !apierrors.IsConflict(err) {
+		t.Fatalf("SetPaused with an outdated object returned %v; want a conflict", err)
+	}
+
+	if err := SetPaused(context.Background(), fakeClient, current); err != nil {
+		t.Fatalf("SetPaused returned unexpected error: %v", err)
+	}
+
+	updated := &orcv1alpha1.Flavor{}
+	if err := fakeClient.Get(context.Background(), client.ObjectKeyFromObject(flavor), updated); err != nil {
+		t.Fatalf("failed to get updated flavor: %v", err)
+	}
+	if meta.FindStatusCondition(updated.Status.Conditions, orcv1alpha1.ConditionDrifted) == nil {
+		t.Errorf("Drifted condition was removed by SetPaused")
+	}
+
+	progressing := meta.FindStatusCondition(updated.Status.Conditions, orcv1alpha1.ConditionProgressing)
+	if progressing == nil || progressing.Status != metav1.ConditionFalse || progressing.Reason != orcv1alpha1.ConditionReasonPaused {
+		t.Errorf("Progressing = %+v; want False with reason %s", progressing, orcv1alpha1.ConditionReasonPaused)
+	}
+	if got := meta.FindStatusCondition(updated.Status.Conditions, orcv1alpha1.ConditionAvailable); got == nil || !got.LastTransitionTime.Equal(&available.LastTransitionTime) || got.Status != available.Status {
+		t.Errorf("Available = %+v; want unchanged %+v", got, available)
+	}
+	if updated.Status.ID == nil || *updated.Status.ID != resourceID {
+		t.Errorf("status.id = %v after SetPaused; want %q", updated.Status.ID, resourceID)
+	}
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/progress"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/logging"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/osclients"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dryrun"
 	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
 )
 
@@ -208,6 +209,7 @@ func (actuator imageActuator) UpdateResource(ctx context.Context, obj orcObjectP
 		return nil
 	}
 
+	ctx = dryrun.WithAction(ctx, "update OpenStack "+controllerName)
 	_, err := actuator.osClient.UpdateImage(ctx, osResource.ID, updateOpts)
 
 	if err != nil {
@@ -49,8 +49,8 @@ const (
 )
 
 type imageReconcilerConstructor struct {
-	scopeFactory        scope.Factory
-	defaultResyncPeriod time.Duration
+	scopeFactory scope.Factory
+	defaults     interfaces.ControllerDefaults
 }
 
 func New(scopeFactory scope.Factory) interfaces.Controller {
@@ -61,8 +61,8 @@ func (imageReconcilerConstructor) GetName() string {
 	return controllerName
 }
 
-func (c *imageReconcilerConstructor) SetDefaultResyncPeriod(d time.Duration) {
-	c.defaultResyncPeriod = d
+func (c *imageReconcilerConstructor) SetDefaults(d interfaces.ControllerDefaults) {
+	c.defaults = d
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -80,6 +80,6 @@ func (c *imageReconcilerConstructor) SetupWithManager(ctx context.Context, mgr c
 		return err
 	}
 
-	r := reconciler.NewController(controllerName, mgr.GetClient(), c.scopeFactory, imageHelperFactory{}, imageStatusWriter{}, c.defaultResyncPeriod)
+	r := reconciler.NewController(controllerName, mgr.GetClient(), mgr.GetEventRecorderFor(controllerName), c.scopeFactory, imageHelperFactory{}, imageStatusWriter{}, c.defaults)
 	return builder.Complete(&r)
 }
@@ -193,7 +193,7 @@ var _ = Describe("Upload tests", Ordered, func() {
 					},
 				},
 			},
-			CloudCredentialsRef: orcv1alpha1.CloudCredentialsReference{
+			CloudCredentialsRef: &orcv1alpha1.CloudCredentialsReference{
 				SecretName: "my-secret",
 				CloudName:  "my-cloud",
 			},
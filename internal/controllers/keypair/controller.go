@@ -19,7 +19,6 @@ package keypair
 import (
 	"context"
 	"errors"
-	"time"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -38,8 +37,8 @@ const controllerName = "keypair"
 // +kubebuilder:rbac:groups=openstack.k-orc.cloud,resources=keypairs/status,verbs=get;update;patch
 
 type keypairReconcilerConstructor struct {
-	scopeFactory        scope.Factory
-	defaultResyncPeriod time.Duration
+	scopeFactory scope.Factory
+	defaults     interfaces.ControllerDefaults
 }
 
 func New(scopeFactory scope.Factory) interfaces.Controller {
@@ -50,8 +49,8 @@ func (keypairReconcilerConstructor) GetName() string {
 	return controllerName
 }
 
-func (c *keypairReconcilerConstructor) SetDefaultResyncPeriod(d time.Duration) {
-	c.defaultResyncPeriod = d
+func (c *keypairReconcilerConstructor) SetDefaults(d interfaces.ControllerDefaults) {
+	c.defaults = d
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -69,6 +68,6 @@ func (c *keypairReconcilerConstructor) SetupWithManager(ctx context.Context, mgr
 		return err
 	}
 
-	r := reconciler.NewController(controllerName, mgr.GetClient(), c.scopeFactory, keypairHelperFactory{}, keypairStatusWriter{}, c.defaultResyncPeriod)
+	r := reconciler.NewController(controllerName, mgr.GetClient(), mgr.GetEventRecorderFor(controllerName), c.scopeFactory, keypairHelperFactory{}, keypairStatusWriter{}, c.defaults)
 	return builder.Complete(&r)
 }
@@ -38,6 +38,7 @@ import (
 	"github.com/k-orc/openstack-resource-controller/v2/internal/logging"
 	osclients "github.com/k-orc/openstack-resource-controller/v2/internal/osclients"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dependency"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dryrun"
 	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/util/tags"
 )
@@ -48,6 +49,7 @@ type (
 	createResourceActuator    = interfaces.CreateResourceActuator[orcObjectPT, orcObjectT, filterT, osResourceT]
 	deleteResourceActuator    = interfaces.DeleteResourceActuator[orcObjectPT, orcObjectT, osResourceT]
 	reconcileResourceActuator = interfaces.ReconcileResourceActuator[orcObjectPT, osResourceT]
+	listResourceActuator      = interfaces.ListResourceActuator[osResourceT]
 	resourceReconciler        = interfaces.ResourceReconciler[orcObjectPT, osResourceT]
 	helperFactory             = interfaces.ResourceHelperFactory[orcObjectPT, orcObjectT, resourceSpecT, filterT, osResourceT]
 	portIterator              = iter.Seq2[*osResourceT, error]
@@ -98,11 +100,18 @@ func resolveHostID(
 }
 
 type portActuator struct {
-	osClient  osclients.NetworkClient
-	k8sClient client.Client
+	osClient     osclients.NetworkClient
+	capabilities osclients.CapabilitiesClient
+	k8sClient    client.Client
+
+	// projectID and listCacheKey identify the project of the actuator's
+	// scope
+	projectID    string
+	listCacheKey string
 }
 
 var _ createResourceActuator = portActuator{}
+var _ listResourceActuator = portActuator{}
 var _ deleteResourceActuator = portActuator{}
 
 func (portActuator) GetResourceID(osResource *osResourceT) string {
@@ -117,6 +126,14 @@ func (actuator portActuator) GetOSResourceByID(ctx context.Context, id string) (
 	return port, nil
 }
 
+func (actuator portActuator) GetListCacheKey() string {
+	return actuator.listCacheKey
+}
+
+func (actuator portActuator) ListOSResourcesForCache(ctx context.Context) portIterator {
+	return actuator.osClient.ListPort(ctx, ports.ListOpts{ProjectID: actuator.projectID})
+}
+
 func (actuator portActuator) ListOSResourcesForAdoption(ctx context.Context, obj *orcv1alpha1.Port) (portIterator, bool) {
 	resource := obj.Spec.Resource
 	if resource == nil {
@@ -334,6 +351,9 @@ func (actuator portActuator) CreateResource(ctx context.Context, obj *orcv1alpha
 		CreateOptsBuilder: portSecurityOpts,
 	}
 	if resource.TrustedVIF != nil {
+		if err := osclients.RequireCapability(ctx, actuator.capabilities, osclients.CapabilityPortTrustedVIF, "spec.resource.trustedVIF"); err != nil {
+			return nil, progress.WrapError(err)
+		}
 		portTrustedOpts.PortTrustedVIF = resource.TrustedVIF
 	}
 
@@ -435,7 +455,12 @@ func (actuator portActuator) updateResource(ctx context.Context, obj orcObjectPT
 
 	updateOpts = handlePortBindingUpdate(updateOpts, resource, osResource)
 	updateOpts = handlePortSecurityUpdate(updateOpts, resource, osResource)
-	updateOpts = handlePortTrustedVIFUpdate(updateOpts, resource, osResource)
+	if resource.TrustedVIF != nil {
+		if err := osclients.RequireCapability(ctx, actuator.capabilities, osclients.CapabilityPortTrustedVIF, "spec.resource.trustedVIF"); err != nil {
+			return progress.WrapError(err)
+		}
+		updateOpts = handlePortTrustedVIFUpdate(updateOpts, resource, osResource)
+	}
 
 	needsUpdate, err := needsUpdate(updateOpts)
 	if err != nil {
@@ -447,13 +472,11 @@ func (actuator portActuator) updateResource(ctx context.Context, obj orcObjectPT
 		return nil
 	}
 
+	ctx = dryrun.WithAction(ctx, "update OpenStack "+controllerName)
 	_, err = actuator.osClient.UpdatePort(ctx, osResource.ID, updateOpts)
 
 	if err != nil {
-		if !orcerrors.IsRetryable(err) {
-			err = orcerrors.Terminal(orcv1alpha1.ConditionReasonInvalidConfiguration, "invalid configuration updating resource: "+err.Error(), err)
-		}
-		return progress.WrapError(err)
+		return progress.WrapUpdateError(err)
 	}
 
 	return progress.NeedsRefresh()
@@ -655,9 +678,16 @@ func newActuator(ctx context.Context, controller interfaces.ResourceController,
 	if err != nil {
 		return portActuator{}, progress.WrapError(err)
 	}
+	capabilities, err := clientScope.NewCapabilitiesClient()
+	if err != nil {
+		return portActuator{}, progress.WrapError(err)
+	}
 
 	return portActuator{
-		osClient:  osClient,
-		k8sClient: controller.GetK8sClient(),
+		osClient:     osClient,
+		capabilities: capabilities,
+		k8sClient:    controller.GetK8sClient(),
+		projectID:    clientScope.GetProjectID(),
+		listCacheKey: clientScope.GetProjectKey(),
 	}, nil
 }
@@ -20,7 +20,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -263,8 +262,8 @@ func serverToPortMapFunc(ctx context.Context, k8sClient client.Client) handler.M
 }
 
 type portReconcilerConstructor struct {
-	scopeFactory        scope.Factory
-	defaultResyncPeriod time.Duration
+	scopeFactory scope.Factory
+	defaults     interfaces.ControllerDefaults
 }
 
 func New(scopeFactory scope.Factory) interfaces.Controller {
@@ -275,8 +274,8 @@ func (portReconcilerConstructor) GetName() string {
 	return controllerName
 }
 
-func (c *portReconcilerConstructor) SetDefaultResyncPeriod(d time.Duration) {
-	c.defaultResyncPeriod = d
+func (c *portReconcilerConstructor) SetDefaults(d interfaces.ControllerDefaults) {
+	c.defaults = d
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -363,6 +362,6 @@ func (c *portReconcilerConstructor) SetupWithManager(ctx context.Context, mgr ct
 		return err
 	}
 
-	r := reconciler.NewController(controllerName, k8sClient, c.scopeFactory, portHelperFactory{}, portStatusWriter{}, c.defaultResyncPeriod)
+	r := reconciler.NewController(controllerName, k8sClient, mgr.GetEventRecorderFor(controllerName), c.scopeFactory, portHelperFactory{}, portStatusWriter{}, c.defaults)
 	return builder.Complete(&r)
 }
@@ -19,7 +19,6 @@ package roleassignment
 import (
 	"context"
 	"errors"
-	"time"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -40,8 +39,8 @@ const controllerName = "roleassignment"
 // +kubebuilder:rbac:groups=openstack.k-orc.cloud,resources=roleassignments/status,verbs=get;update;patch
 
 type roleassignmentReconcilerConstructor struct {
-	scopeFactory        scope.Factory
-	defaultResyncPeriod time.Duration
+	scopeFactory scope.Factory
+	defaults     interfaces.ControllerDefaults
 }
 
 func New(scopeFactory scope.Factory) interfaces.Controller {
@@ -52,8 +51,8 @@ func (roleassignmentReconcilerConstructor) GetName() string {
 	return controllerName
 }
 
-func (c *roleassignmentReconcilerConstructor) SetDefaultResyncPeriod(d time.Duration) {
-	c.defaultResyncPeriod = d
+func (c *roleassignmentReconcilerConstructor) SetDefaults(d interfaces.ControllerDefaults) {
+	c.defaults = d
 }
 
 var roleDependency = dependency.NewDeletionGuardDependency[*orcv1alpha1.RoleAssignmentList, *orcv1alpha1.Role](
@@ -284,9 +283,10 @@ func (c roleassignmentReconcilerConstructor) SetupWithManager(ctx context.Contex
 
 	// Custom reconciler for role assignments (relationships, not resources with IDs)
 	reconciler := &roleassignmentReconciler{
-		client:              mgr.GetClient(),
-		scopeFactory:        c.scopeFactory,
-		defaultResyncPeriod: c.defaultResyncPeriod,
+		client:       mgr.GetClient(),
+		recorder:     mgr.GetEventRecorderFor(controllerName),
+		scopeFactory: c.scopeFactory,
+		defaults:     c.defaults,
 	}
 	return builder.Complete(reconciler)
 }
@@ -24,17 +24,20 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/interfaces"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/progress"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/reconciler"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/resync"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/status"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/logging"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/scope"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dryrun"
 	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/util/finalizers"
 	orcstrings "github.com/k-orc/openstack-resource-controller/v2/internal/util/strings"
@@ -49,9 +52,10 @@ const (
 // Unlike other ORC resources, role assignments are relationships (not resources with IDs),
 // so this uses a custom reconciler instead of the generic framework.
 type roleassignmentReconciler struct {
-	client              client.Client
-	scopeFactory        scope.Factory
-	defaultResyncPeriod time.Duration
+	client       client.Client
+	recorder     record.EventRecorder
+	scopeFactory scope.Factory
+	defaults     interfaces.ControllerDefaults
 
 	statusWriter roleassignmentStatusWriter
 }
@@ -75,12 +79,27 @@ func (r *roleassignmentReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 
 	log := ctrl.LoggerFrom(ctx)
 
+	// A paused object is not reconciled, including deletion, until the
+	// annotation is removed
+	if orcv1alpha1.IsPaused(orcObject) {
+		log.V(logging.Verbose).Info("Reconciliation is paused")
+		return ctrl.Result{}, status.SetPaused(ctx, r.client, orcObject)
+	}
+
+	if r.defaults.IsDryRun(orcObject) {
+		ctx = dryrun.WithDryRun(ctx)
+	}
+
+	var reconcileStatus progress.ReconcileStatus
 	// Check if object is being deleted
 	if !orcObject.GetDeletionTimestamp().IsZero() {
-		return r.reconcileDelete(ctx, orcObject).Return(log)
+		reconcileStatus = r.reconcileDelete(ctx, orcObject)
+	} else {
+		reconcileStatus = r.reconcileNormal(ctx, orcObject)
 	}
 
-	return r.reconcileNormal(ctx, orcObject).Return(log)
+	reconciler.RecordDryRunActions(r.recorder, orcObject, reconcileStatus)
+	return reconcileStatus.Return(log)
 }
 
 func hasRoleAssignmentComponents(statusResource *orcv1alpha1.RoleAssignmentResourceStatus) bool {
@@ -97,7 +116,7 @@ func hasRoleAssignmentComponents(statusResource *orcv1alpha1.RoleAssignmentResou
 // 4. Update status
 func (r *roleassignmentReconciler) reconcileNormal(ctx context.Context, orcObject orcObjectPT) (reconcileStatus progress.ReconcileStatus) {
 	log := ctrl.LoggerFrom(ctx)
-	effectiveResyncPeriod := resync.DetermineResyncPeriod(orcObject.Spec.ResyncPeriod, r.defaultResyncPeriod)
+	effectiveResyncPeriod := resync.DetermineResyncPeriod(orcObject.Spec.ResyncPeriod, r.defaults.ResyncPeriod)
 
 	// Check if we should skip reconciliation
 	if !reconciler.ShouldReconcile(orcObject, orcObject.Status.LastSyncTime, effectiveResyncPeriod) {
@@ -219,6 +238,10 @@ func (r *roleassignmentReconciler) reconcileNormal(ctx context.Context, orcObjec
 
 	// Phase 7: Fetch dependencies and create role assignment
 	if osResource == nil {
+		if r.defaults.IsDryRun(orcObject) {
+			return reconcileStatus.DryRun("Would create OpenStack role assignment")
+		}
+
 		log.V(logging.Info).Info("Creating resource")
 		var createRS progress.ReconcileStatus
 		osResource, createRS = actuator.CreateResource(ctx, orcObject)
@@ -385,6 +408,16 @@ func (r *roleassignmentReconciler) reconcileDelete(ctx context.Context, orcObjec
 		return removeFinalizer(reconcileStatus)
 	}
 
+	if managedOptions.GetDeletionProtection() {
+		log.V(logging.Info).Info("Not deleting role assignment due to deletion protection")
+		return reconcileStatus.WithError(orcerrors.Terminal(orcv1alpha1.ConditionReasonDeletionProtected,
+			"Not deleting OpenStack role assignment: spec.managedOptions.deletionProtection is enabled"))
+	}
+
+	if r.defaults.IsDryRun(orcObject) {
+		return reconcileStatus.DryRun("Would delete OpenStack role assignment")
+	}
+
 	log.V(logging.Info).Info("Deleting role assignment from OpenStack")
 	deleteRS := actuator.DeleteResource(ctx, orcObject, osResource)
 	if needsReschedule, _ := deleteRS.NeedsReschedule(); needsReschedule {
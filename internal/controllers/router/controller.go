@@ -19,7 +19,6 @@ package router
 import (
 	"context"
 	"errors"
-	"time"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -39,8 +38,8 @@ import (
 // +kubebuilder:rbac:groups=openstack.k-orc.cloud,resources=routers/status,verbs=get;update;patch
 
 type routerReconcilerConstructor struct {
-	scopeFactory        scope.Factory
-	defaultResyncPeriod time.Duration
+	scopeFactory scope.Factory
+	defaults     interfaces.ControllerDefaults
 }
 
 func New(scopeFactory scope.Factory) interfaces.Controller {
@@ -51,8 +50,8 @@ func (routerReconcilerConstructor) GetName() string {
 	return controllerName
 }
 
-func (c *routerReconcilerConstructor) SetDefaultResyncPeriod(d time.Duration) {
-	c.defaultResyncPeriod = d
+func (c *routerReconcilerConstructor) SetDefaults(d interfaces.ControllerDefaults) {
+	c.defaults = d
 }
 
 const controllerName = "router"
@@ -144,6 +143,6 @@ func (c *routerReconcilerConstructor) SetupWithManager(ctx context.Context, mgr
 		return err
 	}
 
-	r := reconciler.NewController(controllerName, k8sClient, c.scopeFactory, routerHelperFactory{}, routerStatusWriter{}, c.defaultResyncPeriod)
+	r := reconciler.NewController(controllerName, k8sClient, mgr.GetEventRecorderFor(controllerName), c.scopeFactory, routerHelperFactory{}, routerStatusWriter{}, c.defaults)
 	return builder.Complete(&r)
 }
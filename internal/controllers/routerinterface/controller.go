@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -45,8 +46,8 @@ const (
 )
 
 type routerInterfaceReconcilerConstructor struct {
-	scopeFactory        scope.Factory
-	defaultResyncPeriod time.Duration
+	scopeFactory scope.Factory
+	defaults     interfaces.ControllerDefaults
 }
 
 func New(scopeFactory scope.Factory) interfaces.Controller {
@@ -57,15 +58,16 @@ func (routerInterfaceReconcilerConstructor) GetName() string {
 	return controllerName
 }
 
-func (c *routerInterfaceReconcilerConstructor) SetDefaultResyncPeriod(d time.Duration) {
-	c.defaultResyncPeriod = d
+func (c *routerInterfaceReconcilerConstructor) SetDefaults(d interfaces.ControllerDefaults) {
+	c.defaults = d
 }
 
 // orcRouterInterfaceReconciler reconciles an ORC Subnet.
 type orcRouterInterfaceReconciler struct {
-	client              client.Client
-	scopeFactory        scope.Factory
-	defaultResyncPeriod time.Duration
+	client       client.Client
+	recorder     record.EventRecorder
+	scopeFactory scope.Factory
+	defaults     interfaces.ControllerDefaults
 }
 
 const controllerName = "routerinterface"
@@ -111,9 +113,10 @@ func (c *routerInterfaceReconcilerConstructor) SetupWithManager(ctx context.Cont
 	// dependencies because it reconciles Routers, not RouterInterfaces.
 
 	reconciler := orcRouterInterfaceReconciler{
-		client:              k8sClient,
-		scopeFactory:        c.scopeFactory,
-		defaultResyncPeriod: c.defaultResyncPeriod,
+		client:       k8sClient,
+		recorder:     mgr.GetEventRecorderFor(controllerName),
+		scopeFactory: c.scopeFactory,
+		defaults:     c.defaults,
 	}
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&orcv1alpha1.Router{}, builder.WithPredicates(predicates.NewBecameAvailable(log, &orcv1alpha1.Router{}))).
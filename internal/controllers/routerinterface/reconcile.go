@@ -33,9 +33,11 @@ import (
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/progress"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/reconciler"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/resync"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/status"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/logging"
 	osclients "github.com/k-orc/openstack-resource-controller/v2/internal/osclients"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dependency"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dryrun"
 	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/util/finalizers"
 	orcstrings "github.com/k-orc/openstack-resource-controller/v2/internal/util/strings"
@@ -108,12 +110,20 @@ func (r *orcRouterInterfaceReconciler) Reconcile(ctx context.Context, req ctrl.R
 	for i := range routerInterfaces {
 		routerInterface := &routerInterfaces[i]
 
+		// A paused interface is not reconciled, including deletion, until
+		// the annotation is removed
+		if orcv1alpha1.IsPaused(routerInterface) {
+			log.V(logging.Verbose).Info("Reconciliation is paused", "name", routerInterface.Name)
+			reconcileStatus = reconcileStatus.WithError(status.SetPaused(ctx, r.client, routerInterface))
+			continue
+		}
+
 		if !routerInterface.GetDeletionTimestamp().IsZero() {
 			routerInterfacesToReconcile = append(routerInterfacesToReconcile, routerInterface)
 			continue
 		}
 
-		effectiveResyncPeriod := resync.DetermineResyncPeriod(routerInterface.Spec.ResyncPeriod, r.defaultResyncPeriod)
+		effectiveResyncPeriod := resync.DetermineResyncPeriod(routerInterface.Spec.ResyncPeriod, r.defaults.ResyncPeriod)
 		if !reconciler.ShouldReconcile(routerInterface, routerInterface.Status.LastSyncTime, effectiveResyncPeriod) {
 			if remaining := resync.RemainingUntilNextSync(routerInterface.Status.LastSyncTime, effectiveResyncPeriod); remaining > 0 {
 				reconcileStatus = reconcileStatus.WithRequeue(remaining)
@@ -165,12 +175,18 @@ func (r *orcRouterInterfaceReconciler) Reconcile(ctx context.Context, req ctrl.R
 	for _, routerInterface := range routerInterfacesToReconcile {
 		log = log.WithValues("name", routerInterface.Name)
 
+		ifCtx := ctx
+		if r.defaults.IsDryRun(routerInterface) {
+			ifCtx = dryrun.WithDryRun(ctx)
+		}
+
 		var ifReconcileStatus progress.ReconcileStatus
 		if routerInterface.GetDeletionTimestamp().IsZero() {
-			ifReconcileStatus = r.reconcileNormal(ctx, log, router, routerInterface, routerInterfacePorts, networkClient)
+			ifReconcileStatus = r.reconcileNormal(ifCtx, log, router, routerInterface, routerInterfacePorts, networkClient)
 		} else {
-			ifReconcileStatus = r.reconcileDelete(ctx, log, router, routerInterface, routerInterfacePorts, networkClient)
+			ifReconcileStatus = r.reconcileDelete(ifCtx, log, router, routerInterface, routerInterfacePorts, networkClient)
 		}
+		reconciler.RecordDryRunActions(r.recorder, routerInterface, ifReconcileStatus)
 
 		// Don't aggregate terminal errors because we don't return them to controller runtime
 		err := ifReconcileStatus.GetError()
@@ -227,6 +243,10 @@ func (r *orcRouterInterfaceReconciler) reconcileNormal(ctx context.Context, log
 }
 
 func (r *orcRouterInterfaceReconciler) createRouterInterface(ctx context.Context, log logr.Logger, router *orcv1alpha1.Router, routerInterface *orcv1alpha1.RouterInterface, createOpts routers.AddInterfaceOptsBuilder, networkClient osclients.NetworkClient) progress.ReconcileStatus {
+	if r.defaults.IsDryRun(routerInterface) {
+		return progress.DryRun("Would add interface to OpenStack router " + *router.Status.ID)
+	}
+
 	// Add finalizer immediately before creating a resource
 	// Adding the finalizer only when creating a resource means we don't add
 	// it until all dependent resources are available, which means we don't
@@ -352,6 +372,10 @@ func (r *orcRouterInterfaceReconciler) reconcileDelete(ctx context.Context, log
 	}
 
 	if deleteOpts != nil {
+		if r.defaults.IsDryRun(routerInterface) {
+			return progress.DryRun("Would remove interface from OpenStack router " + *router.Status.ID)
+		}
+
 		log.V(logging.Debug).Info("Deleting router interface")
 		_, err := networkClient.RemoveRouterInterface(ctx, *router.Status.ID, deleteOpts)
 		if err != nil {
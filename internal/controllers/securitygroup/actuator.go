@@ -31,6 +31,7 @@ import (
 	"github.com/k-orc/openstack-resource-controller/v2/internal/logging"
 	osclients "github.com/k-orc/openstack-resource-controller/v2/internal/osclients"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dependency"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dryrun"
 	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/util/tags"
 	corev1 "k8s.io/api/core/v1"
@@ -207,13 +208,11 @@ func (actuator securityGroupActuator) updateResource(ctx context.Context, obj or
 		return nil
 	}
 
+	ctx = dryrun.WithAction(ctx, "update OpenStack "+controllerName)
 	_, err = actuator.osClient.UpdateSecGroup(ctx, osResource.ID, updateOpts)
 
 	if err != nil {
-		if !orcerrors.IsRetryable(err) {
-			err = orcerrors.Terminal(orcv1alpha1.ConditionReasonInvalidConfiguration, "invalid configuration updating resource: "+err.Error(), err)
-		}
-		return progress.WrapError(err)
+		return progress.WrapUpdateError(err)
 	}
 
 	return progress.NeedsRefresh()
@@ -348,7 +347,8 @@ orcRules:
 
 	var err error
 	if len(ruleCreateOpts) > 0 {
-		if _, createErr := actuator.osClient.CreateSecGroupRules(ctx, ruleCreateOpts); createErr != nil {
+		createCtx := dryrun.WithAction(ctx, fmt.Sprintf("create %d rules in OpenStack %s", len(ruleCreateOpts), controllerName))
+		if _, createErr := actuator.osClient.CreateSecGroupRules(createCtx, ruleCreateOpts); createErr != nil {
 			// We should require the spec to be updated before retrying a create which returned a conflict
 			if !orcerrors.IsRetryable(createErr) {
 				createErr = orcerrors.Terminal(orcv1alpha1.ConditionReasonInvalidConfiguration, "invalid configuration creating resource: "+createErr.Error(), createErr)
@@ -361,7 +361,8 @@ orcRules:
 	}
 
 	for _, id := range deleteRuleIDs.UnsortedList() {
-		if deleteErr := actuator.osClient.DeleteSecGroupRule(ctx, id); deleteErr != nil {
+		deleteCtx := dryrun.WithAction(ctx, "delete rule "+id+" from OpenStack "+controllerName)
+		if deleteErr := actuator.osClient.DeleteSecGroupRule(deleteCtx, id); deleteErr != nil {
 			err = errors.Join(err, fmt.Errorf("deleting security group rule %s: %w", id, deleteErr))
 		}
 	}
@@ -19,13 +19,18 @@ package securitygroup
 import (
 	"context"
 	"errors"
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/groups"
 	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions/security/rules"
 	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/progress"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/osclients/mock"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dryrun"
+	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
 	"go.uber.org/mock/gomock"
 	"k8s.io/utils/ptr"
 )
@@ -458,6 +463,153 @@ func Test_securityGroupActuator_updateRules(t *testing.T) {
 	}
 }
 
+func Test_securityGroupActuator_updateResource(t *testing.T) {
+	orcObject := &orcv1alpha1.SecurityGroup{
+		Spec: orcv1alpha1.SecurityGroupSpec{
+			Resource: &orcv1alpha1.SecurityGroupResourceSpec{
+				Name: ptr.To(orcv1alpha1.OpenStackName("new-name")),
+			},
+		},
+	}
+	osResource := &osResourceT{ID: "sg-id", Name: "old-name", RevisionNumber: 3}
+
+	tests := []struct {
+		name         string
+		updateErr    error
+		wantRefresh  bool
+		wantErr      bool
+		wantTerminal bool
+	}{
+		{
+			name:        "successful update refreshes the resource",
+			wantRefresh: true,
+		},
+		{
+			name:      "concurrent modification is retried with backoff",
+			updateErr: gophercloud.ErrUnexpectedResponseCode{Actual: http.StatusPreconditionFailed},
+			wantErr:   true,
+		},
+		{
+			name:         "conflicting update is terminal",
+			updateErr:    gophercloud.ErrUnexpectedResponseCode{Actual: http.StatusConflict},
+			wantErr:      true,
+			wantTerminal: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockctrl := gomock.NewController(t)
+			networkClient := mock.NewMockNetworkClient(mockctrl)
+			networkClient.EXPECT().
+				UpdateSecGroup(gomock.Any(), osResource.ID, groups.UpdateOpts{Name: "new-name", RevisionNumber: ptr.To(3)}).
+				Return(nil, tt.updateErr)
+
+			actuator := securityGroupActuator{osClient: networkClient}
+			reconcileStatus := actuator.updateResource(context.TODO(), orcObject, osResource)
+
+			if got := reconcileStatus.IsRefreshNeeded(); got != tt.wantRefresh {
+				t.Errorf("IsRefreshNeeded() = %v, want %v", got, tt.wantRefresh)
+			}
+			err := reconcileStatus.GetError()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("GetError() = %v, wantErr %v", err, tt.wantErr)
+			}
+			var terminalError *orcerrors.TerminalError
+			if got := errors.As(err, &terminalError); got != tt.wantTerminal {
+				t.Errorf("GetError() = %v, want terminal %v", err, tt.wantTerminal)
+			}
+			// A requeue would replace the controller's exponential backoff
+			if requeue := reconcileStatus.GetRequeue(); requeue != 0 {
+				t.Errorf("GetRequeue() = %v, want 0", requeue)
+			}
+		})
+	}
+}
+
+// dryRunRequest returns the error returned by the scope's RoundTripper for a
+// request sent with ctx.
+func dryRunRequest(t *testing.T, ctx context.Context, method, path string) error {
+	t.Helper()
+
+	request, err := http.NewRequestWithContext(ctx, method, "https://cloud.example.com:9696"+path, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dryrun.CheckRequest(request)
+}
+
+// TestDryRunActions verifies that updates and deletes which are not sent in
+// dry run mode are reported with a description of the intended change.
+func TestDryRunActions(t *testing.T) {
+	const ruleID = "rule-id"
+	osResource := &osResourceT{
+		ID:             "sg-id",
+		Name:           "old-name",
+		RevisionNumber: 3,
+		Rules:          []rules.SecGroupRule{{ID: ruleID, Direction: "ingress", EtherType: "IPv4", SecGroupID: "sg-id"}},
+	}
+	orcObject := &orcv1alpha1.SecurityGroup{
+		Spec: orcv1alpha1.SecurityGroupSpec{
+			Resource: &orcv1alpha1.SecurityGroupResourceSpec{
+				Name: ptr.To(orcv1alpha1.OpenStackName("new-name")),
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		expect     func(*mock.MockNetworkClientMockRecorder)
+		reconciler func(securityGroupActuator) func(context.Context, orcObjectPT, *osResourceT) progress.ReconcileStatus
+		want       string
+	}{
+		{
+			name: "update",
+			expect: func(recorder *mock.MockNetworkClientMockRecorder) {
+				recorder.UpdateSecGroup(gomock.Any(), osResource.ID, gomock.Any()).DoAndReturn(
+					func(ctx context.Context, id string, _ groups.UpdateOptsBuilder) (*groups.SecGroup, error) {
+						return nil, dryRunRequest(t, ctx, http.MethodPut, "/v2.0/security-groups/"+id)
+					})
+			},
+			reconciler: func(actuator securityGroupActuator) func(context.Context, orcObjectPT, *osResourceT) progress.ReconcileStatus {
+				return actuator.updateResource
+			},
+			want: "Would update OpenStack securitygroup",
+		},
+		{
+			name: "delete rule",
+			expect: func(recorder *mock.MockNetworkClientMockRecorder) {
+				recorder.DeleteSecGroupRule(gomock.Any(), ruleID).DoAndReturn(
+					func(ctx context.Context, id string) error {
+						return dryRunRequest(t, ctx, http.MethodDelete, "/v2.0/security-group-rules/"+id)
+					})
+			},
+			reconciler: func(actuator securityGroupActuator) func(context.Context, orcObjectPT, *osResourceT) progress.ReconcileStatus {
+				return actuator.updateRules
+			},
+			want: "Would delete rule " + ruleID + " from OpenStack securitygroup",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockctrl := gomock.NewController(t)
+			networkClient := mock.NewMockNetworkClient(mockctrl)
+			tt.expect(networkClient.EXPECT())
+
+			actuator := securityGroupActuator{osClient: networkClient}
+			reconcileStatus := tt.reconciler(actuator)(dryrun.WithDryRun(context.TODO()), orcObject, osResource)
+
+			if err := reconcileStatus.GetError(); err != nil {
+				t.Errorf("GetError() = %v, want nil", err)
+			}
+			if actions := reconcileStatus.GetDryRunActions(); len(actions) != 1 || actions[0] != tt.want {
+				t.Errorf("GetDryRunActions() = %q, want [%q]", actions, tt.want)
+			}
+		})
+	}
+}
+
 func TestHandleNameUpdate(t *testing.T) {
 	ptrToName := ptr.To[orcv1alpha1.OpenStackName]
 	testCases := []struct {
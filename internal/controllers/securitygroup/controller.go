@@ -19,7 +19,6 @@ package securitygroup
 import (
 	"context"
 	"errors"
-	"time"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -66,8 +65,8 @@ var (
 )
 
 type securitygroupReconcilerConstructor struct {
-	scopeFactory        scope.Factory
-	defaultResyncPeriod time.Duration
+	scopeFactory scope.Factory
+	defaults     interfaces.ControllerDefaults
 }
 
 func New(scopeFactory scope.Factory) interfaces.Controller {
@@ -80,8 +79,8 @@ func (securitygroupReconcilerConstructor) GetName() string {
 	return controllerName
 }
 
-func (c *securitygroupReconcilerConstructor) SetDefaultResyncPeriod(d time.Duration) {
-	c.defaultResyncPeriod = d
+func (c *securitygroupReconcilerConstructor) SetDefaults(d interfaces.ControllerDefaults) {
+	c.defaults = d
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -119,7 +118,7 @@ func (c *securitygroupReconcilerConstructor) SetupWithManager(ctx context.Contex
 		return err
 	}
 
-	r := reconciler.NewController(controllerName, mgr.GetClient(), c.scopeFactory, securityGroupHelperFactory{}, securityGroupStatusWriter{}, c.defaultResyncPeriod)
+	r := reconciler.NewController(controllerName, mgr.GetClient(), mgr.GetEventRecorderFor(controllerName), c.scopeFactory, securityGroupHelperFactory{}, securityGroupStatusWriter{}, c.defaults)
 	return builder.Complete(&r)
 
 }
@@ -40,6 +40,7 @@ import (
 	"github.com/k-orc/openstack-resource-controller/v2/internal/logging"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/osclients"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dependency"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dryrun"
 	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/util/tags"
 )
@@ -452,6 +453,7 @@ func (actuator serverActuator) updateResource(ctx context.Context, obj orcObject
 		return nil
 	}
 
+	ctx = dryrun.WithAction(ctx, "update OpenStack "+controllerName+" name")
 	_, err = actuator.osClient.UpdateServer(ctx, osResource.ID, updateOpts)
 
 	if err != nil {
@@ -536,6 +538,7 @@ func (actuator serverActuator) reconcileMetadata(ctx context.Context, obj orcObj
 	}
 
 	log.V(logging.Verbose).Info("Updating server metadata")
+	ctx = dryrun.WithAction(ctx, "update OpenStack "+controllerName+" metadata")
 	_, err := actuator.osClient.ReplaceServerMetadata(ctx, osResource.ID, desiredMetadata)
 	if err != nil {
 		return progress.WrapError(err)
@@ -608,7 +611,8 @@ func (actuator serverActuator) reconcilePortAttachments(ctx context.Context, obj
 			return iface.PortID == *p.Status.ID
 		}) {
 			log.V(logging.Verbose).Info("Detaching port from server", "port", iface.PortID, "server", *obj.Status.ID)
-			err := actuator.osClient.DeleteAttachedInterface(ctx, *obj.Status.ID, iface.PortID)
+			detachCtx := dryrun.WithAction(ctx, "detach port "+iface.PortID+" from OpenStack "+controllerName)
+			err := actuator.osClient.DeleteAttachedInterface(detachCtx, *obj.Status.ID, iface.PortID)
 			if err != nil {
 				return reconcileStatus.WithReconcileStatus(progress.WrapError(err))
 			}
@@ -680,7 +684,8 @@ func (actuator serverActuator) reconcileVolumeAttachments(ctx context.Context, o
 			return attachment.ID == *v.Status.ID
 		}) {
 			log.V(logging.Verbose).Info("Detaching volume from server", "volume", attachment.ID, "server", *obj.Status.ID)
-			err := actuator.osClient.DeleteVolumeAttachment(ctx, *obj.Status.ID, attachment.ID)
+			detachCtx := dryrun.WithAction(ctx, "detach volume "+attachment.ID+" from OpenStack "+controllerName)
+			err := actuator.osClient.DeleteVolumeAttachment(detachCtx, *obj.Status.ID, attachment.ID)
 			if err != nil {
 				return reconcileStatus.WithReconcileStatus(progress.WrapError(err))
 			}
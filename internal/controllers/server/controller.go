@@ -19,7 +19,6 @@ package server
 import (
 	"context"
 	"errors"
-	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -39,8 +38,8 @@ import (
 // +kubebuilder:rbac:groups=openstack.k-orc.cloud,resources=servers/status,verbs=get;update;patch
 
 type serverReconcilerConstructor struct {
-	scopeFactory        scope.Factory
-	defaultResyncPeriod time.Duration
+	scopeFactory scope.Factory
+	defaults     interfaces.ControllerDefaults
 }
 
 func New(scopeFactory scope.Factory) interfaces.Controller {
@@ -51,8 +50,8 @@ func (serverReconcilerConstructor) GetName() string {
 	return controllerName
 }
 
-func (c *serverReconcilerConstructor) SetDefaultResyncPeriod(d time.Duration) {
-	c.defaultResyncPeriod = d
+func (c *serverReconcilerConstructor) SetDefaults(d interfaces.ControllerDefaults) {
+	c.defaults = d
 }
 
 const controllerName = "server"
@@ -323,6 +322,6 @@ func (c *serverReconcilerConstructor) SetupWithManager(ctx context.Context, mgr
 		return err
 	}
 
-	r := reconciler.NewController(controllerName, k8sClient, c.scopeFactory, serverHelperFactory{}, serverStatusWriter{}, c.defaultResyncPeriod)
+	r := reconciler.NewController(controllerName, k8sClient, mgr.GetEventRecorderFor(controllerName), c.scopeFactory, serverHelperFactory{}, serverStatusWriter{}, c.defaults)
 	return builder.Complete(&r)
 }
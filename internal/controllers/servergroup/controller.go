@@ -19,7 +19,6 @@ package servergroup
 import (
 	"context"
 	"errors"
-	"time"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
@@ -38,8 +37,8 @@ const controllerName = "servergroup"
 // +kubebuilder:rbac:groups=openstack.k-orc.cloud,resources=servergroups/status,verbs=get;update;patch
 
 type servergroupReconcilerConstructor struct {
-	scopeFactory        scope.Factory
-	defaultResyncPeriod time.Duration
+	scopeFactory scope.Factory
+	defaults     interfaces.ControllerDefaults
 }
 
 func New(scopeFactory scope.Factory) interfaces.Controller {
@@ -50,8 +49,8 @@ func (servergroupReconcilerConstructor) GetName() string {
 	return controllerName
 }
 
-func (c *servergroupReconcilerConstructor) SetDefaultResyncPeriod(d time.Duration) {
-	c.defaultResyncPeriod = d
+func (c *servergroupReconcilerConstructor) SetDefaults(d interfaces.ControllerDefaults) {
+	c.defaults = d
 }
 
 // SetupWithManager sets up the controller with the Manager.
@@ -69,6 +68,6 @@ func (c *servergroupReconcilerConstructor) SetupWithManager(ctx context.Context,
 		return err
 	}
 
-	r := reconciler.NewController(controllerName, mgr.GetClient(), c.scopeFactory, servergroupHelperFactory{}, servergroupStatusWriter{}, c.defaultResyncPeriod)
+	r := reconciler.NewController(controllerName, mgr.GetClient(), mgr.GetEventRecorderFor(controllerName), c.scopeFactory, servergroupHelperFactory{}, servergroupStatusWriter{}, c.defaults)
 	return builder.Complete(&r)
 }
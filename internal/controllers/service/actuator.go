@@ -31,6 +31,7 @@ import (
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/progress"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/logging"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/osclients"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dryrun"
 	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
 )
 
@@ -154,6 +155,7 @@ func (actuator serviceActuator) updateResource(ctx context.Context, obj orcObjec
 	// information https://github.com/gophercloud/gophercloud/issues/3553
 	updateOpts.Type = resource.Type
 
+	ctx = dryrun.WithAction(ctx, "update OpenStack "+controllerName)
 	_, err = actuator.osClient.UpdateService(ctx, osResource.ID, updateOpts)
 
 	if err != nil {
@@ -19,7 +19,6 @@ package sharenetwork
 import (
 	"context"
 	"errors"
-	"time"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -41,8 +40,8 @@ const controllerName = "sharenetwork"
 // +kubebuilder:rbac:groups=openstack.k-orc.cloud,resources=sharenetworks/status,verbs=get;update;patch
 
 type sharenetworkReconcilerConstructor struct {
-	scopeFactory        scope.Factory
-	defaultResyncPeriod time.Duration
+	scopeFactory scope.Factory
+	defaults     interfaces.ControllerDefaults
 }
 
 func New(scopeFactory scope.Factory) interfaces.Controller {
@@ -53,8 +52,8 @@ func (sharenetworkReconcilerConstructor) GetName() string {
 	return controllerName
 }
 
-func (c *sharenetworkReconcilerConstructor) SetDefaultResyncPeriod(d time.Duration) {
-	c.defaultResyncPeriod = d
+func (c *sharenetworkReconcilerConstructor) SetDefaults(d interfaces.ControllerDefaults) {
+	c.defaults = d
 }
 
 var networkDependency = dependency.NewDeletionGuardDependency[*orcv1alpha1.ShareNetworkList, *orcv1alpha1.Network](
@@ -115,6 +114,6 @@ func (c *sharenetworkReconcilerConstructor) SetupWithManager(ctx context.Context
 		return err
 	}
 
-	r := reconciler.NewController(controllerName, mgr.GetClient(), c.scopeFactory, sharenetworkHelperFactory{}, sharenetworkStatusWriter{}, c.defaultResyncPeriod)
+	r := reconciler.NewController(controllerName, mgr.GetClient(), mgr.GetEventRecorderFor(controllerName), c.scopeFactory, sharenetworkHelperFactory{}, sharenetworkStatusWriter{}, c.defaults)
 	return builder.Complete(&r)
 }
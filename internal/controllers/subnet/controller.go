@@ -20,7 +20,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
 
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -41,8 +40,8 @@ import (
 )
 
 type subnetReconcilerConstructor struct {
-	scopeFactory        scope.Factory
-	defaultResyncPeriod time.Duration
+	scopeFactory scope.Factory
+	defaults     interfaces.ControllerDefaults
 }
 
 func New(scopeFactory scope.Factory) interfaces.Controller {
@@ -53,8 +52,8 @@ func (subnetReconcilerConstructor) GetName() string {
 	return controllerName
 }
 
-func (c *subnetReconcilerConstructor) SetDefaultResyncPeriod(d time.Duration) {
-	c.defaultResyncPeriod = d
+func (c *subnetReconcilerConstructor) SetDefaults(d interfaces.ControllerDefaults) {
+	c.defaults = d
 }
 
 const controllerName = "subnet"
@@ -201,6 +200,6 @@ func (c *subnetReconcilerConstructor) SetupWithManager(ctx context.Context, mgr
 		return err
 	}
 
-	r := reconciler.NewController(controllerName, k8sClient, c.scopeFactory, subnetHelperFactory{}, subnetStatusWriter{}, c.defaultResyncPeriod)
+	r := reconciler.NewController(controllerName, k8sClient, mgr.GetEventRecorderFor(controllerName), c.scopeFactory, subnetHelperFactory{}, subnetStatusWriter{}, c.defaults)
 	return builder.Complete(&r)
 }
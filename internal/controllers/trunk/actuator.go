@@ -33,6 +33,7 @@ import (
 	"github.com/k-orc/openstack-resource-controller/v2/internal/logging"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/osclients"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dependency"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dryrun"
 	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/util/tags"
 )
@@ -253,6 +254,7 @@ func (actuator trunkActuator) updateResource(ctx context.Context, obj orcObjectP
 		return nil
 	}
 
+	ctx = dryrun.WithAction(ctx, "update OpenStack "+controllerName)
 	_, err = actuator.osClient.UpdateTrunk(ctx, osResource.ID, updateOpts)
 
 	if err != nil {
@@ -381,7 +383,8 @@ func (actuator trunkActuator) reconcileSubports(ctx context.Context, obj orcObje
 		removeOpts := trunks.RemoveSubportsOpts{
 			Subports: subportsToRemove,
 		}
-		if err := actuator.osClient.RemoveSubports(ctx, osResource.ID, removeOpts); err != nil {
+		removeCtx := dryrun.WithAction(ctx, fmt.Sprintf("remove %d subports from OpenStack %s", len(subportsToRemove), controllerName))
+		if err := actuator.osClient.RemoveSubports(removeCtx, osResource.ID, removeOpts); err != nil {
 			if !orcerrors.IsRetryable(err) {
 				err = orcerrors.Terminal(orcv1alpha1.ConditionReasonInvalidConfiguration, "invalid configuration removing subports: "+err.Error(), err)
 			}
@@ -395,7 +398,8 @@ func (actuator trunkActuator) reconcileSubports(ctx context.Context, obj orcObje
 		addOpts := trunks.AddSubportsOpts{
 			Subports: subportsToAdd,
 		}
-		if _, err := actuator.osClient.AddSubports(ctx, osResource.ID, addOpts); err != nil {
+		addCtx := dryrun.WithAction(ctx, fmt.Sprintf("add %d subports to OpenStack %s", len(subportsToAdd), controllerName))
+		if _, err := actuator.osClient.AddSubports(addCtx, osResource.ID, addOpts); err != nil {
 			if !orcerrors.IsRetryable(err) {
 				err = orcerrors.Terminal(orcv1alpha1.ConditionReasonInvalidConfiguration, "invalid configuration adding subports: "+err.Error(), err)
 			}
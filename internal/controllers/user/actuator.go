@@ -34,6 +34,7 @@ import (
 	"github.com/k-orc/openstack-resource-controller/v2/internal/osclients"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/util/applyconfigs"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dependency"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dryrun"
 	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
 	orcapplyconfigv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/pkg/clients/applyconfiguration/api/v1alpha1"
 )
@@ -236,6 +237,7 @@ func (actuator userActuator) reconcilePassword(ctx context.Context, obj orcObjec
 	// CreateResource already set the initial password.
 	if lastAppliedRef != "" {
 		log.V(logging.Info).Info("Updating password")
+		ctx = dryrun.WithAction(ctx, "update OpenStack "+controllerName+" password")
 		_, err := actuator.osClient.UpdateUser(ctx, osResource.ID, users.UpdateOpts{
 			Password: password,
 		})
@@ -293,6 +295,7 @@ func (actuator userActuator) updateResource(ctx context.Context, obj orcObjectPT
 		return nil
 	}
 
+	ctx = dryrun.WithAction(ctx, "update OpenStack "+controllerName)
 	_, err = actuator.osClient.UpdateUser(ctx, osResource.ID, updateOpts)
 
 	if err != nil {
@@ -18,6 +18,7 @@ package volume
 
 import (
 	"context"
+	"fmt"
 	"iter"
 	"time"
 
@@ -33,6 +34,7 @@ import (
 	"github.com/k-orc/openstack-resource-controller/v2/internal/logging"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/osclients"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dependency"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dryrun"
 	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
 )
 
@@ -51,11 +53,16 @@ const (
 	volumeAvailablePollingPeriod = 15 * time.Second
 	// The frequency to poll when waiting for the resource to be deleted
 	volumeDeletingPollingPeriod = 15 * time.Second
+	// The frequency to poll when waiting for the resource to be extended
+	volumeExtendingPollingPeriod = 15 * time.Second
+	// The frequency to poll when waiting for the resource to be retyped
+	volumeRetypingPollingPeriod = 15 * time.Second
 )
 
 type volumeActuator struct {
-	osClient  osclients.VolumeClient
-	k8sClient client.Client
+	osClient     osclients.VolumeClient
+	capabilities osclients.CapabilitiesClient
+	k8sClient    client.Client
 }
 
 var _ createResourceActuator = volumeActuator{}
@@ -173,10 +180,49 @@ func (actuator volumeActuator) CreateResource(ctx context.Context, obj orcObject
 	reconcileStatus = reconcileStatus.WithReconcileStatus(imageDepRS)
 	imageID := ptr.Deref(image.Status.ID, "")
 
+	var snapshotID string
+	if resource.SnapshotRef != nil {
+		snapshot, snapshotDepRS := snapshotDependency.GetDependency(
+			ctx, actuator.k8sClient, obj, orcv1alpha1.IsAvailable,
+		)
+		reconcileStatus = reconcileStatus.WithReconcileStatus(snapshotDepRS)
+		if snapshot != nil {
+			snapshotID = ptr.Deref(snapshot.Status.ID, "")
+		}
+	}
+
+	var sourceVolumeID string
+	if resource.SourceVolumeRef != nil {
+		sourceVolume, sourceVolumeDepRS := sourceVolumeDependency.GetDependency(
+			ctx, actuator.k8sClient, obj, orcv1alpha1.IsAvailable,
+		)
+		reconcileStatus = reconcileStatus.WithReconcileStatus(sourceVolumeDepRS)
+		if sourceVolume != nil {
+			sourceVolumeID = ptr.Deref(sourceVolume.Status.ID, "")
+		}
+	}
+
+	var backupID string
+	if resource.BackupRef != nil {
+		backup, backupDepRS := backupDependency.GetDependency(
+			ctx, actuator.k8sClient, obj, orcv1alpha1.IsAvailable,
+		)
+		reconcileStatus = reconcileStatus.WithReconcileStatus(backupDepRS)
+		if backup != nil {
+			backupID = ptr.Deref(backup.Status.ID, "")
+		}
+	}
+
 	if needsReschedule, _ := reconcileStatus.NeedsReschedule(); needsReschedule {
 		return nil, reconcileStatus
 	}
 
+	if backupID != "" {
+		if err := osclients.RequireCapability(ctx, actuator.capabilities, osclients.CapabilityVolumeFromBackup, "spec.resource.backupRef"); err != nil {
+			return nil, progress.WrapError(err)
+		}
+	}
+
 	metadata := make(map[string]string)
 	for _, m := range resource.Metadata {
 		metadata[m.Name] = m.Value
@@ -190,6 +236,9 @@ func (actuator volumeActuator) CreateResource(ctx context.Context, obj orcObject
 		VolumeType:       volumetypeID,
 		AvailabilityZone: resource.AvailabilityZone,
 		ImageID:          imageID,
+		SnapshotID:       snapshotID,
+		SourceVolID:      sourceVolumeID,
+		BackupID:         backupID,
 	}
 
 	osResource, err := actuator.osClient.CreateVolume(ctx, createOpts)
@@ -241,6 +290,7 @@ func (actuator volumeActuator) updateResource(ctx context.Context, obj orcObject
 		return nil
 	}
 
+	ctx = dryrun.WithAction(ctx, "update OpenStack "+controllerName)
 	_, err = actuator.osClient.UpdateVolume(ctx, osResource.ID, updateOpts)
 
 	if err != nil {
@@ -284,9 +334,167 @@ func handleDescriptionUpdate(updateOpts *volumes.UpdateOpts, resource *resourceS
 func (actuator volumeActuator) GetResourceReconcilers(ctx context.Context, orcObject orcObjectPT, osResource *osResourceT, controller interfaces.ResourceController) ([]resourceReconciler, progress.ReconcileStatus) {
 	return []resourceReconciler{
 		actuator.updateResource,
+		actuator.reconcileSize,
+		actuator.reconcileVolumeType,
 	}, nil
 }
 
+// reconcileSize extends the volume if spec.resource.size is larger than the
+// current size of the volume. Cinder does not support shrinking volumes, which
+// is prevented by API validation.
+func (actuator volumeActuator) reconcileSize(ctx context.Context, obj orcObjectPT, osResource *osResourceT) progress.ReconcileStatus {
+	log := ctrl.LoggerFrom(ctx)
+	resource := obj.Spec.Resource
+	if resource == nil {
+		return nil
+	}
+
+	if osResource.Status == VolumeStatusExtending {
+		return progress.NewReconcileStatus().
+			WithProgressMessage("Extending volume").
+			WaitingOnOpenStack(progress.WaitingOnReady, volumeExtendingPollingPeriod)
+	}
+
+	// Cinder leaves a volume in error_extending when an extend fails. It
+	// will not accept any further actions until an administrator resets
+	// its state.
+	if osResource.Status == VolumeStatusErrorExtending {
+		return progress.WrapError(
+			orcerrors.Terminal(orcv1alpha1.ConditionReasonUnrecoverableError, "Volume failed to extend and is in error_extending state"))
+	}
+
+	newSize := int(resource.Size)
+	if newSize < osResource.Size {
+		// Cinder cannot shrink a volume. This can happen when adopting an
+		// existing volume which is larger than spec.resource.size.
+		return progress.WrapError(
+			orcerrors.Terminal(orcv1alpha1.ConditionReasonInvalidConfiguration,
+				fmt.Sprintf("spec.resource.size (%d GiB) is smaller than the size of the volume (%d GiB), but volumes cannot be shrunk", newSize, osResource.Size)))
+	}
+	if newSize == osResource.Size {
+		return nil
+	}
+
+	var online bool
+	switch osResource.Status {
+	case VolumeStatusAvailable:
+	case VolumeStatusInUse:
+		// Extending an attached volume requires support from both Cinder
+		// and the volume driver
+		if err := osclients.RequireCapability(ctx, actuator.capabilities, osclients.CapabilityOnlineVolumeExtend, "spec.resource.size"); err != nil {
+			return progress.WrapError(err)
+		}
+		online = true
+	default:
+		// Cinder can only extend an available or in-use volume
+		return progress.NewReconcileStatus().
+			WithProgressMessage("Waiting for volume to become available before extending it").
+			WaitingOnOpenStack(progress.WaitingOnReady, volumeAvailablePollingPeriod)
+	}
+
+	log.V(logging.Info).Info("Extending volume", "size", osResource.Size, "newSize", newSize, "online", online)
+	ctx = dryrun.WithAction(ctx, fmt.Sprintf("extend OpenStack %s from %d GiB to %d GiB", controllerName, osResource.Size, newSize))
+	err := actuator.osClient.ExtendVolume(ctx, osResource.ID, osclients.VolumeExtendOpts{
+		ExtendSizeOpts: volumes.ExtendSizeOpts{NewSize: newSize},
+		Online:         online,
+	})
+	if err != nil {
+		if !orcerrors.IsRetryable(err) {
+			err = orcerrors.Terminal(orcv1alpha1.ConditionReasonInvalidConfiguration, "invalid configuration extending volume: "+err.Error(), err)
+		}
+		return progress.WrapError(err)
+	}
+
+	return progress.NewReconcileStatus().
+		WithProgressMessage(fmt.Sprintf("Extending volume from %d GiB to %d GiB", osResource.Size, newSize)).
+		WaitingOnOpenStack(progress.WaitingOnReady, volumeExtendingPollingPeriod)
+}
+
+// reconcileVolumeType retypes the volume if spec.resource.volumeTypeRef
+// refers to a different volume type than the current type of the volume.
+func (actuator volumeActuator) reconcileVolumeType(ctx context.Context, obj orcObjectPT, osResource *osResourceT) progress.ReconcileStatus {
+	log := ctrl.LoggerFrom(ctx)
+	resource := obj.Spec.Resource
+	if resource == nil || resource.VolumeTypeRef == nil {
+		return nil
+	}
+
+	if osResource.Status == VolumeStatusRetyping {
+		return progress.NewReconcileStatus().
+			WithProgressMessage("Retyping volume").
+			WaitingOnOpenStack(progress.WaitingOnReady, volumeRetypingPollingPeriod)
+	}
+
+	// Cinder cannot retype a volume while it is being extended, so we
+	// extend it first
+	if int(resource.Size) > osResource.Size {
+		return nil
+	}
+
+	volumetype, reconcileStatus := volumetypeDependency.GetDependency(
+		ctx, actuator.k8sClient, obj,
+		func(vt *orcv1alpha1.VolumeType) bool {
+			return orcv1alpha1.IsAvailable(vt) && vt.Status.Resource != nil
+		},
+	)
+	if needsReschedule, _ := reconcileStatus.NeedsReschedule(); needsReschedule {
+		return reconcileStatus
+	}
+
+	// Cinder reports the name of the volume type of a volume
+	volumetypeName := volumetype.Status.Resource.Name
+	if osResource.VolumeType == volumetypeName {
+		return nil
+	}
+
+	switch osResource.Status {
+	case VolumeStatusAvailable, VolumeStatusInUse:
+	default:
+		// Cinder can only retype an available or in-use volume
+		return progress.NewReconcileStatus().
+			WithProgressMessage("Waiting for volume to become available before retyping it").
+			WaitingOnOpenStack(progress.WaitingOnReady, volumeAvailablePollingPeriod)
+	}
+
+	migrationPolicy := resource.MigrationPolicy
+	if migrationPolicy == "" {
+		migrationPolicy = orcv1alpha1.VolumeMigrationPolicyNever
+	}
+
+	// Cinder accepts a retype before scheduling it, and if the retype
+	// subsequently fails it returns the volume to its previous status with
+	// its previous type. If we already requested this retype for the current
+	// generation of the object, the retype failed and retrying it would fail
+	// in the same way.
+	if lastRetype := obj.Status.LastRetype; lastRetype != nil &&
+		lastRetype.VolumeType == volumetypeName && lastRetype.Generation == obj.GetGeneration() {
+		return progress.WrapError(
+			orcerrors.Terminal(orcv1alpha1.ConditionReasonInvalidConfiguration,
+				fmt.Sprintf("Cinder failed to retype volume from %s to %s with migration policy %s", osResource.VolumeType, volumetypeName, migrationPolicy)))
+	}
+
+	log.V(logging.Info).Info("Retyping volume", "volumeType", osResource.VolumeType, "newVolumeType", volumetypeName, "migrationPolicy", migrationPolicy)
+	ctx = dryrun.WithAction(ctx, fmt.Sprintf("retype OpenStack %s from %s to %s", controllerName, osResource.VolumeType, volumetypeName))
+	err := actuator.osClient.ChangeVolumeType(ctx, osResource.ID, volumes.ChangeTypeOpts{
+		NewType:         ptr.Deref(volumetype.Status.ID, ""),
+		MigrationPolicy: volumes.MigrationPolicy(migrationPolicy),
+	})
+	if err != nil {
+		if !orcerrors.IsRetryable(err) {
+			err = orcerrors.Terminal(orcv1alpha1.ConditionReasonInvalidConfiguration, "invalid configuration retyping volume: "+err.Error(), err)
+		}
+		return progress.WrapError(err)
+	}
+
+	if err := setLastRetype(ctx, volumetypeName, obj, actuator.k8sClient); err != nil {
+		return progress.WrapError(err)
+	}
+
+	return progress.NewReconcileStatus().
+		WithProgressMessage(fmt.Sprintf("Retyping volume from %s to %s", osResource.VolumeType, volumetypeName)).
+		WaitingOnOpenStack(progress.WaitingOnReady, volumeRetypingPollingPeriod)
+}
+
 type volumeHelperFactory struct{}
 
 var _ helperFactory = volumeHelperFactory{}
@@ -308,10 +516,15 @@ func newActuator(ctx context.Context, orcObject *orcv1alpha1.Volume, controller
 	if err != nil {
 		return volumeActuator{}, progress.WrapError(err)
 	}
+	capabilities, err := clientScope.NewCapabilitiesClient()
+	if err != nil {
+		return volumeActuator{}, progress.WrapError(err)
+	}
 
 	return volumeActuator{
-		osClient:  osClient,
-		k8sClient: controller.GetK8sClient(),
+		osClient:     osClient,
+		capabilities: capabilities,
+		k8sClient:    controller.GetK8sClient(),
 	}, nil
 }
 
@@ -17,11 +17,23 @@ limitations under the License.
 package volume
 
 import (
+	"context"
+	"errors"
+	"net/http"
 	"testing"
 
+	"github.com/gophercloud/gophercloud/v2"
 	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
 	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/osclients"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/osclients/mock"
+	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestNeedsUpdate(t *testing.T) {
@@ -117,3 +129,263 @@ func TestHandleDescriptionUpdate(t *testing.T) {
 
 	}
 }
+
+func TestReconcileSize(t *testing.T) {
+	extendOpts := func(newSize int, online bool) osclients.VolumeExtendOpts {
+		return osclients.VolumeExtendOpts{ExtendSizeOpts: volumes.ExtendSizeOpts{NewSize: newSize}, Online: online}
+	}
+
+	testCases := []struct {
+		name         string
+		size         int32
+		osResource   osResourceT
+		onlineExtend bool
+		extendOpts   *osclients.VolumeExtendOpts
+		extendErr    error
+		wantPoll     bool
+		wantTerminal bool
+	}{
+		{
+			name:       "Size is unchanged",
+			size:       1,
+			osResource: osResourceT{Size: 1, Status: VolumeStatusAvailable},
+		},
+		{
+			name:         "Size is smaller than the volume",
+			size:         1,
+			osResource:   osResourceT{Size: 2, Status: VolumeStatusAvailable},
+			wantTerminal: true,
+		},
+		{
+			name:       "Available volume is extended",
+			size:       2,
+			osResource: osResourceT{Size: 1, Status: VolumeStatusAvailable},
+			extendOpts: ptr.To(extendOpts(2, false)),
+			wantPoll:   true,
+		},
+		{
+			name:         "Attached volume is extended online",
+			size:         2,
+			osResource:   osResourceT{Size: 1, Status: VolumeStatusInUse},
+			onlineExtend: true,
+			extendOpts:   ptr.To(extendOpts(2, true)),
+			wantPoll:     true,
+		},
+		{
+			name:         "Attached volume requires online extend",
+			size:         2,
+			osResource:   osResourceT{Size: 1, Status: VolumeStatusInUse},
+			wantTerminal: true,
+		},
+		{
+			name:       "Volume which is not available is not extended",
+			size:       2,
+			osResource: osResourceT{Size: 1, Status: "attaching"},
+			wantPoll:   true,
+		},
+		{
+			name:       "Volume which is extending is polled",
+			size:       2,
+			osResource: osResourceT{Size: 1, Status: VolumeStatusExtending},
+			wantPoll:   true,
+		},
+		{
+			name:         "Volume which failed to extend is terminal",
+			size:         2,
+			osResource:   osResourceT{Size: 1, Status: VolumeStatusErrorExtending},
+			wantTerminal: true,
+		},
+		{
+			name:         "Rejected extend is terminal",
+			size:         2,
+			osResource:   osResourceT{Size: 1, Status: VolumeStatusAvailable},
+			extendOpts:   ptr.To(extendOpts(2, false)),
+			extendErr:    gophercloud.ErrUnexpectedResponseCode{Actual: http.StatusConflict},
+			wantTerminal: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			mockctrl := gomock.NewController(t)
+			volumeClient := mock.NewMockVolumeClient(mockctrl)
+			capabilitiesClient := mock.NewMockCapabilitiesClient(mockctrl)
+			capabilitiesClient.EXPECT().
+				Supports(gomock.Any(), osclients.CapabilityOnlineVolumeExtend).
+				Return(tt.onlineExtend, nil).AnyTimes()
+			if tt.extendOpts != nil {
+				volumeClient.EXPECT().
+					ExtendVolume(gomock.Any(), "volume-id", *tt.extendOpts).
+					Return(tt.extendErr)
+			}
+
+			orcObject := &orcv1alpha1.Volume{
+				Spec: orcv1alpha1.VolumeSpec{
+					Resource: &orcv1alpha1.VolumeResourceSpec{Size: tt.size},
+				},
+			}
+			osResource := tt.osResource
+			osResource.ID = "volume-id"
+
+			actuator := volumeActuator{osClient: volumeClient, capabilities: capabilitiesClient}
+			reconcileStatus := actuator.reconcileSize(context.TODO(), orcObject, &osResource)
+
+			err := reconcileStatus.GetError()
+			var terminalError *orcerrors.TerminalError
+			if got := errors.As(err, &terminalError); got != tt.wantTerminal {
+				t.Errorf("reconcileSize() error = %v, want terminal %v", err, tt.wantTerminal)
+			} else if !tt.wantTerminal && err != nil {
+				t.Errorf("reconcileSize() error = %v", err)
+			}
+			if got := reconcileStatus.GetRequeue() > 0; got != tt.wantPoll {
+				t.Errorf("reconcileSize() requeue = %v, want poll %v", reconcileStatus.GetRequeue(), tt.wantPoll)
+			}
+		})
+	}
+}
+
+func TestReconcileVolumeType(t *testing.T) {
+	const (
+		namespace  = "test-namespace"
+		generation = 2
+	)
+
+	volumetype := &orcv1alpha1.VolumeType{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "type-b",
+			Namespace:  namespace,
+			Finalizers: []string{finalizer},
+		},
+		Status: orcv1alpha1.VolumeTypeStatus{
+			Conditions: []metav1.Condition{{
+				Type:               orcv1alpha1.ConditionAvailable,
+				Status:             metav1.ConditionTrue,
+				LastTransitionTime: metav1.Now(),
+				Reason:             "Available",
+			}},
+			ID:       ptr.To("type-b-id"),
+			Resource: &orcv1alpha1.VolumeTypeResourceStatus{Name: "type-b"},
+		},
+	}
+	changeTypeOpts := volumes.ChangeTypeOpts{NewType: "type-b-id", MigrationPolicy: volumes.MigrationPolicyNever}
+
+	testCases := []struct {
+		name           string
+		size           int32
+		osResource     osResourceT
+		lastRetype     *orcv1alpha1.VolumeRetypeAttempt
+		changeTypeErr  error
+		wantChangeType bool
+		wantPoll       bool
+		wantTerminal   bool
+		wantLastRetype *orcv1alpha1.VolumeRetypeAttempt
+	}{
+		{
+			name:       "Volume type is unchanged",
+			osResource: osResourceT{VolumeType: "type-b", Status: VolumeStatusAvailable},
+		},
+		{
+			name:       "Volume which is retyping is polled",
+			osResource: osResourceT{VolumeType: "type-a", Status: VolumeStatusRetyping},
+			wantPoll:   true,
+		},
+		{
+			name:       "Volume is extended before it is retyped",
+			size:       2,
+			osResource: osResourceT{VolumeType: "type-a", Status: VolumeStatusAvailable},
+		},
+		{
+			name:       "Volume which is not available is not retyped",
+			osResource: osResourceT{VolumeType: "type-a", Status: "attaching"},
+			wantPoll:   true,
+		},
+		{
+			name:           "Volume is retyped",
+			osResource:     osResourceT{VolumeType: "type-a", Status: VolumeStatusAvailable},
+			wantChangeType: true,
+			wantPoll:       true,
+			wantLastRetype: &orcv1alpha1.VolumeRetypeAttempt{VolumeType: "type-b", Generation: generation},
+		},
+		{
+			name:           "Volume is retyped again when the object has changed",
+			osResource:     osResourceT{VolumeType: "type-a", Status: VolumeStatusInUse},
+			lastRetype:     &orcv1alpha1.VolumeRetypeAttempt{VolumeType: "type-b", Generation: generation - 1},
+			wantChangeType: true,
+			wantPoll:       true,
+			wantLastRetype: &orcv1alpha1.VolumeRetypeAttempt{VolumeType: "type-b", Generation: generation},
+		},
+		{
+			name:           "Volume which was not retyped is terminal",
+			osResource:     osResourceT{VolumeType: "type-a", Status: VolumeStatusAvailable},
+			lastRetype:     &orcv1alpha1.VolumeRetypeAttempt{VolumeType: "type-b", Generation: generation},
+			wantTerminal:   true,
+			wantLastRetype: &orcv1alpha1.VolumeRetypeAttempt{VolumeType: "type-b", Generation: generation},
+		},
+		{
+			name:           "Rejected retype is terminal and not recorded",
+			osResource:     osResourceT{VolumeType: "type-a", Status: VolumeStatusAvailable},
+			changeTypeErr:  gophercloud.ErrUnexpectedResponseCode{Actual: http.StatusConflict},
+			wantChangeType: true,
+			wantTerminal:   true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			orcObject := &orcv1alpha1.Volume{
+				ObjectMeta: metav1.ObjectMeta{Name: "volume", Namespace: namespace, Generation: generation},
+				Spec: orcv1alpha1.VolumeSpec{
+					Resource: &orcv1alpha1.VolumeResourceSpec{
+						Size:          max(tt.size, 1),
+						VolumeTypeRef: ptr.To[orcv1alpha1.KubernetesNameRef]("type-b"),
+					},
+				},
+				Status: orcv1alpha1.VolumeStatus{
+					VolumeStatusExtra: orcv1alpha1.VolumeStatusExtra{LastRetype: tt.lastRetype},
+				},
+			}
+
+			scheme := runtime.NewScheme()
+			_ = orcv1alpha1.AddToScheme(scheme)
+			k8sClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(orcObject, volumetype.DeepCopy()).
+				WithStatusSubresource(orcObject).
+				Build()
+
+			mockctrl := gomock.NewController(t)
+			volumeClient := mock.NewMockVolumeClient(mockctrl)
+			if tt.wantChangeType {
+				volumeClient.EXPECT().
+					ChangeVolumeType(gomock.Any(), "volume-id", changeTypeOpts).
+					Return(tt.changeTypeErr)
+			}
+
+			osResource := tt.osResource
+			osResource.ID = "volume-id"
+			osResource.Size = 1
+
+			actuator := volumeActuator{osClient: volumeClient, k8sClient: k8sClient}
+			reconcileStatus := actuator.reconcileVolumeType(context.TODO(), orcObject, &osResource)
+
+			err := reconcileStatus.GetError()
+			var terminalError *orcerrors.TerminalError
+			if got := errors.As(err, &terminalError); got != tt.wantTerminal {
+				t.Errorf("reconcileVolumeType() error = %v, want terminal %v", err, tt.wantTerminal)
+			} else if !tt.wantTerminal && err != nil {
+				t.Errorf("reconcileVolumeType() error = %v", err)
+			}
+			if got := reconcileStatus.GetRequeue() > 0; got != tt.wantPoll {
+				t.Errorf("reconcileVolumeType() requeue = %v, want poll %v", reconcileStatus.GetRequeue(), tt.wantPoll)
+			}
+
+			volume := &orcv1alpha1.Volume{}
+			if err := k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(orcObject), volume); err != nil {
+				t.Fatal(err)
+			}
+			if got := volume.Status.LastRetype; ptr.Deref(got, orcv1alpha1.VolumeRetypeAttempt{}) != ptr.Deref(tt.wantLastRetype, orcv1alpha1.VolumeRetypeAttempt{}) {
+				t.Errorf("got lastRetype %v, want %v", got, tt.wantLastRetype)
+			}
+		})
+	}
+}
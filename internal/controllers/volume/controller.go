@@ -20,7 +20,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -52,8 +51,8 @@ const controllerName = "volume"
 // +kubebuilder:rbac:groups=openstack.k-orc.cloud,resources=volumes/status,verbs=get;update;patch
 
 type volumeReconcilerConstructor struct {
-	scopeFactory        scope.Factory
-	defaultResyncPeriod time.Duration
+	scopeFactory scope.Factory
+	defaults     interfaces.ControllerDefaults
 }
 
 func New(scopeFactory scope.Factory) interfaces.Controller {
@@ -64,8 +63,8 @@ func (volumeReconcilerConstructor) GetName() string {
 	return controllerName
 }
 
-func (c *volumeReconcilerConstructor) SetDefaultResyncPeriod(d time.Duration) {
-	c.defaultResyncPeriod = d
+func (c *volumeReconcilerConstructor) SetDefaults(d interfaces.ControllerDefaults) {
+	c.defaults = d
 }
 
 var volumetypeDependency = dependency.NewDeletionGuardDependency[*orcv1alpha1.VolumeList, *orcv1alpha1.VolumeType](
@@ -93,6 +92,48 @@ var imageDependency = dependency.NewDependency[*orcv1alpha1.VolumeList, *orcv1al
 	},
 )
 
+var snapshotDependency = dependency.NewDeletionGuardDependency[*orcv1alpha1.VolumeList, *orcv1alpha1.VolumeSnapshot](
+	"spec.resource.snapshotRef",
+	func(volume *orcv1alpha1.Volume) []string {
+		resource := volume.Spec.Resource
+		if resource == nil || resource.SnapshotRef == nil {
+			return nil
+		}
+		return []string{string(*resource.SnapshotRef)}
+	},
+	finalizer, externalObjectFieldOwner,
+)
+
+var backupDependency = dependency.NewDeletionGuardDependency[*orcv1alpha1.VolumeList, *orcv1alpha1.VolumeBackup](
+	"spec.resource.backupRef",
+	func(volume *orcv1alpha1.Volume) []string {
+		resource := volume.Spec.Resource
+		if resource == nil || resource.BackupRef == nil {
+			return nil
+		}
+		return []string{string(*resource.BackupRef)}
+	},
+	finalizer, externalObjectFieldOwner,
+)
+
+// sourceVolumeFinalizer is added to a Volume which is the source of another
+// Volume. It must not be the same as finalizer, which is added to the source
+// Volume by its own controller.
+var sourceVolumeFinalizer = orcstrings.GetFinalizerName("sourcevolume")
+
+var sourceVolumeDependency = dependency.NewDeletionGuardDependency[*orcv1alpha1.VolumeList, *orcv1alpha1.Volume](
+	"spec.resource.sourceVolumeRef",
+	func(volume *orcv1alpha1.Volume) []string {
+		resource := volume.Spec.Resource
+		if resource == nil || resource.SourceVolumeRef == nil {
+			return nil
+		}
+		return []string{string(*resource.SourceVolumeRef)}
+	},
+	sourceVolumeFinalizer, externalObjectFieldOwner,
+	dependency.OverrideDependencyName("sourcevolume"),
+)
+
 // serverToVolumeMapFunc creates a mapping function that reconciles volumes when:
 // - a volume ID appears in server status but the volume doesn't have attachment info for that server
 // - a volume has attachment info for a server, but the server no longer lists that volume
@@ -239,6 +280,21 @@ func (c *volumeReconcilerConstructor) SetupWithManager(ctx context.Context, mgr
 		return err
 	}
 
+	snapshotWatchEventHandler, err := snapshotDependency.WatchEventHandler(log, k8sClient)
+	if err != nil {
+		return err
+	}
+
+	sourceVolumeWatchEventHandler, err := sourceVolumeDependency.WatchEventHandler(log, k8sClient)
+	if err != nil {
+		return err
+	}
+
+	backupWatchEventHandler, err := backupDependency.WatchEventHandler(log, k8sClient)
+	if err != nil {
+		return err
+	}
+
 	builder := ctrl.NewControllerManagedBy(mgr).
 		WithOptions(options).
 		Watches(&orcv1alpha1.VolumeType{}, volumetypeWatchEventHandler,
@@ -247,6 +303,15 @@ func (c *volumeReconcilerConstructor) SetupWithManager(ctx context.Context, mgr
 		Watches(&orcv1alpha1.Image{}, imageWatchEventHandler,
 			builder.WithPredicates(predicates.NewBecameAvailable(log, &orcv1alpha1.Image{})),
 		).
+		Watches(&orcv1alpha1.VolumeSnapshot{}, snapshotWatchEventHandler,
+			builder.WithPredicates(predicates.NewBecameAvailable(log, &orcv1alpha1.VolumeSnapshot{})),
+		).
+		Watches(&orcv1alpha1.Volume{}, sourceVolumeWatchEventHandler,
+			builder.WithPredicates(predicates.NewBecameAvailable(log, &orcv1alpha1.Volume{})),
+		).
+		Watches(&orcv1alpha1.VolumeBackup{}, backupWatchEventHandler,
+			builder.WithPredicates(predicates.NewBecameAvailable(log, &orcv1alpha1.VolumeBackup{})),
+		).
 		Watches(&orcv1alpha1.Server{}, handler.EnqueueRequestsFromMapFunc(serverToVolumeMapFunc(ctx, k8sClient)),
 			builder.WithPredicates(predicates.NewServerVolumesChanged(log)),
 		).
@@ -255,12 +320,15 @@ func (c *volumeReconcilerConstructor) SetupWithManager(ctx context.Context, mgr
 	if err := errors.Join(
 		volumetypeDependency.AddToManager(ctx, mgr),
 		imageDependency.AddToManager(ctx, mgr),
+		snapshotDependency.AddToManager(ctx, mgr),
+		sourceVolumeDependency.AddToManager(ctx, mgr),
+		backupDependency.AddToManager(ctx, mgr),
 		credentialsDependency.AddToManager(ctx, mgr),
 		credentials.AddCredentialsWatch(log, mgr.GetClient(), builder, credentialsDependency),
 	); err != nil {
 		return err
 	}
 
-	r := reconciler.NewController(controllerName, mgr.GetClient(), c.scopeFactory, volumeHelperFactory{}, volumeStatusWriter{}, c.defaultResyncPeriod)
+	r := reconciler.NewController(controllerName, mgr.GetClient(), mgr.GetEventRecorderFor(controllerName), c.scopeFactory, volumeHelperFactory{}, volumeStatusWriter{}, c.defaults)
 	return builder.Complete(&r)
 }
@@ -17,25 +17,35 @@ limitations under the License.
 package volume
 
 import (
+	"context"
 	"strconv"
 
 	"github.com/go-logr/logr"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/interfaces"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/progress"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/logging"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/applyconfigs"
+	orcstrings "github.com/k-orc/openstack-resource-controller/v2/internal/util/strings"
 	orcapplyconfigv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/pkg/clients/applyconfiguration/api/v1alpha1"
 )
 
 // Ideally, these constants are defined in gophercloud.
 const (
-	VolumeStatusAvailable = "available"
-	VolumeStatusInUse     = "in-use"
-	VolumeStatusDeleting  = "deleting"
+	VolumeStatusAvailable      = "available"
+	VolumeStatusInUse          = "in-use"
+	VolumeStatusDeleting       = "deleting"
+	VolumeStatusExtending      = "extending"
+	VolumeStatusErrorExtending = "error_extending"
+	VolumeStatusRetyping       = "retyping"
 )
 
+const SSATransactionRetypeStatus orcstrings.SSATransactionID = "retypestatus"
+
 type volumeStatusWriter struct{}
 
 type objectApplyT = orcapplyconfigv1alpha1.VolumeApplyConfiguration
@@ -147,3 +157,19 @@ func (volumeStatusWriter) ApplyResourceStatus(log logr.Logger, osResource *osRes
 
 	statusApply.WithResource(resourceStatus)
 }
+
+// setLastRetype records in status.lastRetype that we requested a retype to
+// the given volume type for the current generation of the object.
+func setLastRetype(ctx context.Context, volumetypeName string, orcObject orcObjectPT, k8sClient client.Client) error {
+	status := orcapplyconfigv1alpha1.VolumeStatus().
+		WithLastRetype(orcapplyconfigv1alpha1.VolumeRetypeAttempt().
+			WithVolumeType(volumetypeName).
+			WithGeneration(orcObject.GetGeneration()))
+
+	applyConfig := orcapplyconfigv1alpha1.Volume(orcObject.GetName(), orcObject.GetNamespace()).
+		WithUID(orcObject.GetUID()).
+		WithStatus(status)
+
+	ssaFieldOwner := orcstrings.GetSSAFieldOwnerWithTxn(controllerName, SSATransactionRetypeStatus)
+	return k8sClient.Status().Patch(ctx, orcObject, applyconfigs.Patch(types.ApplyPatchType, applyConfig), client.ForceOwnership, ssaFieldOwner)
+}
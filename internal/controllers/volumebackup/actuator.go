@@ -0,0 +1,349 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumebackup
+
+import (
+	"context"
+	"iter"
+	"time"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/backups"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/interfaces"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/progress"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/logging"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/osclients"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dependency"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dryrun"
+	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
+)
+
+// OpenStack resource types
+type (
+	osResourceT = backups.Backup
+
+	createResourceActuator = interfaces.CreateResourceActuator[orcObjectPT, orcObjectT, filterT, osResourceT]
+	deleteResourceActuator = interfaces.DeleteResourceActuator[orcObjectPT, orcObjectT, osResourceT]
+	resourceReconciler     = interfaces.ResourceReconciler[orcObjectPT, osResourceT]
+	helperFactory          = interfaces.ResourceHelperFactory[orcObjectPT, orcObjectT, resourceSpecT, filterT, osResourceT]
+)
+
+const (
+	// The frequency to poll when waiting for the resource to become available
+	volumebackupAvailablePollingPeriod = 15 * time.Second
+	// The frequency to poll when waiting for the resource to be deleted
+	volumebackupDeletingPollingPeriod = 15 * time.Second
+)
+
+type volumebackupActuator struct {
+	osClient  osclients.VolumeBackupClient
+	k8sClient client.Client
+}
+
+var _ createResourceActuator = volumebackupActuator{}
+var _ deleteResourceActuator = volumebackupActuator{}
+
+func (volumebackupActuator) GetResourceID(osResource *osResourceT) string {
+	return osResource.ID
+}
+
+func (actuator volumebackupActuator) GetOSResourceByID(ctx context.Context, id string) (*osResourceT, progress.ReconcileStatus) {
+	resource, err := actuator.osClient.GetVolumeBackup(ctx, id)
+	if err != nil {
+		return nil, progress.WrapError(err)
+	}
+	return resource, nil
+}
+
+func (actuator volumebackupActuator) ListOSResourcesForAdoption(ctx context.Context, orcObject orcObjectPT) (iter.Seq2[*osResourceT, error], bool) {
+	resourceSpec := orcObject.Spec.Resource
+	if resourceSpec == nil {
+		return nil, false
+	}
+
+	// Resolve the volume ID from VolumeRef, and the snapshot ID from
+	// SnapshotRef if set. Without them, adoption could match a backup of
+	// the wrong volume or snapshot.
+	volume, rs := dependency.FetchDependency(
+		ctx, actuator.k8sClient, orcObject.Namespace, &resourceSpec.VolumeRef, "Volume",
+		func(dep *orcv1alpha1.Volume) bool {
+			return orcv1alpha1.IsAvailable(dep) && dep.Status.ID != nil
+		},
+	)
+	if needsReschedule, _ := rs.NeedsReschedule(); needsReschedule {
+		return nil, false
+	}
+	volumeID := ptr.Deref(volume.Status.ID, "")
+
+	name := getResourceName(orcObject)
+	filters := []osclients.ResourceFilter[osResourceT]{
+		func(b *backups.Backup) bool {
+			return b.Name == name
+		},
+		func(b *backups.Backup) bool {
+			return b.VolumeID == volumeID
+		},
+	}
+
+	if resourceSpec.SnapshotRef != nil {
+		snapshot, rs := dependency.FetchDependency(
+			ctx, actuator.k8sClient, orcObject.Namespace, resourceSpec.SnapshotRef, "VolumeSnapshot",
+			func(dep *orcv1alpha1.VolumeSnapshot) bool {
+				return orcv1alpha1.IsAvailable(dep) && dep.Status.ID != nil
+			},
+		)
+		if needsReschedule, _ := rs.NeedsReschedule(); needsReschedule {
+			return nil, false
+		}
+		snapshotID := ptr.Deref(snapshot.Status.ID, "")
+		filters = append(filters, func(b *backups.Backup) bool {
+			return b.SnapshotID == snapshotID
+		})
+	}
+
+	if resourceSpec.Description != nil {
+		filters = append(filters, func(b *backups.Backup) bool {
+			return b.Description == *resourceSpec.Description
+		})
+	}
+
+	return actuator.listOSResources(ctx, filters), true
+}
+
+func (actuator volumebackupActuator) ListOSResourcesForImport(ctx context.Context, obj orcObjectPT, filter filterT) (iter.Seq2[*osResourceT, error], progress.ReconcileStatus) {
+	var reconcileStatus progress.ReconcileStatus
+
+	volume, rs := dependency.FetchDependency[*orcv1alpha1.Volume](
+		ctx, actuator.k8sClient, obj.Namespace,
+		filter.VolumeRef, "Volume",
+		orcv1alpha1.IsAvailable,
+	)
+	reconcileStatus = reconcileStatus.WithReconcileStatus(rs)
+
+	if needsReschedule, _ := reconcileStatus.NeedsReschedule(); needsReschedule {
+		return nil, reconcileStatus
+	}
+
+	var filters []osclients.ResourceFilter[osResourceT]
+
+	if filter.Name != nil {
+		filters = append(filters, func(b *backups.Backup) bool {
+			return b.Name == string(*filter.Name)
+		})
+	}
+
+	if filter.Description != nil {
+		filters = append(filters, func(b *backups.Backup) bool {
+			return b.Description == *filter.Description
+		})
+	}
+
+	if volume.Status.ID != nil {
+		filters = append(filters, func(b *backups.Backup) bool {
+			return b.VolumeID == *volume.Status.ID
+		})
+	}
+
+	return actuator.listOSResources(ctx, filters), reconcileStatus
+}
+
+// listOSResources lists backups matching all of filters.
+//
+// NOTE: The detailed backup list doesn't support filtering by any
+// property, so all filtering is done client-side.
+func (actuator volumebackupActuator) listOSResources(ctx context.Context, filters []osclients.ResourceFilter[osResourceT]) iter.Seq2[*backups.Backup, error] {
+	backups := actuator.osClient.ListVolumeBackups(ctx, backups.ListDetailOpts{})
+	return osclients.Filter(backups, filters...)
+}
+
+func (actuator volumebackupActuator) CreateResource(ctx context.Context, obj orcObjectPT) (*osResourceT, progress.ReconcileStatus) {
+	resource := obj.Spec.Resource
+
+	if resource == nil {
+		// Should have been caught by API validation
+		return nil, progress.WrapError(
+			orcerrors.Terminal(orcv1alpha1.ConditionReasonInvalidConfiguration, "Creation requested, but spec.resource is not set"))
+	}
+	var reconcileStatus progress.ReconcileStatus
+
+	var volumeID string
+	volume, volumeDepRS := volumeDependency.GetDependency(
+		ctx, actuator.k8sClient, obj, orcv1alpha1.IsAvailable,
+	)
+	reconcileStatus = reconcileStatus.WithReconcileStatus(volumeDepRS)
+	if volume != nil {
+		volumeID = ptr.Deref(volume.Status.ID, "")
+	}
+
+	var snapshotID string
+	if resource.SnapshotRef != nil {
+		snapshot, snapshotDepRS := snapshotDependency.GetDependency(
+			ctx, actuator.k8sClient, obj, orcv1alpha1.IsAvailable,
+		)
+		reconcileStatus = reconcileStatus.WithReconcileStatus(snapshotDepRS)
+		if snapshot != nil {
+			snapshotID = ptr.Deref(snapshot.Status.ID, "")
+		}
+	}
+	if needsReschedule, _ := reconcileStatus.NeedsReschedule(); needsReschedule {
+		return nil, reconcileStatus
+	}
+
+	createOpts := backups.CreateOpts{
+		Name:        getResourceName(obj),
+		Description: ptr.Deref(resource.Description, ""),
+		VolumeID:    volumeID,
+		SnapshotID:  snapshotID,
+		Incremental: ptr.Deref(resource.Incremental, false),
+		Container:   ptr.Deref(resource.Container, ""),
+		Force:       ptr.Deref(resource.Force, false),
+	}
+
+	osResource, err := actuator.osClient.CreateVolumeBackup(ctx, createOpts)
+	if err != nil {
+		// We should require the spec to be updated before retrying a create which returned a conflict
+		if !orcerrors.IsRetryable(err) {
+			err = orcerrors.Terminal(orcv1alpha1.ConditionReasonInvalidConfiguration, "invalid configuration creating resource: "+err.Error(), err)
+		}
+		return nil, progress.WrapError(err)
+	}
+
+	return osResource, nil
+}
+
+func (actuator volumebackupActuator) DeleteResource(ctx context.Context, _ orcObjectPT, resource *osResourceT) progress.ReconcileStatus {
+	if resource.Status == BackupStatusDeleting {
+		return progress.WaitingOnOpenStack(progress.WaitingOnReady, volumebackupDeletingPollingPeriod)
+	}
+	return progress.WrapError(actuator.osClient.DeleteVolumeBackup(ctx, resource.ID))
+}
+
+func (actuator volumebackupActuator) updateResource(ctx context.Context, obj orcObjectPT, osResource *osResourceT) progress.ReconcileStatus {
+	log := ctrl.LoggerFrom(ctx)
+	resource := obj.Spec.Resource
+	if resource == nil {
+		// Should have been caught by API validation
+		return progress.WrapError(
+			orcerrors.Terminal(orcv1alpha1.ConditionReasonInvalidConfiguration, "Update requested, but spec.resource is not set"))
+	}
+
+	updateOpts := osclients.VolumeBackupUpdateOpts{}
+
+	handleNameUpdate(&updateOpts, obj, osResource)
+	handleDescriptionUpdate(&updateOpts, resource, osResource)
+
+	needsUpdate, err := needsUpdate(updateOpts)
+	if err != nil {
+		return progress.WrapError(
+			orcerrors.Terminal(orcv1alpha1.ConditionReasonInvalidConfiguration, "invalid configuration updating resource: "+err.Error(), err))
+	}
+	if !needsUpdate {
+		log.V(logging.Debug).Info("No changes")
+		return nil
+	}
+
+	ctx = dryrun.WithAction(ctx, "update OpenStack "+controllerName)
+	_, err = actuator.osClient.UpdateVolumeBackup(ctx, osResource.ID, updateOpts)
+
+	if err != nil {
+		if !orcerrors.IsRetryable(err) {
+			err = orcerrors.Terminal(orcv1alpha1.ConditionReasonInvalidConfiguration, "invalid configuration updating resource: "+err.Error(), err)
+		}
+		return progress.WrapError(err)
+	}
+
+	return progress.NeedsRefresh()
+}
+
+func needsUpdate(updateOpts osclients.VolumeBackupUpdateOpts) (bool, error) {
+	updateOptsMap, err := updateOpts.ToBackupUpdateMap()
+	if err != nil {
+		return false, err
+	}
+
+	updateMap, ok := updateOptsMap["backup"].(map[string]any)
+	if !ok {
+		updateMap = make(map[string]any)
+	}
+
+	return len(updateMap) > 0, nil
+}
+
+func handleNameUpdate(updateOpts *osclients.VolumeBackupUpdateOpts, obj orcObjectPT, osResource *osResourceT) {
+	name := getResourceName(obj)
+	if osResource.Name != name {
+		updateOpts.Name = &name
+	}
+}
+
+func handleDescriptionUpdate(updateOpts *osclients.VolumeBackupUpdateOpts, resource *resourceSpecT, osResource *osResourceT) {
+	description := ptr.Deref(resource.Description, "")
+	if osResource.Description != description {
+		updateOpts.Description = &description
+	}
+}
+
+func (actuator volumebackupActuator) GetResourceReconcilers(ctx context.Context, orcObject orcObjectPT, osResource *osResourceT, controller interfaces.ResourceController) ([]resourceReconciler, progress.ReconcileStatus) {
+	return []resourceReconciler{
+		actuator.updateResource,
+	}, nil
+}
+
+type volumebackupHelperFactory struct{}
+
+var _ helperFactory = volumebackupHelperFactory{}
+
+func newActuator(ctx context.Context, orcObject *orcv1alpha1.VolumeBackup, controller interfaces.ResourceController) (volumebackupActuator, progress.ReconcileStatus) {
+	log := ctrl.LoggerFrom(ctx)
+
+	// Ensure credential secrets exist and have our finalizer
+	_, reconcileStatus := credentialsDependency.GetDependencies(ctx, controller.GetK8sClient(), orcObject, func(*corev1.Secret) bool { return true })
+	if needsReschedule, _ := reconcileStatus.NeedsReschedule(); needsReschedule {
+		return volumebackupActuator{}, reconcileStatus
+	}
+
+	clientScope, err := controller.GetScopeFactory().NewClientScopeFromObject(ctx, controller.GetK8sClient(), log, orcObject)
+	if err != nil {
+		return volumebackupActuator{}, progress.WrapError(err)
+	}
+	osClient, err := clientScope.NewVolumeBackupClient()
+	if err != nil {
+		return volumebackupActuator{}, progress.WrapError(err)
+	}
+
+	return volumebackupActuator{
+		osClient:  osClient,
+		k8sClient: controller.GetK8sClient(),
+	}, nil
+}
+
+func (volumebackupHelperFactory) NewAPIObjectAdapter(obj orcObjectPT) adapterI {
+	return volumebackupAdapter{obj}
+}
+
+func (volumebackupHelperFactory) NewCreateActuator(ctx context.Context, orcObject orcObjectPT, controller interfaces.ResourceController) (createResourceActuator, progress.ReconcileStatus) {
+	return newActuator(ctx, orcObject, controller)
+}
+
+func (volumebackupHelperFactory) NewDeleteActuator(ctx context.Context, orcObject orcObjectPT, controller interfaces.ResourceController) (deleteResourceActuator, progress.ReconcileStatus) {
+	return newActuator(ctx, orcObject, controller)
+}
@@ -0,0 +1,253 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumebackup
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/backups"
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/osclients"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/osclients/mock"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestNeedsUpdate(t *testing.T) {
+	testCases := []struct {
+		name         string
+		updateOpts   osclients.VolumeBackupUpdateOpts
+		expectChange bool
+	}{
+		{
+			name:         "Empty base opts",
+			updateOpts:   osclients.VolumeBackupUpdateOpts{},
+			expectChange: false,
+		},
+		{
+			name:         "Updated opts",
+			updateOpts:   osclients.VolumeBackupUpdateOpts{Name: ptr.To("updated")},
+			expectChange: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := needsUpdate(tt.updateOpts)
+			if got != tt.expectChange {
+				t.Errorf("Expected change: %v, got: %v", tt.expectChange, got)
+			}
+		})
+	}
+}
+
+func TestHandleNameUpdate(t *testing.T) {
+	ptrToName := ptr.To[orcv1alpha1.OpenStackName]
+	testCases := []struct {
+		name          string
+		newValue      *orcv1alpha1.OpenStackName
+		existingValue string
+		expectChange  bool
+	}{
+		{name: "Identical", newValue: ptrToName("name"), existingValue: "name", expectChange: false},
+		{name: "Different", newValue: ptrToName("new-name"), existingValue: "name", expectChange: true},
+		{name: "No value provided, existing is identical to object name", newValue: nil, existingValue: "object-name", expectChange: false},
+		{name: "No value provided, existing is different from object name", newValue: nil, existingValue: "different-from-object-name", expectChange: true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := &orcv1alpha1.VolumeBackup{}
+			resource.Name = "object-name"
+			resource.Spec = orcv1alpha1.VolumeBackupSpec{
+				Resource: &orcv1alpha1.VolumeBackupResourceSpec{Name: tt.newValue},
+			}
+			osResource := &osResourceT{Name: tt.existingValue}
+
+			updateOpts := osclients.VolumeBackupUpdateOpts{}
+			handleNameUpdate(&updateOpts, resource, osResource)
+
+			got, _ := needsUpdate(updateOpts)
+			if got != tt.expectChange {
+				t.Errorf("Expected change: %v, got: %v", tt.expectChange, got)
+			}
+		})
+
+	}
+}
+
+func TestHandleDescriptionUpdate(t *testing.T) {
+	ptrToDescription := ptr.To[string]
+	testCases := []struct {
+		name          string
+		newValue      *string
+		existingValue string
+		expectChange  bool
+	}{
+		{name: "Identical", newValue: ptrToDescription("desc"), existingValue: "desc", expectChange: false},
+		{name: "Different", newValue: ptrToDescription("new-desc"), existingValue: "desc", expectChange: true},
+		{name: "No value provided, existing is set", newValue: nil, existingValue: "desc", expectChange: true},
+		{name: "No value provided, existing is empty", newValue: nil, existingValue: "", expectChange: false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := &orcv1alpha1.VolumeBackupResourceSpec{Description: tt.newValue}
+			osResource := &osResourceT{Description: tt.existingValue}
+
+			updateOpts := osclients.VolumeBackupUpdateOpts{}
+			handleDescriptionUpdate(&updateOpts, resource, osResource)
+
+			got, _ := needsUpdate(updateOpts)
+			if got != tt.expectChange {
+				t.Errorf("Expected change: %v, got: %v", tt.expectChange, got)
+			}
+		})
+
+	}
+}
+
+func TestListOSResourcesForAdoption(t *testing.T) {
+	const namespace = "test-namespace"
+
+	available := func(id string) ([]metav1.Condition, *string) {
+		return []metav1.Condition{{
+			Type:               orcv1alpha1.ConditionAvailable,
+			Status:             metav1.ConditionTrue,
+			LastTransitionTime: metav1.Now(),
+			Reason:             "Available",
+		}}, ptr.To(id)
+	}
+	volume := func(isAvailable bool) *orcv1alpha1.Volume {
+		volume := &orcv1alpha1.Volume{
+			ObjectMeta: metav1.ObjectMeta{Name: "volume", Namespace: namespace},
+		}
+		if isAvailable {
+			volume.Status.Conditions, volume.Status.ID = available("volume-id")
+		}
+		return volume
+	}
+	snapshot := func(isAvailable bool) *orcv1alpha1.VolumeSnapshot {
+		snapshot := &orcv1alpha1.VolumeSnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: "snapshot", Namespace: namespace},
+		}
+		if isAvailable {
+			snapshot.Status.Conditions, snapshot.Status.ID = available("snapshot-id")
+		}
+		return snapshot
+	}
+
+	osResources := []backups.Backup{
+		{ID: "volume-backup", Name: "backup", VolumeID: "volume-id"},
+		{ID: "snapshot-backup", Name: "backup", VolumeID: "volume-id", SnapshotID: "snapshot-id"},
+		{ID: "other-snapshot-backup", Name: "backup", VolumeID: "volume-id", SnapshotID: "other-snapshot-id"},
+		{ID: "other-volume-backup", Name: "backup", VolumeID: "other-volume-id"},
+		{ID: "other-name-backup", Name: "other", VolumeID: "volume-id"},
+	}
+
+	testCases := []struct {
+		name        string
+		snapshotRef *orcv1alpha1.KubernetesNameRef
+		objects     []client.Object
+		wantAdopt   bool
+		wantIDs     []string
+	}{
+		{
+			name: "volume does not exist",
+		},
+		{
+			name:    "volume is not available",
+			objects: []client.Object{volume(false)},
+		},
+		{
+			name:      "volume is available",
+			objects:   []client.Object{volume(true)},
+			wantAdopt: true,
+			wantIDs:   []string{"volume-backup", "snapshot-backup", "other-snapshot-backup"},
+		},
+		{
+			name:        "snapshot is not available",
+			snapshotRef: ptr.To[orcv1alpha1.KubernetesNameRef]("snapshot"),
+			objects:     []client.Object{volume(true), snapshot(false)},
+		},
+		{
+			name:        "snapshot is available",
+			snapshotRef: ptr.To[orcv1alpha1.KubernetesNameRef]("snapshot"),
+			objects:     []client.Object{volume(true), snapshot(true)},
+			wantAdopt:   true,
+			wantIDs:     []string{"snapshot-backup"},
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			_ = orcv1alpha1.AddToScheme(scheme)
+			k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.objects...).Build()
+
+			orcObject := &orcv1alpha1.VolumeBackup{
+				ObjectMeta: metav1.ObjectMeta{Name: "backup", Namespace: namespace},
+				Spec: orcv1alpha1.VolumeBackupSpec{
+					Resource: &orcv1alpha1.VolumeBackupResourceSpec{
+						VolumeRef:   "volume",
+						SnapshotRef: tt.snapshotRef,
+					},
+				},
+			}
+
+			mockctrl := gomock.NewController(t)
+			osClient := mock.NewMockVolumeBackupClient(mockctrl)
+			if tt.wantAdopt {
+				osClient.EXPECT().
+					ListVolumeBackups(gomock.Any(), gomock.Any()).
+					Return(func(yield func(*backups.Backup, error) bool) {
+						for i := range osResources {
+							if !yield(&osResources[i], nil) {
+								return
+							}
+						}
+					})
+			}
+
+			actuator := volumebackupActuator{osClient: osClient, k8sClient: k8sClient}
+			resourceIter, canAdopt := actuator.ListOSResourcesForAdoption(context.TODO(), orcObject)
+			if canAdopt != tt.wantAdopt {
+				t.Fatalf("ListOSResourcesForAdoption() canAdopt = %v, want %v", canAdopt, tt.wantAdopt)
+			}
+			if !canAdopt {
+				return
+			}
+
+			var gotIDs []string
+			for backup, err := range resourceIter {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				gotIDs = append(gotIDs, backup.ID)
+			}
+			if !slices.Equal(gotIDs, tt.wantIDs) {
+				t.Errorf("got backups %v, want %v", gotIDs, tt.wantIDs)
+			}
+		})
+	}
+}
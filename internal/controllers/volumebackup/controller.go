@@ -0,0 +1,140 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumebackup
+
+import (
+	"context"
+	"errors"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+
+	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/interfaces"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/reconciler"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/scope"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/credentials"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dependency"
+	"github.com/k-orc/openstack-resource-controller/v2/pkg/predicates"
+)
+
+const controllerName = "volumebackup"
+
+// +kubebuilder:rbac:groups=openstack.k-orc.cloud,resources=volumebackups,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=openstack.k-orc.cloud,resources=volumebackups/status,verbs=get;update;patch
+
+type volumebackupReconcilerConstructor struct {
+	scopeFactory scope.Factory
+	defaults     interfaces.ControllerDefaults
+}
+
+func New(scopeFactory scope.Factory) interfaces.Controller {
+	return &volumebackupReconcilerConstructor{scopeFactory: scopeFactory}
+}
+
+func (volumebackupReconcilerConstructor) GetName() string {
+	return controllerName
+}
+
+func (c *volumebackupReconcilerConstructor) SetDefaults(d interfaces.ControllerDefaults) {
+	c.defaults = d
+}
+
+var volumeDependency = dependency.NewDeletionGuardDependency[*orcv1alpha1.VolumeBackupList, *orcv1alpha1.Volume](
+	"spec.resource.volumeRef",
+	func(volumebackup *orcv1alpha1.VolumeBackup) []string {
+		resource := volumebackup.Spec.Resource
+		if resource == nil {
+			return nil
+		}
+		return []string{string(resource.VolumeRef)}
+	},
+	finalizer, externalObjectFieldOwner,
+)
+
+var snapshotDependency = dependency.NewDeletionGuardDependency[*orcv1alpha1.VolumeBackupList, *orcv1alpha1.VolumeSnapshot](
+	"spec.resource.snapshotRef",
+	func(volumebackup *orcv1alpha1.VolumeBackup) []string {
+		resource := volumebackup.Spec.Resource
+		if resource == nil || resource.SnapshotRef == nil {
+			return nil
+		}
+		return []string{string(*resource.SnapshotRef)}
+	},
+	finalizer, externalObjectFieldOwner,
+)
+
+var volumeImportDependency = dependency.NewDependency[*orcv1alpha1.VolumeBackupList, *orcv1alpha1.Volume](
+	"spec.import.filter.volumeRef",
+	func(volumebackup *orcv1alpha1.VolumeBackup) []string {
+		resource := volumebackup.Spec.Import
+		if resource == nil || resource.Filter == nil || resource.Filter.VolumeRef == nil {
+			return nil
+		}
+		return []string{string(*resource.Filter.VolumeRef)}
+	},
+)
+
+// SetupWithManager sets up the controller with the Manager.
+func (c *volumebackupReconcilerConstructor) SetupWithManager(ctx context.Context, mgr ctrl.Manager, options controller.Options) error {
+	log := ctrl.LoggerFrom(ctx)
+	k8sClient := mgr.GetClient()
+
+	volumeWatchEventHandler, err := volumeDependency.WatchEventHandler(log, k8sClient)
+	if err != nil {
+		return err
+	}
+
+	snapshotWatchEventHandler, err := snapshotDependency.WatchEventHandler(log, k8sClient)
+	if err != nil {
+		return err
+	}
+
+	volumeImportWatchEventHandler, err := volumeImportDependency.WatchEventHandler(log, k8sClient)
+	if err != nil {
+		return err
+	}
+
+	builder := ctrl.NewControllerManagedBy(mgr).
+		WithOptions(options).
+		Watches(&orcv1alpha1.Volume{}, volumeWatchEventHandler,
+			builder.WithPredicates(predicates.NewBecameAvailable(log, &orcv1alpha1.Volume{})),
+		).
+		Watches(&orcv1alpha1.VolumeSnapshot{}, snapshotWatchEventHandler,
+			builder.WithPredicates(predicates.NewBecameAvailable(log, &orcv1alpha1.VolumeSnapshot{})),
+		).
+		// A second watch is necessary because we need a different handler that omits deletion guards
+		Watches(&orcv1alpha1.Volume{}, volumeImportWatchEventHandler,
+			builder.WithPredicates(predicates.NewBecameAvailable(log, &orcv1alpha1.Volume{})),
+		).
+		For(&orcv1alpha1.VolumeBackup{})
+
+	if err := errors.Join(
+		volumeDependency.AddToManager(ctx, mgr),
+		snapshotDependency.AddToManager(ctx, mgr),
+		volumeImportDependency.AddToManager(ctx, mgr),
+		credentialsDependency.AddToManager(ctx, mgr),
+		credentials.AddCredentialsWatch(log, mgr.GetClient(), builder, credentialsDependency),
+	); err != nil {
+		return err
+	}
+
+	r := reconciler.NewController(controllerName, mgr.GetClient(), mgr.GetEventRecorderFor(controllerName), c.scopeFactory, volumebackupHelperFactory{}, volumebackupStatusWriter{}, c.defaults)
+	return builder.Complete(&r)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumebackup
+
+import (
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/interfaces"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/progress"
+	orcapplyconfigv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/pkg/clients/applyconfiguration/api/v1alpha1"
+)
+
+// Ideally, these constants are defined in gophercloud.
+const (
+	BackupStatusAvailable = "available"
+	BackupStatusDeleting  = "deleting"
+)
+
+type volumebackupStatusWriter struct{}
+
+type objectApplyT = orcapplyconfigv1alpha1.VolumeBackupApplyConfiguration
+type statusApplyT = orcapplyconfigv1alpha1.VolumeBackupStatusApplyConfiguration
+
+var _ interfaces.ResourceStatusWriter[*orcv1alpha1.VolumeBackup, *osResourceT, *objectApplyT, *statusApplyT] = volumebackupStatusWriter{}
+
+func (volumebackupStatusWriter) GetApplyConfig(name, namespace string) *objectApplyT {
+	return orcapplyconfigv1alpha1.VolumeBackup(name, namespace)
+}
+
+func (volumebackupStatusWriter) ResourceAvailableStatus(orcObject *orcv1alpha1.VolumeBackup, osResource *osResourceT) (metav1.ConditionStatus, progress.ReconcileStatus) {
+	if osResource == nil {
+		if orcObject.Status.ID == nil {
+			return metav1.ConditionFalse, nil
+		} else {
+			return metav1.ConditionUnknown, nil
+		}
+	}
+
+	if osResource.Status == BackupStatusAvailable {
+		return metav1.ConditionTrue, nil
+	}
+
+	// Otherwise we should continue to poll
+	return metav1.ConditionFalse, progress.WaitingOnOpenStack(progress.WaitingOnReady, volumebackupAvailablePollingPeriod)
+}
+
+func (volumebackupStatusWriter) ApplyResourceStatus(log logr.Logger, osResource *osResourceT, statusApply *statusApplyT) {
+	resourceStatus := orcapplyconfigv1alpha1.VolumeBackupResourceStatus().
+		WithName(osResource.Name).
+		WithVolumeID(osResource.VolumeID).
+		WithSize(int32(osResource.Size)).
+		WithObjectCount(int32(osResource.ObjectCount)).
+		WithStatus(osResource.Status).
+		WithIncremental(osResource.IsIncremental).
+		WithHasDependentBackups(osResource.HasDependentBackups).
+		WithCreatedAt(metav1.NewTime(osResource.CreatedAt))
+
+	if !osResource.UpdatedAt.IsZero() {
+		resourceStatus.WithUpdatedAt(metav1.NewTime(osResource.UpdatedAt))
+	}
+
+	if !osResource.DataTimestamp.IsZero() {
+		resourceStatus.WithDataTimestamp(metav1.NewTime(osResource.DataTimestamp))
+	}
+
+	if osResource.Description != "" {
+		resourceStatus.WithDescription(osResource.Description)
+	}
+
+	if osResource.SnapshotID != "" {
+		resourceStatus.WithSnapshotID(osResource.SnapshotID)
+	}
+
+	if osResource.FailReason != "" {
+		resourceStatus.WithFailReason(osResource.FailReason)
+	}
+
+	if osResource.Container != "" {
+		resourceStatus.WithContainer(osResource.Container)
+	}
+
+	if osResource.AvailabilityZone != nil && *osResource.AvailabilityZone != "" {
+		resourceStatus.WithAvailabilityZone(*osResource.AvailabilityZone)
+	}
+
+	if osResource.ProjectID != "" {
+		resourceStatus.WithProjectID(osResource.ProjectID)
+	}
+
+	statusApply.WithResource(resourceStatus)
+}
@@ -0,0 +1,207 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package volumesnapshot
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/snapshots"
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/osclients/mock"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestNeedsUpdate(t *testing.T) {
+	testCases := []struct {
+		name         string
+		updateOpts   snapshots.UpdateOpts
+		expectChange bool
+	}{
+		{
+			name:         "Empty base opts",
+			updateOpts:   snapshots.UpdateOpts{},
+			expectChange: false,
+		},
+		{
+			name:         "Updated opts",
+			updateOpts:   snapshots.UpdateOpts{Name: ptr.To("updated")},
+			expectChange: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			got, _ := needsUpdate(tt.updateOpts)
+			if got != tt.expectChange {
+				t.Errorf("Expected change: %v, got: %v", tt.expectChange, got)
+			}
+		})
+	}
+}
+
+func TestHandleNameUpdate(t *testing.T) {
+	ptrToName := ptr.To[orcv1alpha1.OpenStackName]
+	testCases := []struct {
+		name          string
+		newValue      *orcv1alpha1.OpenStackName
+		existingValue string
+		expectChange  bool
+	}{
+		{name: "Identical", newValue: ptrToName("name"), existingValue: "name", expectChange: false},
+		{name: "Different", newValue: ptrToName("new-name"), existingValue: "name", expectChange: true},
+		{name: "No value provided, existing is identical to object name", newValue: nil, existingValue: "object-name", expectChange: false},
+		{name: "No value provided, existing is different from object name", newValue: nil, existingValue: "different-from-object-name", expectChange: true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := &orcv1alpha1.VolumeSnapshot{}
+			resource.Name = "object-name"
+			resource.Spec = orcv1alpha1.VolumeSnapshotSpec{
+				Resource: &orcv1alpha1.VolumeSnapshotResourceSpec{Name: tt.newValue},
+			}
+			osResource := &osResourceT{Name: tt.existingValue}
+
+			updateOpts := snapshots.UpdateOpts{}
+			handleNameUpdate(&updateOpts, resource, osResource)
+
+			got, _ := needsUpdate(updateOpts)
+			if got != tt.expectChange {
+				t.Errorf("Expected change: %v, got: %v", tt.expectChange, got)
+			}
+		})
+
+	}
+}
+
+func TestHandleDescriptionUpdate(t *testing.T) {
+	ptrToDescription := ptr.To[string]
+	testCases := []struct {
+		name          string
+		newValue      *string
+		existingValue string
+		expectChange  bool
+	}{
+		{name: "Identical", newValue: ptrToDescription("desc"), existingValue: "desc", expectChange: false},
+		{name: "Different", newValue: ptrToDescription("new-desc"), existingValue: "desc", expectChange: true},
+		{name: "No value provided, existing is set", newValue: nil, existingValue: "desc", expectChange: true},
+		{name: "No value provided, existing is empty", newValue: nil, existingValue: "", expectChange: false},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			resource := &orcv1alpha1.VolumeSnapshotResourceSpec{Description: tt.newValue}
+			osResource := &osResourceT{Description: tt.existingValue}
+
+			updateOpts := snapshots.UpdateOpts{}
+			handleDescriptionUpdate(&updateOpts, resource, osResource)
+
+			got, _ := needsUpdate(updateOpts)
+			if got != tt.expectChange {
+				t.Errorf("Expected change: %v, got: %v", tt.expectChange, got)
+			}
+		})
+
+	}
+}
+
+func TestListOSResourcesForAdoption(t *testing.T) {
+	const namespace = "test-namespace"
+
+	orcObject := &orcv1alpha1.VolumeSnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: "snapshot", Namespace: namespace},
+		Spec: orcv1alpha1.VolumeSnapshotSpec{
+			Resource: &orcv1alpha1.VolumeSnapshotResourceSpec{VolumeRef: "volume"},
+		},
+	}
+	volume := func(available bool) *orcv1alpha1.Volume {
+		volume := &orcv1alpha1.Volume{
+			ObjectMeta: metav1.ObjectMeta{Name: "volume", Namespace: namespace},
+		}
+		if available {
+			volume.Status = orcv1alpha1.VolumeStatus{
+				Conditions: []metav1.Condition{{
+					Type:               orcv1alpha1.ConditionAvailable,
+					Status:             metav1.ConditionTrue,
+					LastTransitionTime: metav1.Now(),
+					Reason:             "Available",
+				}},
+				ID: ptr.To("volume-id"),
+			}
+		}
+		return volume
+	}
+
+	testCases := []struct {
+		name      string
+		volume    *orcv1alpha1.Volume
+		wantAdopt bool
+	}{
+		{
+			name: "volume does not exist",
+		},
+		{
+			name:   "volume is not available",
+			volume: volume(false),
+		},
+		{
+			name:      "volume is available",
+			volume:    volume(true),
+			wantAdopt: true,
+		},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			_ = orcv1alpha1.AddToScheme(scheme)
+			var objects []client.Object
+			if tt.volume != nil {
+				objects = append(objects, tt.volume)
+			}
+			k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+
+			mockctrl := gomock.NewController(t)
+			osClient := mock.NewMockVolumeSnapshotClient(mockctrl)
+			if tt.wantAdopt {
+				// Snapshots are filtered by the ID of the referenced volume
+				osClient.EXPECT().
+					ListVolumeSnapshots(gomock.Any(), snapshots.ListOpts{Name: "snapshot", VolumeID: "volume-id"}).
+					Return(func(yield func(*snapshots.Snapshot, error) bool) {})
+			}
+
+			actuator := volumesnapshotActuator{osClient: osClient, k8sClient: k8sClient}
+			resourceIter, canAdopt := actuator.ListOSResourcesForAdoption(context.TODO(), orcObject)
+			if canAdopt != tt.wantAdopt {
+				t.Fatalf("ListOSResourcesForAdoption() canAdopt = %v, want %v", canAdopt, tt.wantAdopt)
+			}
+			if canAdopt {
+				for _, err := range resourceIter {
+					if err != nil {
+						t.Errorf("unexpected error: %v", err)
+					}
+				}
+			}
+		})
+	}
+}
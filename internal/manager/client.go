@@ -0,0 +1,87 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"context"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// newClientFunc returns a function which creates the manager's client. When
+// the cache only watches some namespaces, the client reads objects in other
+// namespaces directly from the API server instead of failing. This is required
+// to read the secret of an OpenStackCloud, which may be in any namespace.
+func newClientFunc(watchNamespaces []string) client.NewClientFunc {
+	if len(watchNamespaces) == 0 {
+		return client.New
+	}
+
+	return func(config *rest.Config, options client.Options) (client.Client, error) {
+		if options.Cache == nil || options.Cache.Reader == nil {
+			return client.New(config, options)
+		}
+
+		uncached, err := client.New(config, client.Options{
+			HTTPClient: options.HTTPClient,
+			Scheme:     options.Scheme,
+			Mapper:     options.Mapper,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		cacheOptions := *options.Cache
+		cacheOptions.Reader = &watchedNamespacesReader{
+			cached:     options.Cache.Reader,
+			uncached:   uncached,
+			namespaces: sets.New(watchNamespaces...),
+		}
+		options.Cache = &cacheOptions
+		return client.New(config, options)
+	}
+}
+
+// watchedNamespacesReader reads namespaced objects from cached if they are in
+// a watched namespace, and from uncached otherwise. Cluster-scoped objects and
+// lists across all namespaces are always read from cached.
+type watchedNamespacesReader struct {
+	cached     client.Reader
+	uncached   client.Reader
+	namespaces sets.Set[string]
+}
+
+var _ client.Reader = &watchedNamespacesReader{}
+
+func (r *watchedNamespacesReader) readerFor(namespace string) client.Reader {
+	if namespace == "" || r.namespaces.Has(namespace) {
+		return r.cached
+	}
+	return r.uncached
+}
+
+func (r *watchedNamespacesReader) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	return r.readerFor(key.Namespace).Get(ctx, key, obj, opts...)
+}
+
+func (r *watchedNamespacesReader) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	listOpts := client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+	return r.readerFor(listOpts.Namespace).List(ctx, list, opts...)
+}
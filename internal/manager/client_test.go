@@ -0,0 +1,109 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestWatchedNamespacesReader(t *testing.T) {
+	ctx := context.Background()
+
+	secret := func(namespace, name string) *corev1.Secret {
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name}}
+	}
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "orc-system"}}
+
+	// The cache only contains the watched namespace. The API server contains
+	// everything.
+	cached := fake.NewClientBuilder().WithObjects(secret("watched", "cached"), namespace).Build()
+	uncached := fake.NewClientBuilder().WithObjects(secret("watched", "uncached"), secret("orc-system", "credentials")).Build()
+
+	reader := &watchedNamespacesReader{
+		cached:     cached,
+		uncached:   uncached,
+		namespaces: sets.New("watched"),
+	}
+
+	tests := []struct {
+		name    string
+		key     types.NamespacedName
+		obj     client.Object
+		wantErr bool
+	}{
+		{
+			name: "watched namespace is read from the cache",
+			key:  types.NamespacedName{Namespace: "watched", Name: "cached"},
+			obj:  &corev1.Secret{},
+		},
+		{
+			name:    "watched namespace is not read from the API server",
+			key:     types.NamespacedName{Namespace: "watched", Name: "uncached"},
+			obj:     &corev1.Secret{},
+			wantErr: true,
+		},
+		{
+			name: "other namespace is read from the API server",
+			key:  types.NamespacedName{Namespace: "orc-system", Name: "credentials"},
+			obj:  &corev1.Secret{},
+		},
+		{
+			name: "cluster-scoped object is read from the cache",
+			key:  types.NamespacedName{Name: "orc-system"},
+			obj:  &corev1.Namespace{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := reader.Get(ctx, tt.key, tt.obj)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Get() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("list", func(t *testing.T) {
+		for _, tc := range []struct {
+			namespace string
+			want      []string
+		}{
+			{namespace: "watched", want: []string{"cached"}},
+			{namespace: "orc-system", want: []string{"credentials"}},
+			{namespace: "", want: []string{"cached"}},
+		} {
+			list := &corev1.SecretList{}
+			if err := reader.List(ctx, list, client.InNamespace(tc.namespace)); err != nil {
+				t.Fatalf("List(%q): %v", tc.namespace, err)
+			}
+			var got []string
+			for i := range list.Items {
+				got = append(got, list.Items[i].Name)
+			}
+			if !sets.New(got...).Equal(sets.New(tc.want...)) {
+				t.Errorf("List(%q) = %v, want %v", tc.namespace, got, tc.want)
+			}
+		}
+	})
+}
@@ -0,0 +1,223 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/time/rate"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/yaml"
+)
+
+// The workqueue rate limiter defaults used by controller-runtime. We only
+// replace controller-runtime's rate limiter when the backoff of a controller is
+// configured, in which case any unset delay takes its default value.
+const (
+	defaultBaseDelay = 5 * time.Millisecond
+	defaultMaxDelay  = 1000 * time.Second
+)
+
+// ControllerConfig configures the workers and workqueue of a controller.
+type ControllerConfig struct {
+	// MaxConcurrentReconciles is the maximum number of objects the controller
+	// will reconcile concurrently. If not set, the controller-runtime
+	// default of 1 is used.
+	MaxConcurrentReconciles int `json:"maxConcurrentReconciles,omitempty"`
+
+	// BaseDelay is the delay before the first retry of an object whose
+	// reconcile failed. The delay doubles on each subsequent failure.
+	BaseDelay *metav1.Duration `json:"baseDelay,omitempty"`
+
+	// MaxDelay is the maximum delay before retrying an object whose reconcile
+	// failed.
+	MaxDelay *metav1.Duration `json:"maxDelay,omitempty"`
+}
+
+// merge returns c with any values set in override replacing its own.
+func (c ControllerConfig) merge(override ControllerConfig) ControllerConfig {
+	if override.MaxConcurrentReconciles != 0 {
+		c.MaxConcurrentReconciles = override.MaxConcurrentReconciles
+	}
+	if override.BaseDelay != nil {
+		c.BaseDelay = override.BaseDelay
+	}
+	if override.MaxDelay != nil {
+		c.MaxDelay = override.MaxDelay
+	}
+	return c
+}
+
+// ControllersConfig configures the workers and workqueues of all controllers.
+// It is the format of the file passed to the manager's --controller-config
+// flag.
+type ControllersConfig struct {
+	// Default applies to every controller.
+	Default ControllerConfig `json:"default,omitempty"`
+
+	// Controllers applies to individual controllers by name, overriding
+	// Default.
+	Controllers map[string]ControllerConfig `json:"controllers,omitempty"`
+}
+
+// LoadControllersConfig reads a ControllersConfig from a YAML file.
+func LoadControllersConfig(path string) (ControllersConfig, error) {
+	var config ControllersConfig
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return config, err
+	}
+	if err := yaml.UnmarshalStrict(content, &config); err != nil {
+		return config, fmt.Errorf("parsing controller config %s: %w", path, err)
+	}
+	return config, nil
+}
+
+// Merge returns c with any values set in override replacing its own.
+func (c ControllersConfig) Merge(override ControllersConfig) ControllersConfig {
+	merged := ControllersConfig{
+		Default:     c.Default.merge(override.Default),
+		Controllers: make(map[string]ControllerConfig, len(c.Controllers)+len(override.Controllers)),
+	}
+	for name, config := range c.Controllers {
+		merged.Controllers[name] = config
+	}
+	for name, config := range override.Controllers {
+		merged.Controllers[name] = merged.Controllers[name].merge(config)
+	}
+	return merged
+}
+
+// SetMaxConcurrentReconciles parses a comma-separated list of
+// controller=count pairs, e.g. port=10,server=4, into c.
+func (c *ControllersConfig) SetMaxConcurrentReconciles(value string) error {
+	return c.parseControllerValues(value, func(config *ControllerConfig, v string) error {
+		count, err := strconv.Atoi(v)
+		if err != nil || count < 1 {
+			return fmt.Errorf("invalid concurrency %q: must be a positive integer", v)
+		}
+		config.MaxConcurrentReconciles = count
+		return nil
+	})
+}
+
+// SetBaseDelay parses a comma-separated list of controller=duration pairs,
+// e.g. port=10ms,server=1s, into c.
+func (c *ControllersConfig) SetBaseDelay(value string) error {
+	return c.parseControllerValues(value, func(config *ControllerConfig, v string) error {
+		delay, err := parsePositiveDuration(v)
+		if err != nil {
+			return err
+		}
+		config.BaseDelay = delay
+		return nil
+	})
+}
+
+// SetMaxDelay parses a comma-separated list of controller=duration pairs,
+// e.g. port=1m,server=10m, into c.
+func (c *ControllersConfig) SetMaxDelay(value string) error {
+	return c.parseControllerValues(value, func(config *ControllerConfig, v string) error {
+		delay, err := parsePositiveDuration(v)
+		if err != nil {
+			return err
+		}
+		config.MaxDelay = delay
+		return nil
+	})
+}
+
+func (c *ControllersConfig) parseControllerValues(value string, set func(*ControllerConfig, string) error) error {
+	for pair := range strings.SplitSeq(value, ",") {
+		name, v, ok := strings.Cut(strings.TrimSpace(pair), "=")
+		if !ok || name == "" {
+			return fmt.Errorf("invalid value %q: expected <controller>=<value>", pair)
+		}
+		if c.Controllers == nil {
+			c.Controllers = make(map[string]ControllerConfig)
+		}
+		config := c.Controllers[name]
+		if err := set(&config, v); err != nil {
+			return fmt.Errorf("controller %s: %w", name, err)
+		}
+		c.Controllers[name] = config
+	}
+	return nil
+}
+
+func parsePositiveDuration(value string) (*metav1.Duration, error) {
+	duration, err := time.ParseDuration(value)
+	if err != nil || duration <= 0 {
+		return nil, fmt.Errorf("invalid duration %q: must be a positive duration", value)
+	}
+	return &metav1.Duration{Duration: duration}, nil
+}
+
+// validate returns an error if c configures a controller which is not in
+// names, or a controller's base delay exceeds its max delay.
+func (c ControllersConfig) validate(names []string) error {
+	for name := range c.Controllers {
+		if !slices.Contains(names, name) {
+			return fmt.Errorf("unknown controller %q in controller configuration", name)
+		}
+	}
+	for _, name := range names {
+		config := c.Default.merge(c.Controllers[name])
+		if config.BaseDelay != nil && config.MaxDelay != nil && config.BaseDelay.Duration > config.MaxDelay.Duration {
+			return fmt.Errorf("controller %s: base delay %s exceeds max delay %s", name, config.BaseDelay.Duration, config.MaxDelay.Duration)
+		}
+	}
+	return nil
+}
+
+// controllerOptions returns the controller-runtime options for the named
+// controller.
+func (c ControllersConfig) controllerOptions(name string) controller.Options {
+	config := c.Default.merge(c.Controllers[name])
+
+	options := controller.Options{
+		MaxConcurrentReconciles: config.MaxConcurrentReconciles,
+	}
+
+	if config.BaseDelay != nil || config.MaxDelay != nil {
+		baseDelay, maxDelay := defaultBaseDelay, defaultMaxDelay
+		if config.BaseDelay != nil {
+			baseDelay = config.BaseDelay.Duration
+		}
+		if config.MaxDelay != nil {
+			maxDelay = config.MaxDelay.Duration
+		}
+
+		// As workqueue.DefaultTypedControllerRateLimiter, but with the
+		// configured per-item backoff
+		options.RateLimiter = workqueue.NewTypedMaxOfRateLimiter(
+			workqueue.NewTypedItemExponentialFailureRateLimiter[reconcile.Request](baseDelay, maxDelay),
+			&workqueue.TypedBucketRateLimiter[reconcile.Request]{Limiter: rate.NewLimiter(rate.Limit(10), 100)},
+		)
+	}
+
+	return options
+}
@@ -0,0 +1,161 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestControllersConfigFlags(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		set     func(*ControllersConfig, string) error
+		value   string
+		want    ControllerConfig
+		wantErr bool
+	}{
+		{
+			name:  "concurrency",
+			set:   (*ControllersConfig).SetMaxConcurrentReconciles,
+			value: "port=10,server=4",
+			want:  ControllerConfig{MaxConcurrentReconciles: 10},
+		},
+		{
+			name:    "zero concurrency",
+			set:     (*ControllersConfig).SetMaxConcurrentReconciles,
+			value:   "port=0",
+			wantErr: true,
+		},
+		{
+			name:    "missing controller",
+			set:     (*ControllersConfig).SetMaxConcurrentReconciles,
+			value:   "10",
+			wantErr: true,
+		},
+		{
+			name:  "base delay",
+			set:   (*ControllersConfig).SetBaseDelay,
+			value: "port=10ms",
+			want:  ControllerConfig{BaseDelay: duration(10 * time.Millisecond)},
+		},
+		{
+			name:    "invalid max delay",
+			set:     (*ControllersConfig).SetMaxDelay,
+			value:   "port=soon",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var config ControllersConfig
+			err := tc.set(&config, tc.value)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got %+v", config)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := config.Controllers["port"]; !equalConfig(got, tc.want) {
+				t.Errorf("got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestControllersConfigMerge(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "controllers.yaml")
+	err := os.WriteFile(path, []byte(`
+default:
+  maxConcurrentReconciles: 2
+controllers:
+  port:
+    maxConcurrentReconciles: 8
+    maxDelay: 1m
+`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fileConfig, err := LoadControllersConfig(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var flagConfig ControllersConfig
+	if err := flagConfig.SetMaxConcurrentReconciles("port=10"); err != nil {
+		t.Fatal(err)
+	}
+
+	config := fileConfig.Merge(flagConfig)
+	if err := config.validate([]string{"port", "server"}); err != nil {
+		t.Fatalf("unexpected validation error: %v", err)
+	}
+
+	portOptions := config.controllerOptions("port")
+	if portOptions.MaxConcurrentReconciles != 10 {
+		t.Errorf("port: expected flag to override file concurrency, got %d", portOptions.MaxConcurrentReconciles)
+	}
+	if portOptions.RateLimiter == nil {
+		t.Error("port: expected a rate limiter")
+	} else if delay := portOptions.RateLimiter.When(reconcile.Request{}); delay != defaultBaseDelay {
+		t.Errorf("port: expected first retry after %s, got %s", defaultBaseDelay, delay)
+	}
+
+	serverOptions := config.controllerOptions("server")
+	if serverOptions.MaxConcurrentReconciles != 2 {
+		t.Errorf("server: expected default concurrency, got %d", serverOptions.MaxConcurrentReconciles)
+	}
+	if serverOptions.RateLimiter != nil {
+		t.Error("server: expected the controller-runtime default rate limiter")
+	}
+
+	if err := config.validate([]string{"server"}); err == nil {
+		t.Error("expected an error for an unknown controller")
+	}
+}
+
+func duration(d time.Duration) *metav1.Duration {
+	return &metav1.Duration{Duration: d}
+}
+
+func equalConfig(a, b ControllerConfig) bool {
+	equalDuration := func(x, y *metav1.Duration) bool {
+		if x == nil || y == nil {
+			return x == y
+		}
+		return x.Duration == y.Duration
+	}
+	return a.MaxConcurrentReconciles == b.MaxConcurrentReconciles &&
+		equalDuration(a.BaseDelay, b.BaseDelay) &&
+		equalDuration(a.MaxDelay, b.MaxDelay)
+}
@@ -30,14 +30,16 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
-	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/metrics/filters"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	"github.com/go-logr/logr"
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/interfaces"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/scope"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/webhooks"
 	// +kubebuilder:scaffold:imports
 )
 
@@ -51,6 +53,13 @@ type Options struct {
 	ScopeCacheMaxSize    int
 	WatchNamespaces      []string
 	DefaultResyncPeriod  time.Duration
+	DefaultDriftPolicy   orcv1alpha1.DriftPolicy
+	DryRun               bool
+	ListCacheTTL         time.Duration
+	Shard                ShardConfig
+	Controllers          ControllersConfig
+	Webhooks             []string
+	ScopeFactory         scope.Factory
 }
 
 const lowDefaultResyncPeriodWarningThreshold = 2 * time.Minute
@@ -99,6 +108,10 @@ func Run(ctx context.Context, opts *Options, restConfig *rest.Config, scheme *ru
 		metricsServerOptions.FilterProvider = filters.WithAuthenticationAndAuthorization
 	}
 
+	if err := opts.Shard.validate(); err != nil {
+		return err
+	}
+
 	var watchNamespaces map[string]cache.Config
 	if len(opts.WatchNamespaces) > 0 {
 		watchNamespaces = make(map[string]cache.Config, len(watchNamespaces))
@@ -117,9 +130,10 @@ func Run(ctx context.Context, opts *Options, restConfig *rest.Config, scheme *ru
 		Cache: cache.Options{
 			DefaultNamespaces: watchNamespaces,
 		},
+		NewClient: newClientFunc(opts.WatchNamespaces),
 
 		LeaderElection:   opts.EnableLeaderElection,
-		LeaderElectionID: "f35396c5.k-orc.cloud",
+		LeaderElectionID: opts.Shard.leaderElectionID("f35396c5.k-orc.cloud"),
 		// LeaderElectionReleaseOnCancel defines if the leader should step down voluntarily
 		// when the Manager ends. This requires the binary to immediately end when the
 		// Manager is stopped, otherwise, this setting is unsafe. Setting this significantly
@@ -151,13 +165,53 @@ func Run(ctx context.Context, opts *Options, restConfig *rest.Config, scheme *ru
 			"recommendedMinimum", lowDefaultResyncPeriodWarningThreshold.String())
 	}
 
+	if err := scope.AddSecretWatch(ctx, mgr, opts.ScopeFactory); err != nil {
+		return fmt.Errorf("unable to watch credentials secrets: %w", err)
+	}
+
+	controllerNames := make([]string, len(controllers))
+	for i, c := range controllers {
+		controllerNames[i] = c.GetName()
+	}
+	if err := opts.Controllers.validate(controllerNames); err != nil {
+		return err
+	}
+
+	shardQueues := &shardQueues{}
+	if opts.Shard.enabled() {
+		setupLog.Info("reconciling a shard of namespaces",
+			"shardCount", opts.Shard.Count, "shardIndex", opts.Shard.Index,
+			"namespaceSelector", opts.Shard.NamespaceSelector)
+
+		// Watching namespaces also ensures they are cached before they are
+		// needed by the workqueue
+		if opts.Shard.NamespaceSelector != nil {
+			if err := shardQueues.addNamespaceWatch(ctx, mgr); err != nil {
+				return fmt.Errorf("unable to watch namespaces: %w", err)
+			}
+		}
+	}
+
 	for _, c := range controllers {
-		c.SetDefaultResyncPeriod(opts.DefaultResyncPeriod)
-		if err := c.SetupWithManager(ctx, mgr, controller.Options{}); err != nil {
+		c.SetDefaults(interfaces.ControllerDefaults{
+			ResyncPeriod: opts.DefaultResyncPeriod,
+			DriftPolicy:  opts.DefaultDriftPolicy,
+			DryRun:       opts.DryRun,
+			ListCacheTTL: opts.ListCacheTTL,
+		})
+		controllerOptions := opts.Controllers.controllerOptions(c.GetName())
+		if opts.Shard.enabled() {
+			controllerOptions.NewQueue = opts.Shard.newQueueFunc(ctx, mgr.GetClient(), log.WithName("shard"), shardQueues)
+		}
+		if err := c.SetupWithManager(ctx, mgr, controllerOptions); err != nil {
 			return fmt.Errorf("unable to create %s controller: %w", c.GetName(), err)
 		}
 	}
 
+	if err := webhooks.SetupWithManager(mgr, opts.Webhooks); err != nil {
+		return err
+	}
+
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctx); err != nil {
 		return fmt.Errorf("problem running manager: %w", err)
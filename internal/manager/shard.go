@@ -0,0 +1,303 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	toolscache "k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// ShardConfig configures the namespaces whose objects are reconciled by a
+// manager, allowing several managers to share the objects of a cluster. Each
+// shard elects its own leader. Objects in a namespace are owned by a shard if
+// the namespace is selected by both the hash and the namespace selector.
+type ShardConfig struct {
+	// Count is the number of shards between which namespaces are
+	// distributed by a consistent hash of their name. A value of 0 or 1
+	// disables hash sharding.
+	Count int
+
+	// Index is the shard owned by this manager, from 0 to Count-1.
+	Index int
+
+	// NamespaceSelector selects the namespaces owned by this manager by
+	// their labels. If nil, namespaces are not selected by label.
+	NamespaceSelector labels.Selector
+}
+
+func (s ShardConfig) hashSharded() bool {
+	return s.Count > 1
+}
+
+func (s ShardConfig) enabled() bool {
+	return s.hashSharded() || s.NamespaceSelector != nil
+}
+
+func (s ShardConfig) validate() error {
+	if s.Count < 0 {
+		return fmt.Errorf("invalid shard count %d", s.Count)
+	}
+	if s.Index < 0 || (s.Index > 0 && s.Index >= s.Count) {
+		return fmt.Errorf("shard index %d is not in the range of %d shards", s.Index, max(s.Count, 1))
+	}
+	return nil
+}
+
+// leaderElectionID returns the leader election ID of the shard. Managers
+// share a lease only if they own the same shard.
+func (s ShardConfig) leaderElectionID(id string) string {
+	if s.hashSharded() {
+		id = fmt.Sprintf("shard-%d-of-%d.%s", s.Index, s.Count, id)
+	}
+	if s.NamespaceSelector != nil {
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(s.NamespaceSelector.String()))
+		id = fmt.Sprintf("selector-%08x.%s", h.Sum32(), id)
+	}
+	return id
+}
+
+// ownsNamespace returns true if objects in namespace are reconciled by this
+// shard. A namespace which does not exist is not owned by any shard.
+func (s ShardConfig) ownsNamespace(ctx context.Context, k8sClient client.Reader, namespace string) (bool, error) {
+	if s.hashSharded() && jumpHash(namespace, s.Count) != s.Index {
+		return false, nil
+	}
+
+	if s.NamespaceSelector != nil {
+		ns := &corev1.Namespace{}
+		if err := k8sClient.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+			if apierrors.IsNotFound(err) {
+				return false, nil
+			}
+			return false, fmt.Errorf("fetching namespace %s: %w", namespace, err)
+		}
+		return s.NamespaceSelector.Matches(labels.Set(ns.GetLabels())), nil
+	}
+
+	return true, nil
+}
+
+// jumpHash returns the bucket of key in the range [0, buckets), using the
+// jump consistent hash algorithm. When the number of buckets changes from n
+// to n+1, only 1/(n+1) of keys move to a different bucket.
+//
+// See "A Fast, Minimal Memory, Consistent Hash Algorithm", Lamping and Veach.
+func jumpHash(key string, buckets int) int {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	k := h.Sum64()
+
+	var b, j int64 = -1, 0
+	for j < int64(buckets) {
+		b = j
+		k = k*2862933555777941757 + 1
+		j = int64(float64(b+1) * (float64(int64(1)<<31) / float64((k>>33)+1)))
+	}
+	return int(b)
+}
+
+// newQueueFunc returns a controller NewQueue function which creates the
+// default controller-runtime workqueue, but discards requests for objects
+// which are not owned by the shard. Each queue is added to queues.
+func (s ShardConfig) newQueueFunc(ctx context.Context, k8sClient client.Reader, log logr.Logger, queues *shardQueues) func(string, workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+	return func(controllerName string, rateLimiter workqueue.TypedRateLimiter[reconcile.Request]) workqueue.TypedRateLimitingInterface[reconcile.Request] {
+		queue := &shardQueue{
+			TypedRateLimitingInterface: workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[reconcile.Request]{
+				Name: controllerName,
+			}),
+			owns: func(namespace string) (bool, error) {
+				return s.ownsNamespace(ctx, k8sClient, namespace)
+			},
+			log:             log.WithValues("controller", controllerName),
+			retryLimiter:    workqueue.DefaultTypedControllerRateLimiter[reconcile.Request](),
+			recordDiscarded: s.NamespaceSelector != nil,
+			discarded:       make(map[string]sets.Set[reconcile.Request]),
+		}
+		queues.add(queue)
+		return queue
+	}
+}
+
+// shardQueue is a workqueue which discards requests for objects which are
+// not owned by its shard.
+type shardQueue struct {
+	workqueue.TypedRateLimitingInterface[reconcile.Request]
+
+	owns func(namespace string) (bool, error)
+	log  logr.Logger
+
+	// retryLimiter determines when to check ownership of a request again
+	// after failing to determine the shard of its namespace
+	retryLimiter workqueue.TypedRateLimiter[reconcile.Request]
+
+	// recordDiscarded is true if discarded requests are recorded. The hash
+	// of a namespace never changes, so there is no need to record requests
+	// unless they may be selected by a namespace selector later.
+	recordDiscarded bool
+
+	mu sync.Mutex
+	// discarded contains the requests discarded because their namespace
+	// is not owned by the shard, by namespace, so they can be added if the
+	// namespace is relabelled
+	discarded map[string]sets.Set[reconcile.Request]
+}
+
+func (q *shardQueue) Add(item reconcile.Request) {
+	q.addIfOwned(item, q.TypedRateLimitingInterface.Add)
+}
+
+func (q *shardQueue) AddAfter(item reconcile.Request, duration time.Duration) {
+	q.addIfOwned(item, func(item reconcile.Request) {
+		q.TypedRateLimitingInterface.AddAfter(item, duration)
+	})
+}
+
+func (q *shardQueue) AddRateLimited(item reconcile.Request) {
+	q.addIfOwned(item, q.TypedRateLimitingInterface.AddRateLimited)
+}
+
+// addIfOwned calls add with item if the shard owns the namespace of item. If
+// the shard does not own the namespace, item is discarded. If the shard of the
+// namespace can't be determined, the check is retried with backoff.
+func (q *shardQueue) addIfOwned(item reconcile.Request, add func(reconcile.Request)) {
+	if q.ShuttingDown() {
+		return
+	}
+
+	owned, err := q.owns(item.Namespace)
+	if err != nil {
+		q.log.Error(err, "unable to determine shard of namespace", "namespace", item.Namespace)
+		time.AfterFunc(q.retryLimiter.When(item), func() { q.addIfOwned(item, add) })
+		return
+	}
+	q.retryLimiter.Forget(item)
+
+	if !owned {
+		if !q.recordDiscarded {
+			return
+		}
+
+		q.mu.Lock()
+		defer q.mu.Unlock()
+
+		if q.discarded[item.Namespace] == nil {
+			q.discarded[item.Namespace] = sets.New[reconcile.Request]()
+		}
+		q.discarded[item.Namespace].Insert(item)
+		return
+	}
+	add(item)
+}
+
+// popDiscarded forgets, and returns, the discarded requests for objects in
+// namespace.
+func (q *shardQueue) popDiscarded(namespace string) []reconcile.Request {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	requests := q.discarded[namespace]
+	delete(q.discarded, namespace)
+	return requests.UnsortedList()
+}
+
+// shardQueues are the workqueues of all controllers in a shard.
+type shardQueues struct {
+	mu     sync.Mutex
+	queues []*shardQueue
+}
+
+func (s *shardQueues) add(queue *shardQueue) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.queues = append(s.queues, queue)
+}
+
+// requeueNamespace adds every discarded request for an object in namespace
+// to its queue again. Requests which are still not owned by the shard are
+// discarded again.
+func (s *shardQueues) requeueNamespace(namespace string) {
+	s.mu.Lock()
+	queues := s.queues
+	s.mu.Unlock()
+
+	for _, queue := range queues {
+		for _, request := range queue.popDiscarded(namespace) {
+			queue.Add(request)
+		}
+	}
+}
+
+// forgetNamespace forgets the discarded requests for objects in namespace.
+func (s *shardQueues) forgetNamespace(namespace string) {
+	s.mu.Lock()
+	queues := s.queues
+	s.mu.Unlock()
+
+	for _, queue := range queues {
+		queue.popDiscarded(namespace)
+	}
+}
+
+// addNamespaceWatch requeues the discarded requests for the objects in a
+// namespace when the labels of the namespace change, so that objects in a
+// namespace which becomes selected by the shard's namespace selector are
+// reconciled immediately.
+func (s *shardQueues) addNamespaceWatch(ctx context.Context, mgr ctrl.Manager) error {
+	informer, err := mgr.GetCache().GetInformer(ctx, &corev1.Namespace{})
+	if err != nil {
+		return err
+	}
+
+	_, err = informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj any) {
+			oldNamespace, oldOK := oldObj.(*corev1.Namespace)
+			newNamespace, newOK := newObj.(*corev1.Namespace)
+			if !oldOK || !newOK || maps.Equal(oldNamespace.Labels, newNamespace.Labels) {
+				return
+			}
+			s.requeueNamespace(newNamespace.Name)
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			namespace, ok := obj.(*corev1.Namespace)
+			if !ok {
+				return
+			}
+			s.forgetNamespace(namespace.Name)
+		},
+	})
+	return err
+}
@@ -0,0 +1,295 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package manager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestJumpHash(t *testing.T) {
+	t.Parallel()
+
+	const namespaces = 1000
+	counts := make([]int, 4)
+	moved := 0
+	for i := range namespaces {
+		namespace := fmt.Sprintf("namespace-%d", i)
+		shard := jumpHash(namespace, 4)
+		if shard != jumpHash(namespace, 4) {
+			t.Fatalf("%s: hash is not stable", namespace)
+		}
+		counts[shard]++
+
+		// Adding a shard only moves namespaces to the new shard
+		if grown := jumpHash(namespace, 5); grown != shard {
+			if grown != 4 {
+				t.Errorf("%s: moved from shard %d to existing shard %d", namespace, shard, grown)
+			}
+			moved++
+		}
+	}
+
+	for shard, count := range counts {
+		if count < namespaces/8 {
+			t.Errorf("shard %d has only %d of %d namespaces", shard, count, namespaces)
+		}
+	}
+	if moved > namespaces/3 {
+		t.Errorf("%d of %d namespaces moved when adding a fifth shard", moved, namespaces)
+	}
+}
+
+func TestShardConfig(t *testing.T) {
+	t.Parallel()
+
+	selector, err := labels.Parse("orc-shard=a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		shard     ShardConfig
+		wantErr   bool
+		wantID    string
+		wantOwned []string
+	}{
+		{
+			name:      "not sharded",
+			shard:     ShardConfig{},
+			wantID:    "f35396c5.k-orc.cloud",
+			wantOwned: []string{"selected", "other"},
+		},
+		{
+			name:    "index out of range",
+			shard:   ShardConfig{Count: 2, Index: 2},
+			wantErr: true,
+		},
+		{
+			name:    "index without count",
+			shard:   ShardConfig{Index: 1},
+			wantErr: true,
+		},
+		{
+			name:      "namespace selector",
+			shard:     ShardConfig{NamespaceSelector: selector},
+			wantID:    "selector-e9df19a2.f35396c5.k-orc.cloud",
+			wantOwned: []string{"selected"},
+		},
+		{
+			name:   "hash",
+			shard:  ShardConfig{Count: 2, Index: jumpHash("other", 2)},
+			wantID: fmt.Sprintf("shard-%d-of-2.f35396c5.k-orc.cloud", jumpHash("other", 2)),
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "selected", Labels: map[string]string{"orc-shard": "a"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other"}},
+	).Build()
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.shard.validate()
+			if tc.wantErr {
+				if err == nil {
+					t.Error("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got := tc.shard.leaderElectionID("f35396c5.k-orc.cloud"); got != tc.wantID {
+				t.Errorf("got leader election ID %s, want %s", got, tc.wantID)
+			}
+
+			// The hash shard owns other, and also selected if it hashes to the same shard
+			wantOwned := tc.wantOwned
+			if tc.shard.hashSharded() {
+				wantOwned = []string{"other"}
+				if jumpHash("selected", 2) == tc.shard.Index {
+					wantOwned = append(wantOwned, "selected")
+				}
+			}
+
+			queue := tc.shard.newQueueFunc(context.Background(), k8sClient, logr.Discard(), &shardQueues{})("test",
+				workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+			defer queue.ShutDown()
+			for _, namespace := range []string{"selected", "other"} {
+				queue.Add(reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: "object"}})
+			}
+
+			var owned []string
+			for queue.Len() > 0 {
+				request, _ := queue.Get()
+				owned = append(owned, request.Namespace)
+				queue.Done(request)
+			}
+			slices.Sort(owned)
+			slices.Sort(wantOwned)
+			if !slices.Equal(owned, wantOwned) {
+				t.Errorf("got owned namespaces %v, want %v", owned, wantOwned)
+			}
+		})
+	}
+}
+
+func TestShardQueueRequeueNamespace(t *testing.T) {
+	t.Parallel()
+
+	selector, err := labels.Parse("orc-shard=a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	shard := ShardConfig{NamespaceSelector: selector}
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "relabelled"}}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(namespace).Build()
+
+	queues := &shardQueues{}
+	queue := shard.newQueueFunc(context.Background(), k8sClient, logr.Discard(), queues)("test",
+		workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+	defer queue.ShutDown()
+
+	request := reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace.Name, Name: "object"}}
+	queue.Add(request)
+	if queue.Len() != 0 {
+		t.Fatalf("request for unselected namespace was queued")
+	}
+
+	// Requeueing a namespace which is still not selected discards the request again
+	queues.requeueNamespace(namespace.Name)
+	if queue.Len() != 0 {
+		t.Fatalf("request for unselected namespace was queued")
+	}
+
+	namespace.Labels = map[string]string{"orc-shard": "a"}
+	if err := k8sClient.Update(context.Background(), namespace); err != nil {
+		t.Fatal(err)
+	}
+	queues.requeueNamespace(namespace.Name)
+	if queue.Len() != 1 {
+		t.Fatalf("request for relabelled namespace was not queued")
+	}
+	if got, _ := queue.Get(); got != request {
+		t.Errorf("got request %v, want %v", got, request)
+	}
+}
+
+// TestShardQueueHashOnlyDoesNotRecordDiscarded verifies that requests which
+// are discarded by hash sharding are not retained, as a namespace can never
+// move to another shard without a namespace selector.
+func TestShardQueueHashOnlyDoesNotRecordDiscarded(t *testing.T) {
+	t.Parallel()
+
+	shard := ShardConfig{Count: 2, Index: 0}
+
+	// Find a namespace which is owned by the other shard
+	var namespace string
+	for i := 0; namespace == ""; i++ {
+		if name := fmt.Sprintf("ns-%d", i); jumpHash(name, shard.Count) != shard.Index {
+			namespace = name
+		}
+	}
+
+	queue := shard.newQueueFunc(context.Background(), nil, logr.Discard(), &shardQueues{})("test",
+		workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+	defer queue.ShutDown()
+
+	queue.Add(reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: "object"}})
+	if queue.Len() != 0 {
+		t.Fatalf("request for namespace of another shard was queued")
+	}
+	if discarded := queue.(*shardQueue).popDiscarded(namespace); len(discarded) != 0 {
+		t.Errorf("got discarded requests %v, want none", discarded)
+	}
+}
+
+func TestShardQueueRetriesNamespaceErrors(t *testing.T) {
+	t.Parallel()
+
+	selector, err := labels.Parse("orc-shard=a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	shard := ShardConfig{NamespaceSelector: selector}
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	// Fetching the namespace fails the first time
+	var gets atomic.Int32
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "selected", Labels: map[string]string{"orc-shard": "a"}}},
+	).WithInterceptorFuncs(interceptor.Funcs{
+		Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+			if gets.Add(1) == 1 {
+				return errors.New("cache not synced")
+			}
+			return c.Get(ctx, key, obj, opts...)
+		},
+	}).Build()
+
+	queue := shard.newQueueFunc(context.Background(), k8sClient, logr.Discard(), &shardQueues{})("test",
+		workqueue.DefaultTypedControllerRateLimiter[reconcile.Request]())
+	defer queue.ShutDown()
+
+	queue.Add(reconcile.Request{NamespacedName: types.NamespacedName{Namespace: "selected", Name: "object"}})
+
+	deadline := time.Now().Add(5 * time.Second)
+	for queue.Len() == 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("request was not queued after failing to fetch its namespace")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if got := gets.Load(); got != 2 {
+		t.Errorf("namespace was fetched %d times, want 2", got)
+	}
+}
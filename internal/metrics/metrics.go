@@ -0,0 +1,196 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package metrics defines the ORC-specific Prometheus metrics exposed by the
+// manager's metrics endpoint alongside the default controller-runtime metrics.
+//
+// Metrics relating to ORC objects are labelled with the name of the controller
+// which reconciles them, which is the lowercase name of their kind. This
+// matches the controller label used by controller-runtime's own metrics.
+package metrics
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	namespace = "orc"
+
+	labelController = "controller"
+	labelService    = "service"
+	labelMethod     = "method"
+	labelCode       = "code"
+	labelReason     = "reason"
+
+	// CodeError is the code label of an OpenStack API request which failed
+	// without receiving a response.
+	CodeError = "error"
+
+	// ServiceUnknown is the service label of an OpenStack API request whose
+	// endpoint is not in the service catalog.
+	ServiceUnknown = "unknown"
+)
+
+var (
+	openStackRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "openstack",
+		Name:      "api_requests_total",
+		Help:      "Total number of OpenStack API requests by service, HTTP method and response status code.",
+	}, []string{labelService, labelMethod, labelCode})
+
+	openStackRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "openstack",
+		Name:      "api_request_duration_seconds",
+		Help:      "Latency of OpenStack API requests by service and HTTP method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{labelService, labelMethod})
+
+	adoptions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "adoptions_total",
+		Help:      "Total number of previously created OpenStack resources adopted by ORC objects.",
+	}, []string{labelController})
+
+	externalDeletions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "external_deletions_total",
+		Help:      "Total number of OpenStack resources found to have been deleted outside of ORC.",
+	}, []string{labelController})
+
+	driftCorrections = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "drift_corrections_total",
+		Help:      "Total number of updates made to OpenStack resources to correct drift from an unchanged spec.",
+	}, []string{labelController})
+
+	objects = newObjectCollector()
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		openStackRequests,
+		openStackRequestDuration,
+		adoptions,
+		externalDeletions,
+		driftCorrections,
+		objects,
+	)
+}
+
+// ObserveOpenStackRequest records a completed OpenStack API request. code is
+// the HTTP status code of the response, or 0 if no response was received.
+func ObserveOpenStackRequest(service, method string, code int, duration time.Duration) {
+	codeLabel := CodeError
+	if code != 0 {
+		codeLabel = strconv.Itoa(code)
+	}
+	openStackRequests.WithLabelValues(service, method, codeLabel).Inc()
+	openStackRequestDuration.WithLabelValues(service, method).Observe(duration.Seconds())
+}
+
+// RecordAdoption records the adoption of an OpenStack resource.
+func RecordAdoption(controller string) {
+	adoptions.WithLabelValues(controller).Inc()
+}
+
+// RecordExternalDeletion records the discovery of an OpenStack resource which
+// was deleted outside of ORC.
+func RecordExternalDeletion(controller string) {
+	externalDeletions.WithLabelValues(controller).Inc()
+}
+
+// RecordDriftCorrection records an update to an OpenStack resource which was
+// required although the ORC object's spec had not changed.
+func RecordDriftCorrection(controller string) {
+	driftCorrections.WithLabelValues(controller).Inc()
+}
+
+// SetObjectReason records the reason of the Progressing condition of an ORC
+// object.
+func SetObjectReason(controller string, object types.NamespacedName, reason string) {
+	objects.set(controller, object, reason)
+}
+
+// ForgetObject removes an ORC object which no longer exists.
+func ForgetObject(controller string, object types.NamespacedName) {
+	objects.forget(controller, object)
+}
+
+// objectCollector reports the number of ORC objects for each controller by the
+// reason of their Progressing condition. It is computed at collection time from
+// the last reason reported for each object.
+type objectCollector struct {
+	mu      sync.Mutex
+	reasons map[string]map[types.NamespacedName]string
+	desc    *prometheus.Desc
+}
+
+func newObjectCollector() *objectCollector {
+	return &objectCollector{
+		reasons: make(map[string]map[types.NamespacedName]string),
+		desc: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "objects"),
+			"Number of ORC objects by the reason of their Progressing condition.",
+			[]string{labelController, labelReason}, nil,
+		),
+	}
+}
+
+func (c *objectCollector) set(controller string, object types.NamespacedName, reason string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	reasons, ok := c.reasons[controller]
+	if !ok {
+		reasons = make(map[types.NamespacedName]string)
+		c.reasons[controller] = reasons
+	}
+	reasons[object] = reason
+}
+
+func (c *objectCollector) forget(controller string, object types.NamespacedName) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.reasons[controller], object)
+}
+
+func (c *objectCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *objectCollector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for controller, reasons := range c.reasons {
+		counts := make(map[string]int)
+		for _, reason := range reasons {
+			counts[reason]++
+		}
+		for reason, count := range counts {
+			ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(count), controller, reason)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestObjectCollector(t *testing.T) {
+	t.Parallel()
+
+	c := newObjectCollector()
+	c.set("network", types.NamespacedName{Namespace: "ns", Name: "a"}, "Success")
+	c.set("network", types.NamespacedName{Namespace: "ns", Name: "b"}, "Success")
+	c.set("network", types.NamespacedName{Namespace: "ns", Name: "c"}, "TransientError")
+	c.set("port", types.NamespacedName{Namespace: "ns", Name: "a"}, "InvalidConfiguration")
+
+	// A reason change replaces the previous reason
+	c.set("network", types.NamespacedName{Namespace: "ns", Name: "b"}, "Progressing")
+	// A deleted object is no longer counted
+	c.set("port", types.NamespacedName{Namespace: "ns", Name: "b"}, "Success")
+	c.forget("port", types.NamespacedName{Namespace: "ns", Name: "b"})
+
+	expected := `
+# HELP orc_objects Number of ORC objects by the reason of their Progressing condition.
+# TYPE orc_objects gauge
+orc_objects{controller="network",reason="Progressing"} 1
+orc_objects{controller="network",reason="Success"} 1
+orc_objects{controller="network",reason="TransientError"} 1
+orc_objects{controller="port",reason="InvalidConfiguration"} 1
+`
+	if err := testutil.CollectAndCompare(c, strings.NewReader(expected)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestObserveOpenStackRequest(t *testing.T) {
+	t.Parallel()
+
+	ObserveOpenStackRequest("test-service", "GET", 200, time.Second)
+	ObserveOpenStackRequest("test-service", "GET", 0, time.Second)
+
+	if got := testutil.ToFloat64(openStackRequests.WithLabelValues("test-service", "GET", "200")); got != 1 {
+		t.Errorf("got %v requests with code 200, want 1", got)
+	}
+	if got := testutil.ToFloat64(openStackRequests.WithLabelValues("test-service", "GET", CodeError)); got != 1 {
+		t.Errorf("got %v requests with code %s, want 1", got, CodeError)
+	}
+}
@@ -0,0 +1,234 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package osclients
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack"
+	"github.com/gophercloud/gophercloud/v2/openstack/networking/v2/extensions"
+	"github.com/gophercloud/gophercloud/v2/openstack/utils"
+	"github.com/gophercloud/utils/v2/openstack/clientconfig"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
+)
+
+// Service types of the services whose capabilities can be discovered.
+const (
+	ServiceTypeBlockStorage      = "block-storage"
+	ServiceTypeCompute           = "compute"
+	ServiceTypeNetwork           = "network"
+	ServiceTypeSharedFileSystems = "shared-file-system"
+)
+
+// Capability is an optional feature of an OpenStack service. A cloud supports
+// a capability if its service supports Microversion, or if Extension is a
+// Neutron extension enabled in the cloud.
+type Capability struct {
+	// Description describes the capability to users.
+	Description string
+
+	// ServiceType is the type of the service which provides the capability.
+	ServiceType string
+
+	// Microversion is the minimum API microversion of the service which
+	// supports the capability.
+	Microversion string
+
+	// Extension is the alias of the Neutron extension which provides the
+	// capability.
+	Extension string
+}
+
+func (c Capability) String() string {
+	if c.Extension != "" {
+		return fmt.Sprintf("%s (%s extension %s)", c.Description, c.ServiceType, c.Extension)
+	}
+	return fmt.Sprintf("%s (%s API microversion %s)", c.Description, c.ServiceType, c.Microversion)
+}
+
+var (
+	// CapabilityPortTrustedVIF is support for trusted virtual functions on
+	// ports.
+	CapabilityPortTrustedVIF = Capability{Description: "trusted VIFs", ServiceType: ServiceTypeNetwork, Extension: "port-trusted-vif"}
+
+	// CapabilityStandardAttrRevisions is support for revision numbers on
+	// Neutron resources.
+	CapabilityStandardAttrRevisions = Capability{Description: "revision numbers", ServiceType: ServiceTypeNetwork, Extension: "standard-attr-revisions"}
+
+	// CapabilityVolumeFromBackup is support for creating a volume from a
+	// backup.
+	CapabilityVolumeFromBackup = Capability{Description: "creating volumes from backups", ServiceType: ServiceTypeBlockStorage, Microversion: "3.47"}
+
+	// CapabilityOnlineVolumeExtend is support for extending a volume which
+	// is attached to a server.
+	CapabilityOnlineVolumeExtend = Capability{Description: "extending attached volumes", ServiceType: ServiceTypeBlockStorage, Microversion: "3.42"}
+)
+
+// CapabilitiesClient discovers the capabilities of a cloud. Discovered
+// capabilities are cached for the lifetime of the client.
+type CapabilitiesClient interface {
+	// GetSupportedMicroversions returns the range of API microversions
+	// supported by a service.
+	GetSupportedMicroversions(ctx context.Context, serviceType string) (utils.SupportedMicroversions, error)
+
+	// HasNetworkExtension returns true if the Neutron extension with the
+	// given alias is enabled.
+	HasNetworkExtension(ctx context.Context, alias string) (bool, error)
+
+	// Supports returns true if the cloud supports capability.
+	Supports(ctx context.Context, capability Capability) (bool, error)
+}
+
+type capabilitiesClient struct {
+	providerClient     *gophercloud.ProviderClient
+	providerClientOpts *clientconfig.ClientOpts
+
+	mu                sync.Mutex
+	microversions     map[string]utils.SupportedMicroversions
+	networkExtensions sets.Set[string]
+}
+
+// NewCapabilitiesClient returns a new capabilities client.
+func NewCapabilitiesClient(providerClient *gophercloud.ProviderClient, providerClientOpts *clientconfig.ClientOpts) CapabilitiesClient {
+	return &capabilitiesClient{
+		providerClient:     providerClient,
+		providerClientOpts: providerClientOpts,
+		microversions:      make(map[string]utils.SupportedMicroversions),
+	}
+}
+
+func (c *capabilitiesClient) newServiceClient(serviceType string) (*gophercloud.ServiceClient, error) {
+	newServiceClient := map[string]func(*gophercloud.ProviderClient, gophercloud.EndpointOpts) (*gophercloud.ServiceClient, error){
+		ServiceTypeBlockStorage:      openstack.NewBlockStorageV3,
+		ServiceTypeCompute:           openstack.NewComputeV2,
+		ServiceTypeNetwork:           openstack.NewNetworkV2,
+		ServiceTypeSharedFileSystems: openstack.NewSharedFileSystemV2,
+	}[serviceType]
+	if newServiceClient == nil {
+		return nil, fmt.Errorf("unknown service type %s", serviceType)
+	}
+
+	client, err := newServiceClient(c.providerClient, gophercloud.EndpointOpts{
+		Region:       c.providerClientOpts.RegionName,
+		Availability: clientconfig.GetEndpointType(c.providerClientOpts.EndpointType),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create %s service client: %v", serviceType, err)
+	}
+	return client, nil
+}
+
+func (c *capabilitiesClient) GetSupportedMicroversions(ctx context.Context, serviceType string) (utils.SupportedMicroversions, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if microversions, ok := c.microversions[serviceType]; ok {
+		return microversions, nil
+	}
+
+	client, err := c.newServiceClient(serviceType)
+	if err != nil {
+		return utils.SupportedMicroversions{}, err
+	}
+	microversions, err := utils.GetSupportedMicroversions(ctx, client)
+	if err != nil {
+		return utils.SupportedMicroversions{}, fmt.Errorf("discovering %s API microversions: %w", serviceType, err)
+	}
+
+	c.microversions[serviceType] = microversions
+	return microversions, nil
+}
+
+func (c *capabilitiesClient) HasNetworkExtension(ctx context.Context, alias string) (bool, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.networkExtensions == nil {
+		client, err := c.newServiceClient(ServiceTypeNetwork)
+		if err != nil {
+			return false, err
+		}
+		allPages, err := extensions.List(client).AllPages(ctx)
+		if err != nil {
+			return false, fmt.Errorf("listing network extensions: %w", err)
+		}
+		allExtensions, err := extensions.ExtractExtensions(allPages)
+		if err != nil {
+			return false, fmt.Errorf("listing network extensions: %w", err)
+		}
+
+		networkExtensions := sets.New[string]()
+		for i := range allExtensions {
+			networkExtensions.Insert(allExtensions[i].Alias)
+		}
+		c.networkExtensions = networkExtensions
+	}
+
+	return c.networkExtensions.Has(alias), nil
+}
+
+func (c *capabilitiesClient) Supports(ctx context.Context, capability Capability) (bool, error) {
+	if capability.Extension != "" {
+		return c.HasNetworkExtension(ctx, capability.Extension)
+	}
+
+	microversions, err := c.GetSupportedMicroversions(ctx, capability.ServiceType)
+	if err != nil {
+		return false, err
+	}
+	return microversions.IsSupported(capability.Microversion)
+}
+
+// RequireCapability returns a terminal InvalidConfiguration error if the
+// cloud does not support capability, which is required by field. It returns
+// any error discovering the capabilities of the cloud.
+func RequireCapability(ctx context.Context, c CapabilitiesClient, capability Capability, field string) error {
+	supported, err := c.Supports(ctx, capability)
+	if err != nil {
+		return err
+	}
+	if !supported {
+		return orcerrors.Terminal(orcv1alpha1.ConditionReasonInvalidConfiguration,
+			fmt.Sprintf("%s requires %s, which is not supported by this cloud", field, capability))
+	}
+	return nil
+}
+
+type capabilitiesErrorClient struct{ error }
+
+// NewCapabilitiesErrorClient returns a CapabilitiesClient in which every method returns the given error.
+func NewCapabilitiesErrorClient(e error) CapabilitiesClient {
+	return capabilitiesErrorClient{e}
+}
+
+func (e capabilitiesErrorClient) GetSupportedMicroversions(_ context.Context, _ string) (utils.SupportedMicroversions, error) {
+	return utils.SupportedMicroversions{}, e.error
+}
+
+func (e capabilitiesErrorClient) HasNetworkExtension(_ context.Context, _ string) (bool, error) {
+	return false, e.error
+}
+
+func (e capabilitiesErrorClient) Supports(_ context.Context, _ Capability) (bool, error) {
+	return false, e.error
+}
@@ -0,0 +1,98 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package osclients
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/utils/v2/openstack/clientconfig"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
+)
+
+func TestCapabilitiesClient(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("/compute/v2.1/", func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"version": {"id": "v2.1", "status": "CURRENT", "version": "2.25", "min_version": "2.1"}}`)
+	})
+	mux.HandleFunc("/network/v2.0/extensions", func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"extensions": [{"alias": "standard-attr-revisions", "name": "Resource revision numbers"}]}`)
+	})
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	providerClient := &gophercloud.ProviderClient{HTTPClient: *server.Client()}
+	providerClient.EndpointLocator = func(eo gophercloud.EndpointOpts) (string, error) {
+		switch eo.Type {
+		case ServiceTypeCompute:
+			return server.URL + "/compute/v2.1/", nil
+		case ServiceTypeNetwork:
+			return server.URL + "/network/", nil
+		}
+		return "", fmt.Errorf("no endpoint for %s", eo.Type)
+	}
+	client := NewCapabilitiesClient(providerClient, &clientconfig.ClientOpts{})
+
+	ctx := context.Background()
+	tests := []struct {
+		capability Capability
+		want       bool
+	}{
+		{capability: Capability{ServiceType: ServiceTypeCompute, Microversion: "2.25"}, want: true},
+		{capability: Capability{ServiceType: ServiceTypeCompute, Microversion: "2.26"}, want: false},
+		{capability: CapabilityStandardAttrRevisions, want: true},
+		{capability: CapabilityPortTrustedVIF, want: false},
+	}
+	for _, tc := range tests {
+		got, err := client.Supports(ctx, tc.capability)
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", tc.capability, err)
+		}
+		if got != tc.want {
+			t.Errorf("%s: got %v, want %v", tc.capability, got, tc.want)
+		}
+	}
+
+	// Each service was only queried once
+	if got := requests.Load(); got != 2 {
+		t.Errorf("expected 2 requests, got %d", got)
+	}
+
+	err := RequireCapability(ctx, client, CapabilityPortTrustedVIF, "spec.resource.trustedVIF")
+	var terminalError *orcerrors.TerminalError
+	if !errors.As(err, &terminalError) || terminalError.Reason != orcv1alpha1.ConditionReasonInvalidConfiguration {
+		t.Errorf("expected InvalidConfiguration terminal error, got %v", err)
+	}
+	if err := RequireCapability(ctx, client, CapabilityStandardAttrRevisions, "spec.resource"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
@@ -30,6 +30,7 @@ import (
 	reflect "reflect"
 
 	volumes "github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/volumes"
+	osclients "github.com/k-orc/openstack-resource-controller/v2/internal/osclients"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -57,6 +58,20 @@ func (m *MockVolumeClient) EXPECT() *MockVolumeClientMockRecorder {
 	return m.recorder
 }
 
+// ChangeVolumeType mocks base method.
+func (m *MockVolumeClient) ChangeVolumeType(ctx context.Context, id string, opts volumes.ChangeTypeOptsBuilder) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeVolumeType", ctx, id, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeVolumeType indicates an expected call of ChangeVolumeType.
+func (mr *MockVolumeClientMockRecorder) ChangeVolumeType(ctx, id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeVolumeType", reflect.TypeOf((*MockVolumeClient)(nil).ChangeVolumeType), ctx, id, opts)
+}
+
 // CreateVolume mocks base method.
 func (m *MockVolumeClient) CreateVolume(ctx context.Context, opts volumes.CreateOptsBuilder) (*volumes.Volume, error) {
 	m.ctrl.T.Helper()
@@ -86,6 +101,20 @@ func (mr *MockVolumeClientMockRecorder) DeleteVolume(ctx, resourceID, opts any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolume", reflect.TypeOf((*MockVolumeClient)(nil).DeleteVolume), ctx, resourceID, opts)
 }
 
+// ExtendVolume mocks base method.
+func (m *MockVolumeClient) ExtendVolume(ctx context.Context, id string, opts osclients.VolumeExtendOpts) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtendVolume", ctx, id, opts)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExtendVolume indicates an expected call of ExtendVolume.
+func (mr *MockVolumeClientMockRecorder) ExtendVolume(ctx, id, opts any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtendVolume", reflect.TypeOf((*MockVolumeClient)(nil).ExtendVolume), ctx, id, opts)
+}
+
 // GetVolume mocks base method.
 func (m *MockVolumeClient) GetVolume(ctx context.Context, resourceID string) (*volumes.Volume, error) {
 	m.ctrl.T.Helper()
@@ -27,12 +27,23 @@ import (
 	"github.com/gophercloud/utils/v2/openstack/clientconfig"
 )
 
+// VolumeExtendOpts are the options for extending a volume.
+type VolumeExtendOpts struct {
+	volumes.ExtendSizeOpts
+
+	// Online must be set to extend a volume which is attached to a server.
+	// This requires CapabilityOnlineVolumeExtend.
+	Online bool `json:"-"`
+}
+
 type VolumeClient interface {
 	ListVolumes(ctx context.Context, listOpts volumes.ListOptsBuilder) iter.Seq2[*volumes.Volume, error]
 	CreateVolume(ctx context.Context, opts volumes.CreateOptsBuilder) (*volumes.Volume, error)
 	DeleteVolume(ctx context.Context, resourceID string, opts volumes.DeleteOptsBuilder) error
 	GetVolume(ctx context.Context, resourceID string) (*volumes.Volume, error)
 	UpdateVolume(ctx context.Context, id string, opts volumes.UpdateOptsBuilder) (*volumes.Volume, error)
+	ExtendVolume(ctx context.Context, id string, opts VolumeExtendOpts) error
+	ChangeVolumeType(ctx context.Context, id string, opts volumes.ChangeTypeOptsBuilder) error
 }
 
 type volumeClient struct{ client *gophercloud.ServiceClient }
@@ -59,7 +70,15 @@ func (c volumeClient) ListVolumes(ctx context.Context, listOpts volumes.ListOpts
 }
 
 func (c volumeClient) CreateVolume(ctx context.Context, opts volumes.CreateOptsBuilder) (*volumes.Volume, error) {
-	return volumes.Create(ctx, c.client, opts, nil).Extract()
+	client := c.client
+
+	// Creating a volume from a backup requires a later microversion than
+	// the default
+	if createOpts, ok := opts.(volumes.CreateOpts); ok && createOpts.BackupID != "" {
+		client = c.withMicroversion(CapabilityVolumeFromBackup.Microversion)
+	}
+
+	return volumes.Create(ctx, client, opts, nil).Extract()
 }
 
 func (c volumeClient) DeleteVolume(ctx context.Context, resourceID string, opts volumes.DeleteOptsBuilder) error {
@@ -74,6 +93,26 @@ func (c volumeClient) UpdateVolume(ctx context.Context, id string, opts volumes.
 	return volumes.Update(ctx, c.client, id, opts).Extract()
 }
 
+func (c volumeClient) ExtendVolume(ctx context.Context, id string, opts VolumeExtendOpts) error {
+	client := c.client
+	if opts.Online {
+		client = c.withMicroversion(CapabilityOnlineVolumeExtend.Microversion)
+	}
+	return volumes.ExtendSize(ctx, client, id, opts.ExtendSizeOpts).ExtractErr()
+}
+
+func (c volumeClient) ChangeVolumeType(ctx context.Context, id string, opts volumes.ChangeTypeOptsBuilder) error {
+	return volumes.ChangeType(ctx, c.client, id, opts).ExtractErr()
+}
+
+// withMicroversion returns a copy of the service client which requests the
+// given microversion.
+func (c volumeClient) withMicroversion(microversion string) *gophercloud.ServiceClient {
+	client := *c.client
+	client.Microversion = microversion
+	return &client
+}
+
 type volumeErrorClient struct{ error }
 
 // NewVolumeErrorClient returns a VolumeClient in which every method returns the given error.
@@ -102,3 +141,11 @@ func (e volumeErrorClient) GetVolume(_ context.Context, _ string) (*volumes.Volu
 func (e volumeErrorClient) UpdateVolume(_ context.Context, _ string, _ volumes.UpdateOptsBuilder) (*volumes.Volume, error) {
 	return nil, e.error
 }
+
+func (e volumeErrorClient) ExtendVolume(_ context.Context, _ string, _ VolumeExtendOpts) error {
+	return e.error
+}
+
+func (e volumeErrorClient) ChangeVolumeType(_ context.Context, _ string, _ volumes.ChangeTypeOptsBuilder) error {
+	return e.error
+}
@@ -0,0 +1,118 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package osclients
+
+import (
+	"context"
+	"fmt"
+	"iter"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack"
+	"github.com/gophercloud/gophercloud/v2/openstack/blockstorage/v3/backups"
+	"github.com/gophercloud/utils/v2/openstack/clientconfig"
+)
+
+// CinderBackupMinimumMicroversion is the minimum Cinder microversion used
+// for backups. Updating a backup requires 3.9.
+const CinderBackupMinimumMicroversion = "3.9"
+
+// VolumeBackupUpdateOpts are the options for updating a backup. Unlike
+// backups.UpdateOpts, they are sent in the "backup" element of the request
+// body which is required by Cinder.
+type VolumeBackupUpdateOpts backups.UpdateOpts
+
+func (opts VolumeBackupUpdateOpts) ToBackupUpdateMap() (map[string]any, error) {
+	return gophercloud.BuildRequestBody(opts, "backup")
+}
+
+type VolumeBackupClient interface {
+	ListVolumeBackups(ctx context.Context, listOpts backups.ListDetailOptsBuilder) iter.Seq2[*backups.Backup, error]
+	CreateVolumeBackup(ctx context.Context, opts backups.CreateOptsBuilder) (*backups.Backup, error)
+	DeleteVolumeBackup(ctx context.Context, resourceID string) error
+	GetVolumeBackup(ctx context.Context, resourceID string) (*backups.Backup, error)
+	UpdateVolumeBackup(ctx context.Context, id string, opts backups.UpdateOptsBuilder) (*backups.Backup, error)
+}
+
+type volumebackupClient struct{ client *gophercloud.ServiceClient }
+
+// NewVolumeBackupClient returns a new OpenStack client.
+func NewVolumeBackupClient(providerClient *gophercloud.ProviderClient, providerClientOpts *clientconfig.ClientOpts) (VolumeBackupClient, error) {
+	client, err := openstack.NewBlockStorageV3(providerClient, gophercloud.EndpointOpts{
+		Region:       providerClientOpts.RegionName,
+		Availability: clientconfig.GetEndpointType(providerClientOpts.EndpointType),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to create volumebackup service client: %v", err)
+	}
+	client.Microversion = CinderBackupMinimumMicroversion
+
+	return &volumebackupClient{client}, nil
+}
+
+func (c volumebackupClient) ListVolumeBackups(ctx context.Context, listOpts backups.ListDetailOptsBuilder) iter.Seq2[*backups.Backup, error] {
+	pager := backups.ListDetail(c.client, listOpts)
+	return func(yield func(*backups.Backup, error) bool) {
+		_ = pager.EachPage(ctx, yieldPage(backups.ExtractBackups, yield))
+	}
+}
+
+func (c volumebackupClient) CreateVolumeBackup(ctx context.Context, opts backups.CreateOptsBuilder) (*backups.Backup, error) {
+	return backups.Create(ctx, c.client, opts).Extract()
+}
+
+func (c volumebackupClient) DeleteVolumeBackup(ctx context.Context, resourceID string) error {
+	return backups.Delete(ctx, c.client, resourceID).ExtractErr()
+}
+
+func (c volumebackupClient) GetVolumeBackup(ctx context.Context, resourceID string) (*backups.Backup, error) {
+	return backups.Get(ctx, c.client, resourceID).Extract()
+}
+
+func (c volumebackupClient) UpdateVolumeBackup(ctx context.Context, id string, opts backups.UpdateOptsBuilder) (*backups.Backup, error) {
+	return backups.Update(ctx, c.client, id, opts).Extract()
+}
+
+type volumebackupErrorClient struct{ error }
+
+// NewVolumeBackupErrorClient returns a VolumeBackupClient in which every method returns the given error.
+func NewVolumeBackupErrorClient(e error) VolumeBackupClient {
+	return volumebackupErrorClient{e}
+}
+
+func (e volumebackupErrorClient) ListVolumeBackups(_ context.Context, _ backups.ListDetailOptsBuilder) iter.Seq2[*backups.Backup, error] {
+	return func(yield func(*backups.Backup, error) bool) {
+		yield(nil, e.error)
+	}
+}
+
+func (e volumebackupErrorClient) CreateVolumeBackup(_ context.Context, _ backups.CreateOptsBuilder) (*backups.Backup, error) {
+	return nil, e.error
+}
+
+func (e volumebackupErrorClient) DeleteVolumeBackup(_ context.Context, _ string) error {
+	return e.error
+}
+
+func (e volumebackupErrorClient) GetVolumeBackup(_ context.Context, _ string) (*backups.Backup, error) {
+	return nil, e.error
+}
+
+func (e volumebackupErrorClient) UpdateVolumeBackup(_ context.Context, _ string, _ backups.UpdateOptsBuilder) (*backups.Backup, error) {
+	return nil, e.error
+}
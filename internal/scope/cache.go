@@ -0,0 +1,160 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"bytes"
+	"context"
+	"maps"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/apimachinery/pkg/util/sets"
+	toolscache "k8s.io/client-go/tools/cache"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+)
+
+// scopeCacheMu serialises adding scopes to a scope cache with conditionally
+// removing them, so that a scope is never removed after it has been replaced.
+var scopeCacheMu sync.Mutex
+
+// addCachedScope caches scope with key, replacing any scope previously cached
+// with key.
+func addCachedScope(c *cache.LRUExpireCache, key string, scope Scope, ttl time.Duration) {
+	scopeCacheMu.Lock()
+	defer scopeCacheMu.Unlock()
+
+	c.Add(key, scope, ttl)
+}
+
+// removeCachedScope removes the scope cached with key only if it is scope.
+func removeCachedScope(c *cache.LRUExpireCache, key string, scope Scope) {
+	scopeCacheMu.Lock()
+	defer scopeCacheMu.Unlock()
+
+	if cached, found := c.Get(key); found && cached == scope {
+		c.Remove(key)
+	}
+}
+
+// secretScopeRegistry records the keys of the cached scopes created from each
+// credentials secret, so they can be discarded when the secret changes.
+type secretScopeRegistry struct {
+	mu   sync.Mutex
+	keys map[types.NamespacedName]sets.Set[string]
+}
+
+// add records that the scope cached with key was created from secret.
+func (r *secretScopeRegistry) add(secret types.NamespacedName, key string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.keys == nil {
+		r.keys = make(map[types.NamespacedName]sets.Set[string])
+	}
+	if r.keys[secret] == nil {
+		r.keys[secret] = sets.New[string]()
+	}
+	r.keys[secret].Insert(key)
+}
+
+// remove forgets, and returns, the keys of all scopes created from secret.
+func (r *secretScopeRegistry) remove(secret types.NamespacedName) []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	keys := r.keys[secret]
+	delete(r.keys, secret)
+	return keys.UnsortedList()
+}
+
+// invalidateSecret discards all cached scopes and authentication failures of
+// credentials from secret.
+func (f *providerScopeFactory) invalidateSecret(secret types.NamespacedName) {
+	if f.clientCache == nil {
+		return
+	}
+	for _, key := range f.secretScopes.remove(secret) {
+		f.clientCache.Remove(key)
+		f.failureCache.Remove(key)
+	}
+}
+
+// AddSecretWatch discards the cached scopes of factory which were created from
+// a credentials secret when the contents of the secret are updated, or when it
+// is deleted. Subsequent scopes are created from the current contents of the
+// secret. It does nothing if factory does not cache scopes.
+func AddSecretWatch(ctx context.Context, mgr ctrl.Manager, factory Factory) error {
+	f, ok := factory.(*providerScopeFactory)
+	if !ok || f.clientCache == nil {
+		return nil
+	}
+
+	informer, err := mgr.GetCache().GetInformer(ctx, &corev1.Secret{})
+	if err != nil {
+		return err
+	}
+
+	_, err = informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+		UpdateFunc: func(oldObj, newObj any) {
+			oldSecret, oldOK := oldObj.(*corev1.Secret)
+			newSecret, newOK := newObj.(*corev1.Secret)
+			if !oldOK || !newOK {
+				return
+			}
+			// Ignore changes which only affect metadata, e.g. finalizers
+			// added by ORC
+			if secretDataEqual(oldSecret, newSecret) {
+				return
+			}
+			f.invalidateSecret(types.NamespacedName{Namespace: newSecret.Namespace, Name: newSecret.Name})
+		},
+		DeleteFunc: func(obj any) {
+			if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			secret, ok := obj.(*corev1.Secret)
+			if !ok {
+				return
+			}
+			f.invalidateSecret(types.NamespacedName{Namespace: secret.Namespace, Name: secret.Name})
+		},
+	})
+	return err
+}
+
+// secretDataEqual returns true if two versions of a secret have the same data
+// and annotations, which may configure rate limits. The credentials status
+// annotation is ignored, as it is written by ORC.
+func secretDataEqual(a, b *corev1.Secret) bool {
+	return maps.EqualFunc(a.Data, b.Data, bytes.Equal) &&
+		maps.Equal(withoutStatusAnnotation(a.Annotations), withoutStatusAnnotation(b.Annotations))
+}
+
+func withoutStatusAnnotation(annotations map[string]string) map[string]string {
+	if _, ok := annotations[orcv1alpha1.CloudCredentialsStatusAnnotation]; !ok {
+		return annotations
+	}
+	annotations = maps.Clone(annotations)
+	delete(annotations, orcv1alpha1.CloudCredentialsStatusAnnotation)
+	return annotations
+}
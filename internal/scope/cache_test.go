@@ -0,0 +1,77 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+)
+
+func TestInvalidateSecret(t *testing.T) {
+	t.Parallel()
+
+	f := NewFactory(10, nil, RateLimit{}).(*providerScopeFactory)
+
+	secretA := types.NamespacedName{Namespace: "default", Name: "a"}
+	secretB := types.NamespacedName{Namespace: "default", Name: "b"}
+	for key, secret := range map[string]types.NamespacedName{"a1": secretA, "a2": secretA, "b1": secretB} {
+		f.clientCache.Add(key, &providerScope{}, time.Hour)
+		f.failureCache.Add(key, &cachedAuthenticationError{}, time.Hour)
+		f.secretScopes.add(secret, key)
+	}
+
+	f.invalidateSecret(secretA)
+
+	for key, want := range map[string]bool{"a1": false, "a2": false, "b1": true} {
+		if _, found := f.clientCache.Get(key); found != want {
+			t.Errorf("scope %s cached: got %v, want %v", key, found, want)
+		}
+		if _, found := f.failureCache.Get(key); found != want {
+			t.Errorf("failure %s cached: got %v, want %v", key, found, want)
+		}
+	}
+}
+
+func TestSecretDataEqual(t *testing.T) {
+	t.Parallel()
+
+	secret := func(value string, finalizers ...string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Finalizers: finalizers},
+			Data:       map[string][]byte{"clouds.yaml": []byte(value)},
+		}
+	}
+
+	if !secretDataEqual(secret("a"), secret("a", "openstack.k-orc.cloud/network")) {
+		t.Error("expected secrets which differ only in finalizers to be equal")
+	}
+	if secretDataEqual(secret("a"), secret("b")) {
+		t.Error("expected secrets with different data not to be equal")
+	}
+
+	withStatus := secret("a")
+	withStatus.Annotations = map[string]string{orcv1alpha1.CloudCredentialsStatusAnnotation: "{}"}
+	if !secretDataEqual(secret("a"), withStatus) {
+		t.Error("expected secrets which differ only in credentials status to be equal")
+	}
+}
@@ -2,8 +2,14 @@ package scope
 
 import (
 	"net/http"
+	"strings"
+	"sync"
+	"time"
 
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+
+	"github.com/k-orc/openstack-resource-controller/v2/internal/metrics"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dryrun"
 )
 
 // RoundTripper satisfies the http.RoundTripper interface and is used to
@@ -11,6 +17,15 @@ import (
 type RoundTripper struct {
 	// Default http.RoundTripper
 	http.RoundTripper
+
+	// limiter limits requests made with the provider client's credentials.
+	// If nil, requests are not limited.
+	limiter *rateLimiter
+
+	mu sync.RWMutex
+	// serviceEndpoints maps endpoint URLs to the service type which serves
+	// them. It is used to label request metrics.
+	serviceEndpoints map[string]string
 }
 
 // RoundTrip performs a round-trip HTTP request, injecting the OpenStack
@@ -21,5 +36,55 @@ func (rt *RoundTripper) RoundTrip(request *http.Request) (*http.Response, error)
 		request.Header.Set("X-OpenStack-Request-ID", "req-"+string(reconcileID))
 	}
 
-	return rt.RoundTripper.RoundTrip(request)
+	// Requests which would modify OpenStack resources are not sent in dry
+	// run mode
+	if err := dryrun.CheckRequest(request); err != nil {
+		return nil, err
+	}
+
+	release, err := rt.limiter.acquire(request.Context())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	start := time.Now()
+	response, err := rt.RoundTripper.RoundTrip(request)
+
+	var code int
+	if err == nil {
+		code = response.StatusCode
+	}
+	metrics.ObserveOpenStackRequest(rt.serviceForURL(request.URL.String()), request.Method, code, time.Since(start))
+
+	return response, err
+}
+
+// SetServiceEndpoint records that requests to URLs beginning with endpoint are
+// made to the given service type.
+func (rt *RoundTripper) SetServiceEndpoint(endpoint, serviceType string) {
+	rt.mu.Lock()
+	defer rt.mu.Unlock()
+
+	if rt.serviceEndpoints == nil {
+		rt.serviceEndpoints = make(map[string]string)
+	}
+	rt.serviceEndpoints[strings.TrimSuffix(endpoint, "/")] = serviceType
+}
+
+// serviceForURL returns the service type of the longest endpoint which is a
+// prefix of url.
+func (rt *RoundTripper) serviceForURL(url string) string {
+	rt.mu.RLock()
+	defer rt.mu.RUnlock()
+
+	service := metrics.ServiceUnknown
+	longest := 0
+	for endpoint, serviceType := range rt.serviceEndpoints {
+		if len(endpoint) > longest && strings.HasPrefix(url, endpoint) {
+			service = serviceType
+			longest = len(endpoint)
+		}
+	}
+	return service
 }
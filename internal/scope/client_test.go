@@ -0,0 +1,96 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/k-orc/openstack-resource-controller/v2/internal/metrics"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dryrun"
+)
+
+func TestRoundTripperServiceForURL(t *testing.T) {
+	t.Parallel()
+
+	rt := &RoundTripper{}
+	rt.SetServiceEndpoint("https://cloud.example.com:5000/v3/", "identity")
+	rt.SetServiceEndpoint("https://cloud.example.com:9696", "network")
+	rt.SetServiceEndpoint("https://cloud.example.com/compute/v2.1", "compute")
+	rt.SetServiceEndpoint("https://cloud.example.com/compute/v2.1/admin", "compute-admin")
+
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://cloud.example.com:5000/v3/auth/tokens", "identity"},
+		{"https://cloud.example.com:9696/v2.0/networks", "network"},
+		{"https://cloud.example.com/compute/v2.1/servers/detail", "compute"},
+		{"https://cloud.example.com/compute/v2.1/admin/servers", "compute-admin"},
+		{"https://other.example.com/v2/images", metrics.ServiceUnknown},
+	}
+	for _, tc := range tests {
+		if got := rt.serviceForURL(tc.url); got != tc.want {
+			t.Errorf("serviceForURL(%q) = %q, want %q", tc.url, got, tc.want)
+		}
+	}
+}
+
+func TestRoundTripperDryRun(t *testing.T) {
+	t.Parallel()
+
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &RoundTripper{RoundTripper: http.DefaultTransport}}
+	ctx := dryrun.WithDryRun(context.Background())
+
+	get, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/v2.0/networks", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Do(get)
+	if err != nil {
+		t.Fatalf("GET in dry run mode: %v", err)
+	}
+	resp.Body.Close()
+
+	put, err := http.NewRequestWithContext(ctx, http.MethodPut, server.URL+"/v2.0/networks/net-id", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.Do(put)
+	var dryRunErr *dryrun.Error
+	if !errors.As(err, &dryRunErr) {
+		t.Fatalf("expected dry run error for PUT, got %v", err)
+	}
+	if want := "Would send PUT /v2.0/networks/net-id"; dryRunErr.Action() != want {
+		t.Errorf("got action %q, want %q", dryRunErr.Action(), want)
+	}
+
+	if got := requests.Load(); got != 1 {
+		t.Errorf("server received %d requests, want 1", got)
+	}
+}
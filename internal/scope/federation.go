@@ -0,0 +1,231 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack"
+	"github.com/gophercloud/utils/v2/openstack/clientconfig"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+)
+
+// +kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=serviceaccounts/token,verbs=create
+
+// AuthV3OIDCAccessToken is the auth type of a cloud which authenticates by
+// exchanging a Kubernetes ServiceAccount token, as an OIDC access token, for a
+// Keystone token using identity federation.
+const AuthV3OIDCAccessToken clientconfig.AuthType = "v3oidcaccesstoken"
+
+// serviceAccountTokenExpirationSeconds is the requested lifetime of a
+// ServiceAccount token. The token is only used once, to obtain an unscoped
+// Keystone token, so it does not need to be long-lived.
+const serviceAccountTokenExpirationSeconds = 600
+
+// kubernetesAPIAudiences are the default audiences of the Kubernetes API
+// server. A token with one of these audiences could be used to authenticate
+// to the API server as the ServiceAccount, so ORC will not send it to an
+// identity provider.
+var kubernetesAPIAudiences = []string{
+	"api",
+	"kubernetes",
+	"kubernetes.default.svc",
+	"https://kubernetes.default.svc",
+	"https://kubernetes.default.svc.cluster.local",
+}
+
+// TokenExchange describes how to obtain a Keystone token by federating a
+// Kubernetes ServiceAccount token.
+type TokenExchange struct {
+	// IdentityProvider is the name of the Keystone identity provider which
+	// trusts the Kubernetes ServiceAccount token issuer.
+	IdentityProvider string
+
+	// Protocol is the name of the Keystone federation protocol of the
+	// identity provider, typically openid.
+	Protocol string
+
+	// ServiceAccount is the ServiceAccount whose token is exchanged.
+	ServiceAccount types.NamespacedName
+
+	// Audience is the audience of the requested ServiceAccount token.
+	Audience string
+
+	// k8sClient is used to fetch the ServiceAccount and request its tokens.
+	// It is not part of the identity of the TokenExchange.
+	k8sClient client.Client
+}
+
+// cacheKey returns the values which identify the TokenExchange.
+func (e *TokenExchange) cacheKey() any {
+	if e == nil {
+		return nil
+	}
+	key := *e
+	key.k8sClient = nil
+	return key
+}
+
+// getTokenExchangeFromSecret returns the TokenExchange configured by a cloud
+// credentials secret, or nil if the cloud does not authenticate with a
+// ServiceAccount token.
+func getTokenExchangeFromSecret(secret *corev1.Secret, cloud clientconfig.Cloud, k8sClient client.Client) (*TokenExchange, error) {
+	if cloud.AuthType != AuthV3OIDCAccessToken {
+		return nil, nil
+	}
+
+	exchange := &TokenExchange{
+		IdentityProvider: string(secret.Data[orcv1alpha1.CloudCredentialsIdentityProviderSecretKey]),
+		Protocol:         string(secret.Data[orcv1alpha1.CloudCredentialsProtocolSecretKey]),
+		ServiceAccount: types.NamespacedName{
+			Namespace: secret.Namespace,
+			Name:      string(secret.Data[orcv1alpha1.CloudCredentialsServiceAccountSecretKey]),
+		},
+		Audience:  string(secret.Data[orcv1alpha1.CloudCredentialsAudienceSecretKey]),
+		k8sClient: k8sClient,
+	}
+
+	for key, value := range map[string]string{
+		orcv1alpha1.CloudCredentialsIdentityProviderSecretKey: exchange.IdentityProvider,
+		orcv1alpha1.CloudCredentialsProtocolSecretKey:         exchange.Protocol,
+		orcv1alpha1.CloudCredentialsServiceAccountSecretKey:   exchange.ServiceAccount.Name,
+		orcv1alpha1.CloudCredentialsAudienceSecretKey:         exchange.Audience,
+	} {
+		if value == "" {
+			return nil, fmt.Errorf("OpenStack credentials secret %v has auth type %v but did not contain key %v",
+				secret.Name, AuthV3OIDCAccessToken, key)
+		}
+	}
+
+	if slices.Contains(kubernetesAPIAudiences, strings.TrimSuffix(exchange.Audience, "/")) {
+		return nil, fmt.Errorf("OpenStack credentials secret %v: %v must not be an audience of the Kubernetes API server",
+			secret.Name, orcv1alpha1.CloudCredentialsAudienceSecretKey)
+	}
+
+	return exchange, nil
+}
+
+// requestServiceAccountToken returns a new token for the ServiceAccount. The
+// ServiceAccount must allow tokens to be requested for the audience with the
+// token exchange audience annotation.
+func (e *TokenExchange) requestServiceAccountToken(ctx context.Context) (string, error) {
+	serviceAccount := &corev1.ServiceAccount{}
+	if err := e.k8sClient.Get(ctx, e.ServiceAccount, serviceAccount); err != nil {
+		return "", fmt.Errorf("fetching ServiceAccount %s: %w", e.ServiceAccount, err)
+	}
+	if serviceAccount.Annotations[orcv1alpha1.ServiceAccountTokenExchangeAudienceAnnotation] != e.Audience {
+		return "", fmt.Errorf("ServiceAccount %s does not allow token exchange with audience %s: it must have annotation %s=%s",
+			e.ServiceAccount, e.Audience, orcv1alpha1.ServiceAccountTokenExchangeAudienceAnnotation, e.Audience)
+	}
+
+	tokenRequest := &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			Audiences:         []string{e.Audience},
+			ExpirationSeconds: ptr.To[int64](serviceAccountTokenExpirationSeconds),
+		},
+	}
+
+	if err := e.k8sClient.SubResource("token").Create(ctx, serviceAccount, tokenRequest); err != nil {
+		return "", fmt.Errorf("requesting token for ServiceAccount %s: %w", e.ServiceAccount, err)
+	}
+	return tokenRequest.Status.Token, nil
+}
+
+// getUnscopedToken exchanges a ServiceAccount token for an unscoped Keystone
+// token using the OS-FEDERATION API of the identity service.
+func (e *TokenExchange) getUnscopedToken(ctx context.Context, provider *gophercloud.ProviderClient) (string, error) {
+	accessToken, err := e.requestServiceAccountToken(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	identityClient, err := openstack.NewIdentityV3(provider, gophercloud.EndpointOpts{})
+	if err != nil {
+		return "", err
+	}
+
+	url := identityClient.ServiceURL("OS-FEDERATION", "identity_providers", e.IdentityProvider, "protocols", e.Protocol, "auth")
+	resp, err := identityClient.Post(ctx, url, nil, nil, &gophercloud.RequestOpts{
+		MoreHeaders: map[string]string{"Authorization": "Bearer " + accessToken},
+		OmitHeaders: []string{"X-Auth-Token"},
+		OkCodes:     []int{http.StatusOK, http.StatusCreated},
+	})
+	if err != nil {
+		return "", fmt.Errorf("exchanging token of ServiceAccount %s with identity provider %s: %w", e.ServiceAccount, e.IdentityProvider, err)
+	}
+	defer resp.Body.Close()
+
+	token := resp.Header.Get("X-Subject-Token")
+	if token == "" {
+		return "", fmt.Errorf("identity provider %s did not return a token", e.IdentityProvider)
+	}
+	return token, nil
+}
+
+// authenticate authenticates provider with a Keystone token scoped as
+// described by opts, obtained by exchanging a ServiceAccount token.
+func (e *TokenExchange) authenticate(ctx context.Context, provider *gophercloud.ProviderClient, opts gophercloud.AuthOptions) error {
+	unscopedToken, err := e.getUnscopedToken(ctx, provider)
+	if err != nil {
+		return err
+	}
+
+	opts.TokenID = unscopedToken
+	opts.AllowReauth = false
+	return openstack.Authenticate(ctx, provider, opts)
+}
+
+// authenticateWithTokenExchange authenticates provider using exchange, and
+// configures it to reauthenticate with a new ServiceAccount token when its
+// Keystone token expires. The unscoped token obtained from the exchange cannot
+// be used for reauthentication because it may itself have expired.
+func authenticateWithTokenExchange(ctx context.Context, provider *gophercloud.ProviderClient, exchange *TokenExchange, opts gophercloud.AuthOptions) error {
+	if err := exchange.authenticate(ctx, provider, opts); err != nil {
+		return err
+	}
+
+	provider.ReauthFunc = func(ctx context.Context) error {
+		// As gophercloud, we reauthenticate with a throwaway client which
+		// will not itself attempt to reauthenticate
+		throwaway, err := openstack.NewClient(opts.IdentityEndpoint)
+		if err != nil {
+			return err
+		}
+		throwaway.HTTPClient = provider.HTTPClient
+		throwaway.UserAgent = provider.UserAgent
+		throwaway.SetThrowaway(true)
+
+		if err := exchange.authenticate(ctx, throwaway, opts); err != nil {
+			return err
+		}
+		provider.CopyTokenFrom(throwaway)
+		return nil
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/v2/openstack"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+)
+
+func TestGetTokenExchangeFromSecret(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		data    map[string]string
+		want    *TokenExchange
+		wantErr bool
+	}{
+		{
+			name: "password",
+			data: map[string]string{
+				orcv1alpha1.CloudCredentialsAuthURLSecretKey:  "https://keystone.example.com/v3",
+				orcv1alpha1.CloudCredentialsUsernameSecretKey: "admin",
+				orcv1alpha1.CloudCredentialsPasswordSecretKey: "password",
+			},
+		},
+		{
+			name: "token exchange",
+			data: map[string]string{
+				orcv1alpha1.CloudCredentialsAuthURLSecretKey:          "https://keystone.example.com/v3",
+				orcv1alpha1.CloudCredentialsAuthTypeSecretKey:         string(AuthV3OIDCAccessToken),
+				orcv1alpha1.CloudCredentialsIdentityProviderSecretKey: "kubernetes",
+				orcv1alpha1.CloudCredentialsProtocolSecretKey:         "openid",
+				orcv1alpha1.CloudCredentialsServiceAccountSecretKey:   "orc",
+				orcv1alpha1.CloudCredentialsAudienceSecretKey:         "openstack",
+				orcv1alpha1.CloudCredentialsProjectNameSecretKey:      "my-project",
+			},
+			want: &TokenExchange{
+				IdentityProvider: "kubernetes",
+				Protocol:         "openid",
+				ServiceAccount:   types.NamespacedName{Namespace: "default", Name: "orc"},
+				Audience:         "openstack",
+			},
+		},
+		{
+			name: "token exchange without audience",
+			data: map[string]string{
+				orcv1alpha1.CloudCredentialsAuthURLSecretKey:          "https://keystone.example.com/v3",
+				orcv1alpha1.CloudCredentialsAuthTypeSecretKey:         string(AuthV3OIDCAccessToken),
+				orcv1alpha1.CloudCredentialsIdentityProviderSecretKey: "kubernetes",
+				orcv1alpha1.CloudCredentialsProtocolSecretKey:         "openid",
+				orcv1alpha1.CloudCredentialsServiceAccountSecretKey:   "orc",
+			},
+			wantErr: true,
+		},
+		{
+			name: "token exchange with Kubernetes API server audience",
+			data: map[string]string{
+				orcv1alpha1.CloudCredentialsAuthURLSecretKey:          "https://keystone.example.com/v3",
+				orcv1alpha1.CloudCredentialsAuthTypeSecretKey:         string(AuthV3OIDCAccessToken),
+				orcv1alpha1.CloudCredentialsIdentityProviderSecretKey: "kubernetes",
+				orcv1alpha1.CloudCredentialsProtocolSecretKey:         "openid",
+				orcv1alpha1.CloudCredentialsServiceAccountSecretKey:   "orc",
+				orcv1alpha1.CloudCredentialsAudienceSecretKey:         "https://kubernetes.default.svc.cluster.local/",
+			},
+			wantErr: true,
+		},
+		{
+			name: "token exchange without service account",
+			data: map[string]string{
+				orcv1alpha1.CloudCredentialsAuthURLSecretKey:          "https://keystone.example.com/v3",
+				orcv1alpha1.CloudCredentialsAuthTypeSecretKey:         string(AuthV3OIDCAccessToken),
+				orcv1alpha1.CloudCredentialsIdentityProviderSecretKey: "kubernetes",
+				orcv1alpha1.CloudCredentialsProtocolSecretKey:         "openid",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: "default"},
+				Data:       make(map[string][]byte, len(tc.data)),
+			}
+			for k, v := range tc.data {
+				secret.Data[k] = []byte(v)
+			}
+
+			cloud, err := CloudFromSecret(secret, "")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got, err := getTokenExchangeFromSecret(secret, cloud, nil)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if (got == nil) != (tc.want == nil) || (got != nil && got.cacheKey() != tc.want.cacheKey()) {
+				t.Errorf("got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTokenExchangeGetUnscopedToken(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/v3/OS-FEDERATION/identity_providers/kubernetes/protocols/openid/auth" {
+			http.NotFound(w, r)
+			return
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer fake-token" {
+			t.Errorf("got Authorization header %q", got)
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("X-Subject-Token", "unscoped-token")
+		w.WriteHeader(http.StatusCreated)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		wantErr     bool
+	}{
+		{
+			name:        "service account allows audience",
+			annotations: map[string]string{orcv1alpha1.ServiceAccountTokenExchangeAudienceAnnotation: "openstack"},
+		},
+		{
+			name:    "service account without annotation",
+			wantErr: true,
+		},
+		{
+			name:        "service account allows other audience",
+			annotations: map[string]string{orcv1alpha1.ServiceAccountTokenExchangeAudienceAnnotation: "other"},
+			wantErr:     true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			provider, err := openstack.NewClient(server.URL + "/v3")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			serviceAccount := &corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{
+				Name:        "orc",
+				Namespace:   "default",
+				Annotations: tc.annotations,
+			}}
+			exchange := &TokenExchange{
+				IdentityProvider: "kubernetes",
+				Protocol:         "openid",
+				ServiceAccount:   types.NamespacedName{Namespace: "default", Name: "orc"},
+				Audience:         "openstack",
+				k8sClient:        fake.NewClientBuilder().WithObjects(serviceAccount).Build(),
+			}
+
+			token, err := exchange.getUnscopedToken(context.Background(), provider)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got token %q", token)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if token != "unscoped-token" {
+				t.Errorf("got token %q, want %q", token, "unscoped-token")
+			}
+		})
+	}
+}
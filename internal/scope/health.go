@@ -0,0 +1,218 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/tokens"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+)
+
+// credentialsFailureCacheExpiry is how long a failure to authenticate is
+// cached. Until it expires, objects using the same credentials return the
+// cached failure instead of each attempting to authenticate again.
+const credentialsFailureCacheExpiry = time.Minute
+
+// CredentialsStatus is the result of authenticating with a cloud in a
+// credentials secret. ORC publishes it in the
+// CloudCredentialsStatusAnnotation of the secret.
+type CredentialsStatus struct {
+	// Authenticated is true if authentication succeeded.
+	Authenticated bool `json:"authenticated"`
+
+	// Error is the reason authentication failed.
+	Error string `json:"error,omitempty"`
+
+	// User and Project are the authenticated user and the project of its
+	// token, qualified by the name of their domain.
+	User    string `json:"user,omitempty"`
+	Project string `json:"project,omitempty"`
+
+	// Roles are the names of the roles of the token.
+	Roles []string `json:"roles,omitempty"`
+
+	// ExpiresAt is the expiry time of the token.
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// Services are the types of the services in the service catalog.
+	Services []string `json:"services,omitempty"`
+
+	// LastChecked is the time of the authentication.
+	LastChecked metav1.Time `json:"lastChecked"`
+}
+
+// getCredentialsStatus returns the CredentialsStatus of an attempt to
+// authenticate, which either returned authResult or failed with authErr.
+func getCredentialsStatus(authResult gophercloud.AuthResult, authErr error, now time.Time) CredentialsStatus {
+	status := CredentialsStatus{LastChecked: metav1.NewTime(now)}
+	if authErr != nil {
+		status.Error = authErr.Error()
+		return status
+	}
+	status.Authenticated = true
+
+	result, ok := authResult.(interface {
+		ExtractUser() (*tokens.User, error)
+		ExtractProject() (*tokens.Project, error)
+		ExtractRoles() ([]tokens.Role, error)
+		ExtractToken() (*tokens.Token, error)
+		ExtractServiceCatalog() (*tokens.ServiceCatalog, error)
+	})
+	if !ok {
+		return status
+	}
+
+	if user, err := result.ExtractUser(); err == nil && user != nil {
+		status.User = qualifiedName(user.Domain, user.Name)
+	}
+	if project, err := result.ExtractProject(); err == nil && project != nil {
+		status.Project = qualifiedName(project.Domain, project.Name)
+	}
+	if roles, err := result.ExtractRoles(); err == nil {
+		for i := range roles {
+			status.Roles = append(status.Roles, roles[i].Name)
+		}
+		slices.Sort(status.Roles)
+	}
+	if token, err := result.ExtractToken(); err == nil && token != nil && !token.ExpiresAt.IsZero() {
+		status.ExpiresAt = &metav1.Time{Time: token.ExpiresAt}
+	}
+	if catalog, err := result.ExtractServiceCatalog(); err == nil && catalog != nil {
+		for i := range catalog.Entries {
+			status.Services = append(status.Services, catalog.Entries[i].Type)
+		}
+		slices.Sort(status.Services)
+		status.Services = slices.Compact(status.Services)
+	}
+	return status
+}
+
+func qualifiedName(domain tokens.Domain, name string) string {
+	if domain.Name == "" {
+		return name
+	}
+	return domain.Name + "/" + name
+}
+
+// needsPublish returns true if status should replace the published status
+// previous. To avoid updating the secret every time a scope is created, a
+// status is only published if it differs from the previous status in more
+// than its timestamps, or if the previously published token has expired.
+func (status *CredentialsStatus) needsPublish(previous *CredentialsStatus) bool {
+	if previous == nil {
+		return true
+	}
+	if previous.ExpiresAt != nil && !previous.ExpiresAt.After(status.LastChecked.Time) {
+		return true
+	}
+
+	return status.Authenticated != previous.Authenticated ||
+		status.Error != previous.Error ||
+		status.User != previous.User ||
+		status.Project != previous.Project ||
+		!slices.Equal(status.Roles, previous.Roles) ||
+		!slices.Equal(status.Services, previous.Services)
+}
+
+// publishCredentialsStatus publishes the status of the cloud used by
+// credentials in the CloudCredentialsStatusAnnotation of the credentials
+// secret. Failure to publish is logged, but is not returned: it must not
+// prevent the use of valid credentials.
+func publishCredentialsStatus(ctx context.Context, k8sClient client.Client, logger logr.Logger, credentials *cloudCredentials, status CredentialsStatus) {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		secret := &corev1.Secret{}
+		if err := k8sClient.Get(ctx, credentials.secret, secret); err != nil {
+			return err
+		}
+
+		// An unparseable annotation is overwritten
+		statuses, _ := GetCredentialsStatuses(secret)
+		if statuses == nil {
+			statuses = make(map[string]CredentialsStatus)
+		}
+
+		var previous *CredentialsStatus
+		if p, ok := statuses[credentials.cloudName]; ok {
+			previous = &p
+		}
+		if !status.needsPublish(previous) {
+			return nil
+		}
+		statuses[credentials.cloudName] = status
+
+		value, err := json.Marshal(statuses)
+		if err != nil {
+			return err
+		}
+
+		// The optimistic lock ensures we don't overwrite a status
+		// published concurrently for a different cloud in the same
+		// secret
+		patch := client.MergeFromWithOptions(secret.DeepCopy(), client.MergeFromWithOptimisticLock{})
+		if secret.Annotations == nil {
+			secret.Annotations = make(map[string]string)
+		}
+		secret.Annotations[orcv1alpha1.CloudCredentialsStatusAnnotation] = string(value)
+		return k8sClient.Patch(ctx, secret, patch)
+	})
+	if err != nil {
+		logger.V(3).Info("Unable to publish credentials status", "secret", credentials.secret, "err", err)
+	}
+}
+
+// GetCredentialsStatuses returns the statuses published in the
+// CloudCredentialsStatusAnnotation of a credentials secret, indexed by cloud
+// name. The cloud name of a secret which does not contain a clouds.yaml file is
+// the empty string. It returns nil if no status has been published.
+func GetCredentialsStatuses(secret *corev1.Secret) (map[string]CredentialsStatus, error) {
+	value, ok := secret.Annotations[orcv1alpha1.CloudCredentialsStatusAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	var statuses map[string]CredentialsStatus
+	if err := json.Unmarshal([]byte(value), &statuses); err != nil {
+		return nil, fmt.Errorf("parsing annotation %s: %w", orcv1alpha1.CloudCredentialsStatusAnnotation, err)
+	}
+	return statuses, nil
+}
+
+// cachedAuthenticationError is returned instead of authenticating again while
+// a failure to authenticate with the same credentials is cached.
+type cachedAuthenticationError struct {
+	err error
+}
+
+func (e *cachedAuthenticationError) Error() string {
+	return fmt.Sprintf("credentials recently failed to authenticate: %v", e.err)
+}
+
+func (e *cachedAuthenticationError) Unwrap() error {
+	return e.err
+}
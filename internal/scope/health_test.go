@@ -0,0 +1,177 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud/v2/openstack/identity/v3/tokens"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testAuthResult = `{
+	"token": {
+		"expires_at": "2025-01-01T12:00:00.000000Z",
+		"user": {"id": "user-id", "name": "orc", "domain": {"id": "default", "name": "Default"}},
+		"project": {"id": "project-id", "name": "demo", "domain": {"id": "default", "name": "Default"}},
+		"roles": [{"id": "2", "name": "member"}, {"id": "1", "name": "reader"}],
+		"catalog": [
+			{"type": "network", "name": "neutron", "endpoints": []},
+			{"type": "identity", "name": "keystone", "endpoints": []},
+			{"type": "network", "name": "neutron2", "endpoints": []}
+		]
+	}
+}`
+
+func TestGetCredentialsStatus(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC)
+
+	result := tokens.CreateResult{}
+	if err := json.Unmarshal([]byte(testAuthResult), &result.Body); err != nil {
+		t.Fatal(err)
+	}
+
+	status := getCredentialsStatus(result, nil, now)
+	if !status.Authenticated || status.Error != "" {
+		t.Errorf("expected authenticated status, got %+v", status)
+	}
+	if status.User != "Default/orc" || status.Project != "Default/demo" {
+		t.Errorf("unexpected user or project: %+v", status)
+	}
+	if !slices.Equal(status.Roles, []string{"member", "reader"}) {
+		t.Errorf("unexpected roles: %v", status.Roles)
+	}
+	if !slices.Equal(status.Services, []string{"identity", "network"}) {
+		t.Errorf("unexpected services: %v", status.Services)
+	}
+	if status.ExpiresAt == nil || !status.ExpiresAt.Equal(&metav1.Time{Time: now.Add(time.Hour)}) {
+		t.Errorf("unexpected expiry: %v", status.ExpiresAt)
+	}
+
+	status = getCredentialsStatus(nil, errors.New("unauthorized"), now)
+	if status.Authenticated || status.Error != "unauthorized" {
+		t.Errorf("expected failed status, got %+v", status)
+	}
+}
+
+func TestCredentialsStatusNeedsPublish(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC)
+	published := &CredentialsStatus{
+		Authenticated: true,
+		User:          "orc",
+		ExpiresAt:     &metav1.Time{Time: now.Add(time.Hour)},
+		LastChecked:   metav1.NewTime(now.Add(-time.Hour)),
+	}
+
+	tests := []struct {
+		name   string
+		status CredentialsStatus
+		want   bool
+	}{
+		{
+			name: "new token",
+			status: CredentialsStatus{
+				Authenticated: true,
+				User:          "orc",
+				ExpiresAt:     &metav1.Time{Time: now.Add(2 * time.Hour)},
+				LastChecked:   metav1.NewTime(now),
+			},
+			want: false,
+		},
+		{
+			name: "previous token expired",
+			status: CredentialsStatus{
+				Authenticated: true,
+				User:          "orc",
+				ExpiresAt:     &metav1.Time{Time: now.Add(3 * time.Hour)},
+				LastChecked:   metav1.NewTime(now.Add(2 * time.Hour)),
+			},
+			want: true,
+		},
+		{
+			name: "authentication failed",
+			status: CredentialsStatus{
+				Error:       "unauthorized",
+				LastChecked: metav1.NewTime(now),
+			},
+			want: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tc.status.needsPublish(published); got != tc.want {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+
+	if !published.needsPublish(nil) {
+		t.Error("expected a status to be published if there is no previous status")
+	}
+}
+
+func TestPublishCredentialsStatus(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	secretName := types.NamespacedName{Namespace: "default", Name: "credentials"}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(&corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: secretName.Namespace, Name: secretName.Name},
+	}).Build()
+
+	now := metav1.NewTime(time.Date(2025, 1, 1, 11, 0, 0, 0, time.UTC))
+	ctx := context.Background()
+	publishCredentialsStatus(ctx, k8sClient, logr.Discard(), &cloudCredentials{secret: secretName, cloudName: "a"},
+		CredentialsStatus{Authenticated: true, User: "orc", LastChecked: now})
+	publishCredentialsStatus(ctx, k8sClient, logr.Discard(), &cloudCredentials{secret: secretName, cloudName: "b"},
+		CredentialsStatus{Error: "unauthorized", LastChecked: now})
+
+	secret := &corev1.Secret{}
+	if err := k8sClient.Get(ctx, secretName, secret); err != nil {
+		t.Fatal(err)
+	}
+	statuses, err := GetCredentialsStatuses(secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statuses) != 2 || !statuses["a"].Authenticated || statuses["a"].User != "orc" ||
+		statuses["b"].Authenticated || statuses["b"].Error != "unauthorized" {
+		t.Errorf("unexpected statuses: %+v", statuses)
+	}
+}
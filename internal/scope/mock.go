@@ -36,6 +36,7 @@ import (
 type MockScopeFactory struct {
 	AddressScope                *mock.MockAddressScopeClient
 	ApplicationCredentialClient *mock.MockApplicationCredentialClient
+	CapabilitiesClient          *mock.MockCapabilitiesClient
 	ComputeClient               *mock.MockComputeClient
 	DomainClient                *mock.MockDomainClient
 	EndpointClient              *mock.MockEndpointClient
@@ -49,15 +50,21 @@ type MockScopeFactory struct {
 	ServiceClient               *mock.MockServiceClient
 	UserClient                  *mock.MockUserClient
 	VolumeClient                *mock.MockVolumeClient
+	VolumeBackupClient          *mock.MockVolumeBackupClient
+	VolumeSnapshotClient        *mock.MockVolumeSnapshotClient
 	VolumeTypeClient            *mock.MockVolumeTypeClient
 	ShareNetworkClient          *mock.MockShareNetworkClient
 
+	// ProjectID is the project of the mock scope
+	ProjectID string
+
 	clientScopeCreateError error
 }
 
 func NewMockScopeFactory(mockCtrl *gomock.Controller) *MockScopeFactory {
 	addressScope := mock.NewMockAddressScopeClient(mockCtrl)
 	applicationcredentialClient := mock.NewMockApplicationCredentialClient(mockCtrl)
+	capabilitiesClient := mock.NewMockCapabilitiesClient(mockCtrl)
 	computeClient := mock.NewMockComputeClient(mockCtrl)
 	domainClient := mock.NewMockDomainClient(mockCtrl)
 	endpointClient := mock.NewMockEndpointClient(mockCtrl)
@@ -72,11 +79,14 @@ func NewMockScopeFactory(mockCtrl *gomock.Controller) *MockScopeFactory {
 	userClient := mock.NewMockUserClient(mockCtrl)
 	sharenetworkClient := mock.NewMockShareNetworkClient(mockCtrl)
 	volumeClient := mock.NewMockVolumeClient(mockCtrl)
+	volumebackupClient := mock.NewMockVolumeBackupClient(mockCtrl)
+	volumesnapshotClient := mock.NewMockVolumeSnapshotClient(mockCtrl)
 	volumetypeClient := mock.NewMockVolumeTypeClient(mockCtrl)
 
 	return &MockScopeFactory{
 		AddressScope:                addressScope,
 		ApplicationCredentialClient: applicationcredentialClient,
+		CapabilitiesClient:          capabilitiesClient,
 		ComputeClient:               computeClient,
 		DomainClient:                domainClient,
 		EndpointClient:              endpointClient,
@@ -91,6 +101,8 @@ func NewMockScopeFactory(mockCtrl *gomock.Controller) *MockScopeFactory {
 		ShareNetworkClient:          sharenetworkClient,
 		UserClient:                  userClient,
 		VolumeClient:                volumeClient,
+		VolumeBackupClient:          volumebackupClient,
+		VolumeSnapshotClient:        volumesnapshotClient,
 		VolumeTypeClient:            volumetypeClient,
 	}
 }
@@ -110,6 +122,10 @@ func (f *MockScopeFactory) NewAddressScopeClient() (osclients.AddressScopeClient
 	return f.AddressScope, nil
 }
 
+func (f *MockScopeFactory) NewCapabilitiesClient() (osclients.CapabilitiesClient, error) {
+	return f.CapabilitiesClient, nil
+}
+
 func (f *MockScopeFactory) NewComputeClient() (osclients.ComputeClient, error) {
 	return f.ComputeClient, nil
 }
@@ -134,6 +150,14 @@ func (f *MockScopeFactory) NewVolumeClient() (osclients.VolumeClient, error) {
 	return f.VolumeClient, nil
 }
 
+func (f *MockScopeFactory) NewVolumeBackupClient() (osclients.VolumeBackupClient, error) {
+	return f.VolumeBackupClient, nil
+}
+
+func (f *MockScopeFactory) NewVolumeSnapshotClient() (osclients.VolumeSnapshotClient, error) {
+	return f.VolumeSnapshotClient, nil
+}
+
 func (f *MockScopeFactory) NewVolumeTypeClient() (osclients.VolumeTypeClient, error) {
 	return f.VolumeTypeClient, nil
 }
@@ -177,3 +201,11 @@ func (f *MockScopeFactory) NewApplicationCredentialClient() (osclients.Applicati
 func (f *MockScopeFactory) ExtractToken() (*tokens.Token, error) {
 	return &tokens.Token{ExpiresAt: time.Now().Add(24 * time.Hour)}, nil
 }
+
+func (f *MockScopeFactory) GetProjectID() string {
+	return f.ProjectID
+}
+
+func (f *MockScopeFactory) GetProjectKey() string {
+	return f.ProjectID
+}
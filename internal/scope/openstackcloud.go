@@ -0,0 +1,226 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/gophercloud/utils/v2/openstack/clientconfig"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
+)
+
+// +kubebuilder:rbac:groups=openstack.k-orc.cloud,resources=openstackclouds,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=namespaces,verbs=get;list;watch
+
+// cloudCredentials identifies the credentials used by an object, and any
+// overrides of the values in its credentials secret.
+type cloudCredentials struct {
+	secret    types.NamespacedName
+	cloudName string
+
+	// regionName is empty unless overridden by the cloudCredentialsRef or
+	// an OpenStackCloud, and caCert is empty unless overridden by an
+	// OpenStackCloud
+	regionName string
+	caCert     []byte
+}
+
+// apply applies any overrides to a cloud and CA certificate obtained from the
+// credentials secret.
+func (c *cloudCredentials) apply(cloud clientconfig.Cloud, caCert []byte) (clientconfig.Cloud, []byte) {
+	if c.regionName != "" {
+		cloud.RegionName = c.regionName
+	}
+	if c.caCert != nil {
+		caCert = c.caCert
+	}
+	return cloud, caCert
+}
+
+// getCloudCredentials returns the credentials of the first object which has a
+// namespace. These are the credentials referenced by its cloudCredentialsRef
+// if specified, or otherwise the credentials of the OpenStackCloud which
+// allows its namespace.
+func getCloudCredentials(ctx context.Context, k8sClient client.Reader, objects ...orcv1alpha1.CloudCredentialsRefProvider) (*cloudCredentials, error) {
+	i := slices.IndexFunc(objects, func(o orcv1alpha1.CloudCredentialsRefProvider) bool {
+		namespace, _ := o.GetCloudCredentialsRef()
+		return namespace != nil
+	})
+	if i < 0 {
+		return nil, fmt.Errorf("unable to get credentials from provided objects")
+	}
+	namespace, credentialsRef := objects[i].GetCloudCredentialsRef()
+
+	if credentialsRef != nil {
+		return &cloudCredentials{
+			secret:     types.NamespacedName{Namespace: *namespace, Name: credentialsRef.SecretName},
+			cloudName:  credentialsRef.CloudName,
+			regionName: credentialsRef.RegionName,
+		}, nil
+	}
+
+	cloud, err := GetOpenStackCloudForObject(ctx, k8sClient, objects[i])
+	if err != nil {
+		return nil, err
+	}
+
+	credentials := &cloudCredentials{
+		secret: types.NamespacedName{
+			Namespace: cloud.Spec.SecretRef.Namespace,
+			Name:      string(cloud.Spec.SecretRef.Name),
+		},
+		cloudName:  cloud.Spec.CloudName,
+		regionName: cloud.Spec.RegionName,
+	}
+	if cloud.Spec.CACert != "" {
+		credentials.caCert = []byte(cloud.Spec.CACert)
+	}
+	return credentials, nil
+}
+
+// GetOpenStackCloudForObject returns the OpenStackCloud which allows the
+// namespace of obj. If obj records a different OpenStackCloud in its status
+// and already has an OpenStack resource, it returns a terminal error instead:
+// the resource was created or imported with the credentials of the recorded
+// OpenStackCloud, which may be a different cloud or project.
+func GetOpenStackCloudForObject(ctx context.Context, k8sClient client.Reader, obj orcv1alpha1.CloudCredentialsRefProvider) (*orcv1alpha1.OpenStackCloud, error) {
+	namespace, _ := obj.GetCloudCredentialsRef()
+	if namespace == nil {
+		return nil, fmt.Errorf("unable to get namespace of object")
+	}
+
+	cloud, err := GetOpenStackCloudForNamespace(ctx, k8sClient, *namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	if statusProvider, ok := obj.(orcv1alpha1.OpenStackCloudStatusProvider); ok {
+		recorded, hasResource := statusProvider.GetOpenStackCloudStatus()
+		if hasResource && recorded != nil && *recorded != cloud.Name {
+			return nil, orcerrors.Terminal(orcv1alpha1.ConditionReasonInvalidConfiguration,
+				fmt.Sprintf("namespace %s is now allowed by OpenStackCloud %s, but the OpenStack resource uses the credentials of OpenStackCloud %s", *namespace, cloud.Name, *recorded))
+		}
+	}
+	return cloud, nil
+}
+
+// GetOpenStackCloudForNamespace returns the OpenStackCloud which allows
+// namespace. It is an error if no OpenStackCloud, or more than one
+// OpenStackCloud, allows namespace.
+func GetOpenStackCloudForNamespace(ctx context.Context, k8sClient client.Reader, namespace string) (*orcv1alpha1.OpenStackCloud, error) {
+	cloudList := &orcv1alpha1.OpenStackCloudList{}
+	if err := k8sClient.List(ctx, cloudList); err != nil {
+		return nil, fmt.Errorf("listing OpenStackClouds: %w", err)
+	}
+
+	// Only fetch the namespace if we need its labels
+	var ns *corev1.Namespace
+	getNamespaceLabels := func() (labels.Set, error) {
+		if ns == nil {
+			ns = &corev1.Namespace{}
+			if err := k8sClient.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+				ns = nil
+				return nil, fmt.Errorf("fetching namespace %s: %w", namespace, err)
+			}
+		}
+		return labels.Set(ns.GetLabels()), nil
+	}
+
+	var matches []*orcv1alpha1.OpenStackCloud
+	for i := range cloudList.Items {
+		cloud := &cloudList.Items[i]
+		allowed, err := namespaceIsAllowed(cloud, namespace, getNamespaceLabels)
+		if err != nil {
+			return nil, err
+		}
+		if allowed {
+			matches = append(matches, cloud)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return nil, fmt.Errorf("cloudCredentialsRef is not specified and no OpenStackCloud allows namespace %s", namespace)
+	case 1:
+		return matches[0], nil
+	default:
+		names := make([]string, len(matches))
+		for i := range matches {
+			names[i] = matches[i].Name
+		}
+		slices.Sort(names)
+		return nil, fmt.Errorf("cloudCredentialsRef is not specified and multiple OpenStackClouds allow namespace %s: %v", namespace, names)
+	}
+}
+
+// OpenStackCloudAllowsNamespace returns true if the OpenStackCloud allows
+// namespace.
+func OpenStackCloudAllowsNamespace(cloud *orcv1alpha1.OpenStackCloud, namespace *corev1.Namespace) (bool, error) {
+	return namespaceIsAllowed(cloud, namespace.Name, func() (labels.Set, error) {
+		return labels.Set(namespace.GetLabels()), nil
+	})
+}
+
+// namespaceIsAllowed returns true if the OpenStackCloud allows namespace.
+func namespaceIsAllowed(cloud *orcv1alpha1.OpenStackCloud, namespace string, getNamespaceLabels func() (labels.Set, error)) (bool, error) {
+	allowed := &cloud.Spec.AllowedNamespaces
+	if slices.Contains(allowed.Names, namespace) {
+		return true, nil
+	}
+
+	if allowed.Selector == nil {
+		return false, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(allowed.Selector)
+	if err != nil {
+		return false, fmt.Errorf("OpenStackCloud %s has invalid namespace selector: %w", cloud.Name, err)
+	}
+	namespaceLabels, err := getNamespaceLabels()
+	if err != nil {
+		return false, err
+	}
+	return selector.Matches(namespaceLabels), nil
+}
+
+// CloudFromObject returns the cloud and CA certificate which are used to
+// create a scope for obj, including credentials inherited from an
+// OpenStackCloud. The CA certificate is nil if none is configured for the
+// cloud.
+func CloudFromObject(ctx context.Context, k8sClient client.Client, obj orcv1alpha1.CloudCredentialsRefProvider) (clientconfig.Cloud, []byte, error) {
+	credentials, err := getCloudCredentials(ctx, k8sClient, obj)
+	if err != nil {
+		return clientconfig.Cloud{}, nil, err
+	}
+
+	cloud, _, caCert, _, err := getCloudFromSecret(ctx, k8sClient, credentials.secret.Namespace, credentials.secret.Name, credentials.cloudName, RateLimit{})
+	if err != nil {
+		return clientconfig.Cloud{}, nil, err
+	}
+
+	cloud, caCert = credentials.apply(cloud, caCert)
+	return cloud, caCert, nil
+}
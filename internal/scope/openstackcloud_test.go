@@ -0,0 +1,156 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+)
+
+func TestGetCloudCredentials(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+	if err := orcv1alpha1.AddToScheme(scheme); err != nil {
+		t.Fatal(err)
+	}
+
+	namespaces := []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b", Labels: map[string]string{"cloud": "prod"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-c", Labels: map[string]string{"cloud": "prod"}}},
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other"}},
+	}
+	clouds := []client.Object{
+		&orcv1alpha1.OpenStackCloud{
+			ObjectMeta: metav1.ObjectMeta{Name: "dev"},
+			Spec: orcv1alpha1.OpenStackCloudSpec{
+				SecretRef: orcv1alpha1.OpenStackCloudSecretReference{Namespace: "orc-system", Name: "dev-credentials"},
+				CloudName: "dev",
+				AllowedNamespaces: orcv1alpha1.OpenStackCloudAllowedNamespaces{
+					Names: []string{"team-a", "team-c"},
+				},
+			},
+		},
+		&orcv1alpha1.OpenStackCloud{
+			ObjectMeta: metav1.ObjectMeta{Name: "prod"},
+			Spec: orcv1alpha1.OpenStackCloudSpec{
+				SecretRef:  orcv1alpha1.OpenStackCloudSecretReference{Namespace: "orc-system", Name: "prod-credentials"},
+				RegionName: "RegionTwo",
+				CACert:     "cert",
+				AllowedNamespaces: orcv1alpha1.OpenStackCloudAllowedNamespaces{
+					Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"cloud": "prod"}},
+				},
+			},
+		},
+	}
+	k8sClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(append(namespaces, clouds...)...).Build()
+
+	network := func(namespace string, credentialsRef *orcv1alpha1.CloudCredentialsReference) *orcv1alpha1.Network {
+		return &orcv1alpha1.Network{
+			ObjectMeta: metav1.ObjectMeta{Name: "network", Namespace: namespace},
+			Spec:       orcv1alpha1.NetworkSpec{CloudCredentialsRef: credentialsRef},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		obj     *orcv1alpha1.Network
+		want    *cloudCredentials
+		wantErr bool
+	}{
+		{
+			name: "cloudCredentialsRef",
+			obj:  network("team-a", &orcv1alpha1.CloudCredentialsReference{SecretName: "credentials", CloudName: "openstack"}),
+			want: &cloudCredentials{
+				secret:    types.NamespacedName{Namespace: "team-a", Name: "credentials"},
+				cloudName: "openstack",
+			},
+		},
+		{
+			name: "cloudCredentialsRef with region",
+			obj:  network("team-a", &orcv1alpha1.CloudCredentialsReference{SecretName: "credentials", CloudName: "openstack", RegionName: "RegionTwo"}),
+			want: &cloudCredentials{
+				secret:     types.NamespacedName{Namespace: "team-a", Name: "credentials"},
+				cloudName:  "openstack",
+				regionName: "RegionTwo",
+			},
+		},
+		{
+			name: "allowed by name",
+			obj:  network("team-a", nil),
+			want: &cloudCredentials{
+				secret:    types.NamespacedName{Namespace: "orc-system", Name: "dev-credentials"},
+				cloudName: "dev",
+			},
+		},
+		{
+			name: "allowed by selector",
+			obj:  network("team-b", nil),
+			want: &cloudCredentials{
+				secret:     types.NamespacedName{Namespace: "orc-system", Name: "prod-credentials"},
+				regionName: "RegionTwo",
+				caCert:     []byte("cert"),
+			},
+		},
+		{
+			name:    "allowed by multiple clouds",
+			obj:     network("team-c", nil),
+			wantErr: true,
+		},
+		{
+			name:    "not allowed",
+			obj:     network("other", nil),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := getCloudCredentials(context.Background(), k8sClient, tc.obj)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got.secret != tc.want.secret || got.cloudName != tc.want.cloudName ||
+				got.regionName != tc.want.regionName || string(got.caCert) != string(tc.want.caCert) {
+				t.Errorf("got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
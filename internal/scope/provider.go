@@ -22,6 +22,7 @@ import (
 	"crypto/x509"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -39,52 +40,88 @@ import (
 
 	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
 	clients "github.com/k-orc/openstack-resource-controller/v2/internal/osclients"
+	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/version"
 )
 
 type providerScopeFactory struct {
-	clientCache   *cache.LRUExpireCache
-	defaultCACert []byte
+	clientCache      *cache.LRUExpireCache
+	defaultCACert    []byte
+	defaultRateLimit RateLimit
+	rateLimiters     rateLimiterRegistry
+	secretScopes     secretScopeRegistry
+
+	// failureCache contains recent failures to authenticate. It is nil if
+	// clientCache is nil.
+	failureCache *cache.LRUExpireCache
 }
 
 func (f *providerScopeFactory) NewClientScopeFromObject(ctx context.Context, ctrlClient client.Client, logger logr.Logger, objects ...orcv1alpha1.CloudCredentialsRefProvider) (Scope, error) {
-	namespace, credentialsRef := func() (*string, *orcv1alpha1.CloudCredentialsReference) {
-		for _, o := range objects {
-			namespace, credentialsRef := o.GetCloudCredentialsRef()
-
-			if namespace != nil && credentialsRef != nil {
-				return namespace, credentialsRef
-			}
-		}
-		return nil, nil
-	}()
-
-	if namespace == nil || credentialsRef == nil {
-		return nil, fmt.Errorf("unable to get credentials from provided objects")
+	credentials, err := getCloudCredentials(ctx, ctrlClient, objects...)
+	if err != nil {
+		return nil, err
 	}
 
-	var cloud clientconfig.Cloud
-	var caCert []byte
-
-	var err error
-	cloud, caCert, err = getCloudFromSecret(ctx, ctrlClient, *namespace, credentialsRef.SecretName, credentialsRef.CloudName)
+	cloud, exchange, caCert, rateLimit, err := getCloudFromSecret(ctx, ctrlClient, credentials.secret.Namespace, credentials.secret.Name, credentials.cloudName, f.defaultRateLimit)
 	if err != nil {
 		return nil, err
 	}
+	cloud, caCert = credentials.apply(cloud, caCert)
 
 	if caCert == nil {
 		caCert = f.defaultCACert
 	}
 
+	// Requests made with the same credentials share a rate limiter
+	// regardless of whether their scope is cached
+	cloudKey, err := getScopeCacheKey(cloud, exchange.cacheKey())
+	if err != nil {
+		return nil, fmt.Errorf("compute cloud config cache key: %w", err)
+	}
+	limiter := f.rateLimiters.get(cloudKey, rateLimit)
+
+	// Publish the result of every authentication on the credentials secret
+	onAuthenticate := func(scope *providerScope, err error) {
+		var authResult gophercloud.AuthResult
+		if scope != nil {
+			authResult = scope.providerClient.GetAuthResult()
+		}
+		status := getCredentialsStatus(authResult, err, time.Now())
+		publishCredentialsStatus(ctx, ctrlClient, logger, credentials, status)
+	}
+
 	if f.clientCache == nil {
-		return NewProviderScope(cloud, caCert, logger)
+		scope, err := newProviderScope(cloud, exchange, caCert, limiter, logger)
+		onAuthenticate(scope, err)
+		if err != nil {
+			return nil, err
+		}
+		return scope, nil
+	}
+
+	key, err := getProviderScopeCacheKey(cloud, exchange, caCert, limiter)
+	if err != nil {
+		return nil, err
 	}
+	f.secretScopes.add(credentials.secret, key)
 
-	return NewCachedProviderScope(f.clientCache, cloud, caCert, logger)
+	// Don't attempt to authenticate again with credentials which were
+	// recently rejected
+	if cachedErr, found := f.failureCache.Get(key); found {
+		logger.V(6).Info("Using authentication failure from cache")
+		return nil, cachedErr.(error)
+	}
+
+	return newCachedProviderScope(f.clientCache, key, cloud, exchange, caCert, limiter, logger, func(scope *providerScope, err error) {
+		if orcerrors.IsAuthenticationError(err) {
+			f.failureCache.Add(key, &cachedAuthenticationError{err: err}, credentialsFailureCacheExpiry)
+		}
+		onAuthenticate(scope, err)
+	})
 }
 
-func getScopeCacheKey(cloud clientconfig.Cloud) (string, error) {
-	key, err := computeSpewHash(cloud)
+func getScopeCacheKey(values ...any) (string, error) {
+	key, err := computeSpewHash(values)
 	if err != nil {
 		return "", err
 	}
@@ -92,13 +129,35 @@ func getScopeCacheKey(cloud clientconfig.Cloud) (string, error) {
 	return fmt.Sprintf("%d", key), nil
 }
 
+// getProviderScopeCacheKey returns the key of a cached scope. The scope's
+// provider client uses the CA certificate and the limiter, so the scope must
+// not be reused if either changes.
+func getProviderScopeCacheKey(cloud clientconfig.Cloud, exchange *TokenExchange, caCert []byte, limiter *rateLimiter) (string, error) {
+	key, err := getScopeCacheKey(cloud, exchange.cacheKey(), caCert, limiter.getLimit())
+	if err != nil {
+		return "", fmt.Errorf("compute cloud config cache key: %w", err)
+	}
+	return key, nil
+}
+
 type providerScope struct {
 	providerClient     *gophercloud.ProviderClient
 	providerClientOpts *clientconfig.ClientOpts
+	roundTripper       *RoundTripper
+
+	// capabilities is shared by all users of the scope, so capabilities
+	// are only discovered once per scope
+	capabilities clients.CapabilitiesClient
 }
 
-func NewProviderScope(cloud clientconfig.Cloud, caCert []byte, logger logr.Logger) (Scope, error) {
-	providerClient, clientOpts, err := NewProviderClient(cloud, caCert, logger)
+// NewProviderScope returns a Scope authenticated to cloud. If exchange is not
+// nil, the scope authenticates by exchanging a ServiceAccount token.
+func NewProviderScope(cloud clientconfig.Cloud, exchange *TokenExchange, caCert []byte, limiter *rateLimiter, logger logr.Logger) (Scope, error) {
+	return newProviderScope(cloud, exchange, caCert, limiter, logger)
+}
+
+func newProviderScope(cloud clientconfig.Cloud, exchange *TokenExchange, caCert []byte, limiter *rateLimiter, logger logr.Logger) (*providerScope, error) {
+	providerClient, clientOpts, roundTripper, err := newProviderClient(cloud, exchange, caCert, limiter, logger)
 	if err != nil {
 		return nil, err
 	}
@@ -106,25 +165,38 @@ func NewProviderScope(cloud clientconfig.Cloud, caCert []byte, logger logr.Logge
 	return &providerScope{
 		providerClient:     providerClient,
 		providerClientOpts: clientOpts,
+		roundTripper:       roundTripper,
+		capabilities:       clients.NewCapabilitiesClient(providerClient, clientOpts),
 	}, nil
 }
 
-func NewCachedProviderScope(cache *cache.LRUExpireCache, cloud clientconfig.Cloud, caCert []byte, logger logr.Logger) (Scope, error) {
-	key, err := getScopeCacheKey(cloud)
+func NewCachedProviderScope(cache *cache.LRUExpireCache, cloud clientconfig.Cloud, exchange *TokenExchange, caCert []byte, limiter *rateLimiter, logger logr.Logger) (Scope, error) {
+	key, err := getProviderScopeCacheKey(cloud, exchange, caCert, limiter)
 	if err != nil {
-		return nil, fmt.Errorf("compute cloud config cache key: %w", err)
+		return nil, err
 	}
+	return newCachedProviderScope(cache, key, cloud, exchange, caCert, limiter, logger, nil)
+}
 
+// newCachedProviderScope returns the scope cached with key, or creates and
+// caches a new scope. If onAuthenticate is not nil, it is called with the
+// result of creating a new scope.
+func newCachedProviderScope(cache *cache.LRUExpireCache, key string, cloud clientconfig.Cloud, exchange *TokenExchange, caCert []byte, limiter *rateLimiter, logger logr.Logger, onAuthenticate func(*providerScope, error)) (Scope, error) {
 	if scope, found := cache.Get(key); found {
 		logger.V(6).Info("Using scope from cache")
 		return scope.(Scope), nil
 	}
 
-	scope, err := NewProviderScope(cloud, caCert, logger)
+	scope, err := newProviderScope(cloud, exchange, caCert, limiter, logger)
+	if onAuthenticate != nil {
+		onAuthenticate(scope, err)
+	}
 	if err != nil {
 		return nil, err
 	}
 
+	discardOnReauthenticationFailure(cache, key, scope, logger)
+
 	token, err := scope.ExtractToken()
 	if err != nil {
 		return nil, err
@@ -133,10 +205,30 @@ func NewCachedProviderScope(cache *cache.LRUExpireCache, cloud clientconfig.Clou
 	// compute the token expiration time
 	expiry := time.Until(token.ExpiresAt) / 2
 
-	cache.Add(key, scope, expiry)
+	addCachedScope(cache, key, scope, expiry)
 	return scope, nil
 }
 
+// discardOnReauthenticationFailure removes scope from the cache if it fails to
+// reauthenticate after OpenStack rejects its token, so that the next scope is
+// created by authenticating again with the current credentials. A scope which
+// reauthenticates successfully remains in the cache.
+func discardOnReauthenticationFailure(cache *cache.LRUExpireCache, key string, scope *providerScope, logger logr.Logger) {
+	reauthenticate := scope.providerClient.ReauthFunc
+	if reauthenticate == nil {
+		return
+	}
+
+	scope.providerClient.ReauthFunc = func(ctx context.Context) error {
+		err := reauthenticate(ctx)
+		if err != nil {
+			logger.V(4).Info("Discarding cached scope after failing to reauthenticate", "err", err)
+			removeCachedScope(cache, key, scope)
+		}
+		return err
+	}
+}
+
 func (s *providerScope) NewAddressScopeClient() (clients.AddressScopeClient, error) {
 	return clients.NewAddressScopeClient(s.providerClient, s.providerClientOpts)
 }
@@ -145,6 +237,10 @@ func (s *providerScope) NewApplicationCredentialClient() (clients.ApplicationCre
 	return clients.NewApplicationCredentialClient(s.providerClient, s.providerClientOpts)
 }
 
+func (s *providerScope) NewCapabilitiesClient() (clients.CapabilitiesClient, error) {
+	return s.capabilities, nil
+}
+
 func (s *providerScope) NewComputeClient() (clients.ComputeClient, error) {
 	return clients.NewComputeClient(s.providerClient, s.providerClientOpts)
 }
@@ -169,6 +265,14 @@ func (s *providerScope) NewVolumeClient() (clients.VolumeClient, error) {
 	return clients.NewVolumeClient(s.providerClient, s.providerClientOpts)
 }
 
+func (s *providerScope) NewVolumeBackupClient() (clients.VolumeBackupClient, error) {
+	return clients.NewVolumeBackupClient(s.providerClient, s.providerClientOpts)
+}
+
+func (s *providerScope) NewVolumeSnapshotClient() (clients.VolumeSnapshotClient, error) {
+	return clients.NewVolumeSnapshotClient(s.providerClient, s.providerClientOpts)
+}
+
 func (s *providerScope) NewVolumeTypeClient() (clients.VolumeTypeClient, error) {
 	return clients.NewVolumeTypeClient(s.providerClient, s.providerClientOpts)
 }
@@ -219,7 +323,34 @@ func (s *providerScope) ExtractToken() (*tokens.Token, error) {
 	return tokens.Get(context.TODO(), client, s.providerClient.Token()).ExtractToken()
 }
 
-func NewProviderClient(cloud clientconfig.Cloud, caCert []byte, logger logr.Logger) (*gophercloud.ProviderClient, *clientconfig.ClientOpts, error) {
+func (s *providerScope) GetProjectID() string {
+	result, ok := s.providerClient.GetAuthResult().(interface {
+		ExtractProject() (*tokens.Project, error)
+	})
+	if !ok {
+		return ""
+	}
+	project, err := result.ExtractProject()
+	if err != nil || project == nil {
+		return ""
+	}
+	return project.ID
+}
+
+func (s *providerScope) GetProjectKey() string {
+	projectID := s.GetProjectID()
+	if projectID == "" {
+		return ""
+	}
+	return strings.Join([]string{s.providerClient.IdentityBase, s.providerClientOpts.RegionName, projectID}, "|")
+}
+
+func NewProviderClient(cloud clientconfig.Cloud, exchange *TokenExchange, caCert []byte, limiter *rateLimiter, logger logr.Logger) (*gophercloud.ProviderClient, *clientconfig.ClientOpts, error) {
+	provider, clientOpts, _, err := newProviderClient(cloud, exchange, caCert, limiter, logger)
+	return provider, clientOpts, err
+}
+
+func newProviderClient(cloud clientconfig.Cloud, exchange *TokenExchange, caCert []byte, limiter *rateLimiter, logger logr.Logger) (*gophercloud.ProviderClient, *clientconfig.ClientOpts, *RoundTripper, error) {
 	clientOpts := new(clientconfig.ClientOpts)
 
 	// We explicitly disable reading auth data from env variables by setting an invalid EnvPrefix.
@@ -236,13 +367,13 @@ func NewProviderClient(cloud clientconfig.Cloud, caCert []byte, logger logr.Logg
 
 	opts, err := clientconfig.AuthOptions(clientOpts)
 	if err != nil {
-		return nil, nil, fmt.Errorf("auth option failed for cloud %v: %v", cloud.Cloud, err)
+		return nil, nil, nil, fmt.Errorf("auth option failed for cloud %v: %v", cloud.Cloud, err)
 	}
 	opts.AllowReauth = true
 
 	provider, err := openstack.NewClient(opts.IdentityEndpoint)
 	if err != nil {
-		return nil, nil, fmt.Errorf("create providerClient err: %v", err)
+		return nil, nil, nil, fmt.Errorf("create providerClient err: %v", err)
 	}
 
 	ua := gophercloud.UserAgent{}
@@ -264,21 +395,50 @@ func NewProviderClient(cloud clientconfig.Cloud, caCert []byte, logger logr.Logg
 		}
 	}
 
-	provider.HTTPClient.Transport = &RoundTripper{
+	roundTripper := &RoundTripper{
 		RoundTripper: &http.Transport{Proxy: http.ProxyFromEnvironment, TLSClientConfig: config},
+		limiter:      limiter,
 	}
+	roundTripper.SetServiceEndpoint(opts.IdentityEndpoint, "identity")
+	provider.HTTPClient.Transport = roundTripper
 	if klog.V(6).Enabled() {
 		provider.HTTPClient.Transport = &osclient.RoundTripper{
 			Rt:     provider.HTTPClient.Transport,
 			Logger: &gophercloudLogger{logger},
 		}
 	}
-	err = openstack.Authenticate(context.TODO(), provider, *opts)
+	if exchange != nil {
+		err = authenticateWithTokenExchange(context.TODO(), provider, exchange, *opts)
+	} else {
+		err = openstack.Authenticate(context.TODO(), provider, *opts)
+	}
 	if err != nil {
-		return nil, nil, fmt.Errorf("providerClient authentication err: %v", err)
+		return nil, nil, nil, fmt.Errorf("providerClient authentication err: %w", err)
 	}
+	setServiceEndpointsFromCatalog(roundTripper, provider.GetAuthResult())
 
-	return provider, clientOpts, nil
+	return provider, clientOpts, roundTripper, nil
+}
+
+// setServiceEndpointsFromCatalog labels requests to every endpoint in the
+// service catalog of an identity v3 authentication result with the type of
+// the service.
+func setServiceEndpointsFromCatalog(roundTripper *RoundTripper, authResult gophercloud.AuthResult) {
+	result, ok := authResult.(interface {
+		ExtractServiceCatalog() (*tokens.ServiceCatalog, error)
+	})
+	if !ok {
+		return
+	}
+	catalog, err := result.ExtractServiceCatalog()
+	if err != nil {
+		return
+	}
+	for _, entry := range catalog.Entries {
+		for _, endpoint := range entry.Endpoints {
+			roundTripper.SetServiceEndpoint(endpoint.URL, entry.Type)
+		}
+	}
 }
 
 type gophercloudLogger struct {
@@ -291,15 +451,13 @@ func (g gophercloudLogger) Printf(format string, args ...interface{}) {
 }
 
 // getCloudFromSecret extract a Cloud from the given namespace:secretName.
-func getCloudFromSecret(ctx context.Context, ctrlClient client.Client, secretNamespace string, secretName string, cloudName string) (clientconfig.Cloud, []byte, error) {
+// The returned RateLimit is defaultRateLimit overridden by any rate limit
+// annotations on the secret.
+func getCloudFromSecret(ctx context.Context, ctrlClient client.Client, secretNamespace string, secretName string, cloudName string, defaultRateLimit RateLimit) (clientconfig.Cloud, *TokenExchange, []byte, RateLimit, error) {
 	emptyCloud := clientconfig.Cloud{}
 
 	if secretName == "" {
-		return emptyCloud, nil, nil
-	}
-
-	if cloudName == "" {
-		return emptyCloud, nil, fmt.Errorf("secret name set to %v but no cloud was specified. Please set cloud_name in your machine spec", secretName)
+		return emptyCloud, nil, nil, defaultRateLimit, nil
 	}
 
 	secret := &corev1.Secret{}
@@ -308,29 +466,97 @@ func getCloudFromSecret(ctx context.Context, ctrlClient client.Client, secretNam
 		Name:      secretName,
 	}, secret)
 	if err != nil {
-		return emptyCloud, nil, err
+		return emptyCloud, nil, nil, defaultRateLimit, err
 	}
 
-	content, ok := secret.Data[orcv1alpha1.CloudCredentialsConfigSecretKey]
-	if !ok {
-		return emptyCloud, nil, fmt.Errorf("OpenStack credentials secret %v did not contain key %v",
+	rateLimit, err := defaultRateLimit.withAnnotations(secret.GetAnnotations())
+	if err != nil {
+		return emptyCloud, nil, nil, defaultRateLimit, fmt.Errorf("OpenStack credentials secret %v: %w", secretName, err)
+	}
+
+	cloud, err := CloudFromSecret(secret, cloudName)
+	if err != nil {
+		return emptyCloud, nil, nil, rateLimit, err
+	}
+
+	exchange, err := getTokenExchangeFromSecret(secret, cloud, ctrlClient)
+	if err != nil {
+		return emptyCloud, nil, nil, rateLimit, err
+	}
+
+	// caCert is nil if the secret does not contain one
+	return cloud, exchange, secret.Data[orcv1alpha1.CloudCredencialsCASecretKey], rateLimit, nil
+}
+
+// CloudFromSecret returns the Cloud defined by a cloud credentials secret. If
+// the secret contains a clouds.yaml file this is the cloud named cloudName.
+// Otherwise the cloud is defined by individual keys in the secret.
+func CloudFromSecret(secret *corev1.Secret, cloudName string) (clientconfig.Cloud, error) {
+	if content, ok := secret.Data[orcv1alpha1.CloudCredentialsConfigSecretKey]; ok {
+		return getCloudFromCloudsYAML(content, secret.Name, cloudName)
+	}
+
+	if _, ok := secret.Data[orcv1alpha1.CloudCredentialsAuthURLSecretKey]; ok {
+		return getCloudFromSecretData(secret.Data), nil
+	}
+
+	return clientconfig.Cloud{}, fmt.Errorf("OpenStack credentials secret %v did not contain key %v or %v",
+		secret.Name, orcv1alpha1.CloudCredentialsConfigSecretKey, orcv1alpha1.CloudCredentialsAuthURLSecretKey)
+}
+
+// getCloudFromCloudsYAML returns the named cloud from the contents of a
+// clouds.yaml file.
+func getCloudFromCloudsYAML(content []byte, secretName string, cloudName string) (clientconfig.Cloud, error) {
+	if cloudName == "" {
+		return clientconfig.Cloud{}, fmt.Errorf("OpenStack credentials secret %v contains %v but no cloudName was specified",
 			secretName, orcv1alpha1.CloudCredentialsConfigSecretKey)
 	}
+
 	var clouds clientconfig.Clouds
-	if err = yaml.Unmarshal(content, &clouds); err != nil {
-		return emptyCloud, nil, fmt.Errorf("failed to unmarshal clouds credentials stored in secret %v: %v", secretName, err)
+	if err := yaml.Unmarshal(content, &clouds); err != nil {
+		return clientconfig.Cloud{}, fmt.Errorf("failed to unmarshal clouds credentials stored in secret %v: %v", secretName, err)
 	}
 
-	cloudsYaml, ok := clouds.Clouds[cloudName]
+	cloud, ok := clouds.Clouds[cloudName]
 	if !ok {
-		return emptyCloud, nil, fmt.Errorf("no cloud named: %v, in the provided config", cloudName)
+		return clientconfig.Cloud{}, fmt.Errorf("no cloud named: %v, in the provided config", cloudName)
 	}
+	return cloud, nil
+}
 
-	// get caCert
-	caCert, ok := secret.Data[orcv1alpha1.CloudCredencialsCASecretKey]
-	if !ok {
-		return cloudsYaml, nil, nil
+// getCloudFromSecretData returns a Cloud defined by individual keys in the data
+// of a credentials secret. If the data does not specify an auth type, the cloud
+// authenticates with an application credential if the data contains an
+// application credential secret, and with a password otherwise.
+func getCloudFromSecretData(data map[string][]byte) clientconfig.Cloud {
+	value := func(key string) string {
+		return string(data[key])
 	}
 
-	return cloudsYaml, caCert, nil
+	cloud := clientconfig.Cloud{
+		AuthType: clientconfig.AuthV3Password,
+		AuthInfo: &clientconfig.AuthInfo{
+			AuthURL:                     value(orcv1alpha1.CloudCredentialsAuthURLSecretKey),
+			ApplicationCredentialID:     value(orcv1alpha1.CloudCredentialsApplicationCredentialIDSecretKey),
+			ApplicationCredentialName:   value(orcv1alpha1.CloudCredentialsApplicationCredentialNameSecretKey),
+			ApplicationCredentialSecret: value(orcv1alpha1.CloudCredentialsApplicationCredentialSecretSecretKey),
+			Username:                    value(orcv1alpha1.CloudCredentialsUsernameSecretKey),
+			UserID:                      value(orcv1alpha1.CloudCredentialsUserIDSecretKey),
+			Password:                    value(orcv1alpha1.CloudCredentialsPasswordSecretKey),
+			UserDomainName:              value(orcv1alpha1.CloudCredentialsUserDomainNameSecretKey),
+			UserDomainID:                value(orcv1alpha1.CloudCredentialsUserDomainIDSecretKey),
+			ProjectName:                 value(orcv1alpha1.CloudCredentialsProjectNameSecretKey),
+			ProjectID:                   value(orcv1alpha1.CloudCredentialsProjectIDSecretKey),
+			ProjectDomainName:           value(orcv1alpha1.CloudCredentialsProjectDomainNameSecretKey),
+			ProjectDomainID:             value(orcv1alpha1.CloudCredentialsProjectDomainIDSecretKey),
+		},
+		RegionName:   value(orcv1alpha1.CloudCredentialsRegionNameSecretKey),
+		EndpointType: value(orcv1alpha1.CloudCredentialsInterfaceSecretKey),
+	}
+	if authType := value(orcv1alpha1.CloudCredentialsAuthTypeSecretKey); authType != "" {
+		cloud.AuthType = clientconfig.AuthType(authType)
+	} else if cloud.AuthInfo.ApplicationCredentialSecret != "" {
+		cloud.AuthType = clientconfig.AuthV3ApplicationCredential
+	}
+	return cloud
 }
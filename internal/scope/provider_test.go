@@ -0,0 +1,218 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud/v2"
+	"github.com/gophercloud/utils/v2/openstack/clientconfig"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/cache"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+)
+
+func TestCloudFromSecret(t *testing.T) {
+	t.Parallel()
+
+	const cloudsYAML = `
+clouds:
+  openstack:
+    auth:
+      auth_url: https://keystone.example.com/v3
+      username: admin
+    region_name: RegionOne
+`
+
+	tests := []struct {
+		name      string
+		data      map[string]string
+		cloudName string
+		want      clientconfig.Cloud
+		wantErr   bool
+	}{
+		{
+			name:      "clouds.yaml",
+			data:      map[string]string{orcv1alpha1.CloudCredentialsConfigSecretKey: cloudsYAML},
+			cloudName: "openstack",
+			want: clientconfig.Cloud{
+				AuthInfo: &clientconfig.AuthInfo{
+					AuthURL:  "https://keystone.example.com/v3",
+					Username: "admin",
+				},
+				RegionName: "RegionOne",
+			},
+		},
+		{
+			name:    "clouds.yaml without cloud name",
+			data:    map[string]string{orcv1alpha1.CloudCredentialsConfigSecretKey: cloudsYAML},
+			wantErr: true,
+		},
+		{
+			name:      "clouds.yaml with unknown cloud name",
+			data:      map[string]string{orcv1alpha1.CloudCredentialsConfigSecretKey: cloudsYAML},
+			cloudName: "other",
+			wantErr:   true,
+		},
+		{
+			name: "application credential",
+			data: map[string]string{
+				orcv1alpha1.CloudCredentialsAuthURLSecretKey:                     "https://keystone.example.com/v3",
+				orcv1alpha1.CloudCredentialsApplicationCredentialIDSecretKey:     "ac-id",
+				orcv1alpha1.CloudCredentialsApplicationCredentialSecretSecretKey: "ac-secret",
+				orcv1alpha1.CloudCredentialsRegionNameSecretKey:                  "RegionOne",
+				orcv1alpha1.CloudCredentialsInterfaceSecretKey:                   "internal",
+			},
+			// Ignored without clouds.yaml
+			cloudName: "openstack",
+			want: clientconfig.Cloud{
+				AuthType: clientconfig.AuthV3ApplicationCredential,
+				AuthInfo: &clientconfig.AuthInfo{
+					AuthURL:                     "https://keystone.example.com/v3",
+					ApplicationCredentialID:     "ac-id",
+					ApplicationCredentialSecret: "ac-secret",
+				},
+				RegionName:   "RegionOne",
+				EndpointType: "internal",
+			},
+		},
+		{
+			name: "password",
+			data: map[string]string{
+				orcv1alpha1.CloudCredentialsAuthURLSecretKey:           "https://keystone.example.com/v3",
+				orcv1alpha1.CloudCredentialsUsernameSecretKey:          "admin",
+				orcv1alpha1.CloudCredentialsPasswordSecretKey:          "password",
+				orcv1alpha1.CloudCredentialsUserDomainNameSecretKey:    "Default",
+				orcv1alpha1.CloudCredentialsProjectNameSecretKey:       "admin",
+				orcv1alpha1.CloudCredentialsProjectDomainNameSecretKey: "Default",
+			},
+			want: clientconfig.Cloud{
+				AuthType: clientconfig.AuthV3Password,
+				AuthInfo: &clientconfig.AuthInfo{
+					AuthURL:           "https://keystone.example.com/v3",
+					Username:          "admin",
+					Password:          "password",
+					UserDomainName:    "Default",
+					ProjectName:       "admin",
+					ProjectDomainName: "Default",
+				},
+			},
+		},
+		{
+			name:    "no credentials",
+			data:    map[string]string{orcv1alpha1.CloudCredencialsCASecretKey: "cert"},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "credentials", Namespace: "default"},
+				Data:       make(map[string][]byte, len(tc.data)),
+			}
+			for k, v := range tc.data {
+				secret.Data[k] = []byte(v)
+			}
+
+			got, err := CloudFromSecret(secret, tc.cloudName)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if got.AuthType != tc.want.AuthType || got.RegionName != tc.want.RegionName || got.EndpointType != tc.want.EndpointType {
+				t.Errorf("got %+v, want %+v", got, tc.want)
+			}
+			if got.AuthInfo == nil || *got.AuthInfo != *tc.want.AuthInfo {
+				t.Errorf("got auth %+v, want %+v", got.AuthInfo, tc.want.AuthInfo)
+			}
+		})
+	}
+}
+
+func TestDiscardOnReauthenticationFailure(t *testing.T) {
+	t.Parallel()
+
+	const key = "key"
+
+	tests := []struct {
+		name      string
+		reauthErr error
+		replaced  bool
+		wantScope bool
+	}{
+		{
+			name:      "successful reauthentication keeps the scope",
+			wantScope: true,
+		},
+		{
+			name:      "failed reauthentication discards the scope",
+			reauthErr: errors.New("unauthorized"),
+		},
+		{
+			name:      "failed reauthentication does not discard a replacement scope",
+			reauthErr: errors.New("unauthorized"),
+			replaced:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			scopeCache := cache.NewLRUExpireCache(10)
+			scope := &providerScope{providerClient: &gophercloud.ProviderClient{
+				ReauthFunc: func(context.Context) error { return tt.reauthErr },
+			}}
+			addCachedScope(scopeCache, key, scope, time.Hour)
+			discardOnReauthenticationFailure(scopeCache, key, scope, logr.Discard())
+
+			replacement := &providerScope{}
+			if tt.replaced {
+				addCachedScope(scopeCache, key, replacement, time.Hour)
+			}
+
+			if err := scope.providerClient.ReauthFunc(context.TODO()); !errors.Is(err, tt.reauthErr) {
+				t.Errorf("ReauthFunc() error = %v, want %v", err, tt.reauthErr)
+			}
+
+			cached, found := scopeCache.Get(key)
+			switch {
+			case tt.replaced:
+				if cached != Scope(replacement) {
+					t.Errorf("replacement scope was discarded")
+				}
+			case found != tt.wantScope:
+				t.Errorf("scope cached: got %v, want %v", found, tt.wantScope)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+)
+
+// RateLimit limits the OpenStack API requests made with a single set of
+// credentials. The zero value does not limit requests.
+type RateLimit struct {
+	// QPS is the sustained number of requests per second. A value of 0
+	// disables rate limiting.
+	QPS float64
+
+	// Burst is the maximum number of requests which may be made at once in
+	// excess of QPS. If it is not set, it defaults to QPS rounded up.
+	Burst int
+
+	// MaxInFlight is the maximum number of concurrent requests. A value of 0
+	// disables the limit.
+	MaxInFlight int
+}
+
+// withAnnotations returns a copy of l with any values set in the rate limit
+// annotations of a credentials secret.
+func (l RateLimit) withAnnotations(annotations map[string]string) (RateLimit, error) {
+	if value, ok := annotations[orcv1alpha1.CloudCredentialsRateLimitQPSAnnotation]; ok {
+		qps, err := strconv.ParseFloat(value, 64)
+		if err != nil || qps < 0 {
+			return l, fmt.Errorf("invalid value %q for annotation %s: must be a non-negative number", value, orcv1alpha1.CloudCredentialsRateLimitQPSAnnotation)
+		}
+		l.QPS = qps
+	}
+
+	parseInt := func(annotation string, target *int) error {
+		value, ok := annotations[annotation]
+		if !ok {
+			return nil
+		}
+		i, err := strconv.Atoi(value)
+		if err != nil || i < 0 {
+			return fmt.Errorf("invalid value %q for annotation %s: must be a non-negative integer", value, annotation)
+		}
+		*target = i
+		return nil
+	}
+	if err := parseInt(orcv1alpha1.CloudCredentialsRateLimitBurstAnnotation, &l.Burst); err != nil {
+		return l, err
+	}
+	if err := parseInt(orcv1alpha1.CloudCredentialsMaxInFlightAnnotation, &l.MaxInFlight); err != nil {
+		return l, err
+	}
+
+	return l, nil
+}
+
+// rateLimiter enforces a RateLimit for all requests made with a single set of
+// credentials. It is shared by every provider client created for those
+// credentials, so the limit applies across all controllers.
+type rateLimiter struct {
+	limit    RateLimit
+	tokens   *rate.Limiter
+	inFlight chan struct{}
+}
+
+func newRateLimiter(limit RateLimit) *rateLimiter {
+	l := &rateLimiter{limit: limit}
+	if limit.QPS > 0 {
+		burst := limit.Burst
+		if burst <= 0 {
+			burst = int(math.Ceil(limit.QPS))
+		}
+		l.tokens = rate.NewLimiter(rate.Limit(limit.QPS), burst)
+	}
+	if limit.MaxInFlight > 0 {
+		l.inFlight = make(chan struct{}, limit.MaxInFlight)
+	}
+	return l
+}
+
+// getLimit returns the RateLimit enforced by l. A nil rateLimiter enforces no
+// limit.
+func (l *rateLimiter) getLimit() RateLimit {
+	if l == nil {
+		return RateLimit{}
+	}
+	return l.limit
+}
+
+// acquire blocks until a request may be made, or ctx is cancelled. If it
+// returns without error, the caller must call the returned release function
+// when the request has completed.
+func (l *rateLimiter) acquire(ctx context.Context) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	if l.tokens != nil {
+		if err := l.tokens.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("waiting for OpenStack API rate limit: %w", err)
+		}
+	}
+
+	if l.inFlight == nil {
+		return func() {}, nil
+	}
+	select {
+	case l.inFlight <- struct{}{}:
+		return func() { <-l.inFlight }, nil
+	case <-ctx.Done():
+		return nil, fmt.Errorf("waiting for OpenStack API concurrency limit: %w", ctx.Err())
+	}
+}
+
+// rateLimiterIdleExpiry is the time after which the rateLimiter for a set of
+// credentials is discarded if no scope has been requested for them with its
+// limit. This prevents limiters accumulating for credentials which have been
+// rotated or deleted, or whose limit has changed.
+const rateLimiterIdleExpiry = time.Hour
+
+// rateLimiterRegistry holds the rateLimiter for each set of credentials.
+type rateLimiterRegistry struct {
+	mu        sync.Mutex
+	limiters  map[rateLimiterKey]*registeredRateLimiter
+	lastSweep time.Time
+
+	// now returns the current time. It defaults to time.Now.
+	now func() time.Time
+}
+
+// rateLimiterKey identifies a rateLimiter. Secrets which contain the same
+// credentials may carry different rate limit annotations, so the limit is part
+// of the key. Otherwise each secret would replace the other's limiter, and
+// neither limit would be enforced.
+type rateLimiterKey struct {
+	credentials string
+	limit       RateLimit
+}
+
+type registeredRateLimiter struct {
+	*rateLimiter
+	lastUsed time.Time
+}
+
+// get returns the rateLimiter enforcing limit for the credentials identified
+// by key. Requests with the same credentials and limit share a rateLimiter.
+func (r *rateLimiterRegistry) get(key string, limit RateLimit) *rateLimiter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := time.Now()
+	if r.now != nil {
+		now = r.now()
+	}
+	r.sweep(now)
+
+	if limit.QPS <= 0 && limit.MaxInFlight <= 0 {
+		return nil
+	}
+
+	limiterKey := rateLimiterKey{credentials: key, limit: limit}
+	if l, ok := r.limiters[limiterKey]; ok {
+		l.lastUsed = now
+		return l.rateLimiter
+	}
+	if r.limiters == nil {
+		r.limiters = make(map[rateLimiterKey]*registeredRateLimiter)
+	}
+	l := newRateLimiter(limit)
+	r.limiters[limiterKey] = &registeredRateLimiter{rateLimiter: l, lastUsed: now}
+	return l
+}
+
+// sweep discards limiters which have not been used for rateLimiterIdleExpiry.
+// A limiter which is not returned by get is not used by any new scope, and
+// any cached scope which still uses it is also unused.
+func (r *rateLimiterRegistry) sweep(now time.Time) {
+	if now.Sub(r.lastSweep) < rateLimiterIdleExpiry {
+		return
+	}
+	r.lastSweep = now
+
+	for key, l := range r.limiters {
+		if now.Sub(l.lastUsed) >= rateLimiterIdleExpiry {
+			delete(r.limiters, key)
+		}
+	}
+}
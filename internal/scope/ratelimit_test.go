@@ -0,0 +1,183 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scope
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+)
+
+func TestRateLimitWithAnnotations(t *testing.T) {
+	t.Parallel()
+
+	defaults := RateLimit{QPS: 10, Burst: 20, MaxInFlight: 5}
+
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		want        RateLimit
+		wantErr     bool
+	}{
+		{
+			name: "no annotations",
+			want: defaults,
+		},
+		{
+			name: "all annotations",
+			annotations: map[string]string{
+				orcv1alpha1.CloudCredentialsRateLimitQPSAnnotation:   "2.5",
+				orcv1alpha1.CloudCredentialsRateLimitBurstAnnotation: "4",
+				orcv1alpha1.CloudCredentialsMaxInFlightAnnotation:    "0",
+			},
+			want: RateLimit{QPS: 2.5, Burst: 4, MaxInFlight: 0},
+		},
+		{
+			name: "invalid qps",
+			annotations: map[string]string{
+				orcv1alpha1.CloudCredentialsRateLimitQPSAnnotation: "fast",
+			},
+			wantErr: true,
+		},
+		{
+			name: "negative max in flight",
+			annotations: map[string]string{
+				orcv1alpha1.CloudCredentialsMaxInFlightAnnotation: "-1",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := defaults.withAnnotations(tc.annotations)
+			if tc.wantErr {
+				if err == nil {
+					t.Errorf("expected error, got %+v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("got %+v, want %+v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRateLimiterRegistry(t *testing.T) {
+	t.Parallel()
+
+	var registry rateLimiterRegistry
+
+	if l := registry.get("cloud", RateLimit{}); l != nil {
+		t.Errorf("expected no limiter for an empty limit, got %+v", l)
+	}
+
+	limit := RateLimit{QPS: 1}
+	first := registry.get("cloud", limit)
+	if first == nil {
+		t.Fatal("expected a limiter")
+	}
+	if l := registry.get("cloud", limit); l != first {
+		t.Error("expected the same limiter for the same credentials and limit")
+	}
+	if l := registry.get("other-cloud", limit); l == first {
+		t.Error("expected a different limiter for different credentials")
+	}
+	second := registry.get("cloud", RateLimit{QPS: 2})
+	if second == first {
+		t.Error("expected a different limiter for a different limit")
+	}
+
+	// Secrets with the same credentials but different limits each keep
+	// their own limiter
+	if l := registry.get("cloud", limit); l != first {
+		t.Error("expected the limiter for the first limit to be retained")
+	}
+	if l := registry.get("cloud", RateLimit{QPS: 2}); l != second {
+		t.Error("expected the limiter for the second limit to be retained")
+	}
+}
+
+func TestRateLimiterRegistryExpiry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	registry := rateLimiterRegistry{now: func() time.Time { return now }}
+
+	limit := RateLimit{QPS: 1}
+	used := registry.get("used", limit)
+	registry.get("rotated", limit)
+
+	// Limiters which are in use are retained
+	now = now.Add(rateLimiterIdleExpiry / 2)
+	registry.get("used", limit)
+	now = now.Add(rateLimiterIdleExpiry / 2)
+	if l := registry.get("used", limit); l != used {
+		t.Error("expected the limiter in use to be retained")
+	}
+
+	if _, ok := registry.limiters[rateLimiterKey{credentials: "rotated", limit: limit}]; ok {
+		t.Error("expected the idle limiter to be discarded")
+	}
+	if len(registry.limiters) != 1 {
+		t.Errorf("expected 1 limiter, got %d", len(registry.limiters))
+	}
+
+	// The limiter for a limit which is no longer used is discarded once it
+	// is idle
+	if l := registry.get("used", RateLimit{}); l != nil {
+		t.Errorf("expected no limiter for an empty limit, got %+v", l)
+	}
+	now = now.Add(rateLimiterIdleExpiry)
+	registry.get("used", RateLimit{})
+	if len(registry.limiters) != 0 {
+		t.Errorf("expected no limiters, got %d", len(registry.limiters))
+	}
+}
+
+func TestRateLimiterMaxInFlight(t *testing.T) {
+	t.Parallel()
+
+	l := newRateLimiter(RateLimit{MaxInFlight: 1})
+
+	release, err := l.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A second request must wait until the first is released
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := l.acquire(ctx); err == nil {
+		t.Fatal("expected second request to block until its context expired")
+	}
+
+	release()
+	release, err = l.acquire(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error after release: %v", err)
+	}
+	release()
+}
@@ -29,14 +29,18 @@ import (
 )
 
 // NewFactory creates the default scope factory. It generates service clients which make OpenStack API calls against a running cloud.
-func NewFactory(maxCacheSize int, defaultCACert []byte) Factory {
-	var c *cache.LRUExpireCache
+// defaultRateLimit limits the requests made with each set of credentials unless overridden by annotations on the credentials secret.
+func NewFactory(maxCacheSize int, defaultCACert []byte, defaultRateLimit RateLimit) Factory {
+	var c, failures *cache.LRUExpireCache
 	if maxCacheSize > 0 {
 		c = cache.NewLRUExpireCache(maxCacheSize)
+		failures = cache.NewLRUExpireCache(maxCacheSize)
 	}
 	return &providerScopeFactory{
-		clientCache:   c,
-		defaultCACert: defaultCACert,
+		clientCache:      c,
+		failureCache:     failures,
+		defaultCACert:    defaultCACert,
+		defaultRateLimit: defaultRateLimit,
 	}
 }
 
@@ -50,6 +54,7 @@ type Factory interface {
 type Scope interface {
 	NewAddressScopeClient() (osclients.AddressScopeClient, error)
 	NewApplicationCredentialClient() (osclients.ApplicationCredentialClient, error)
+	NewCapabilitiesClient() (osclients.CapabilitiesClient, error)
 	NewComputeClient() (osclients.ComputeClient, error)
 	NewDomainClient() (osclients.DomainClient, error)
 	NewEndpointClient() (osclients.EndpointClient, error)
@@ -64,8 +69,20 @@ type Scope interface {
 	NewShareNetworkClient() (osclients.ShareNetworkClient, error)
 	NewUserClient() (osclients.UserClient, error)
 	NewVolumeClient() (osclients.VolumeClient, error)
+	NewVolumeBackupClient() (osclients.VolumeBackupClient, error)
+	NewVolumeSnapshotClient() (osclients.VolumeSnapshotClient, error)
 	NewVolumeTypeClient() (osclients.VolumeTypeClient, error)
 	ExtractToken() (*tokens.Token, error)
+
+	// GetProjectID returns the ID of the project the scope's token is
+	// scoped to, or an empty string if it is not scoped to a project.
+	GetProjectID() string
+
+	// GetProjectKey returns a key identifying the cloud, region and project
+	// of the scope, or an empty string if its token is not scoped to a
+	// project. Scopes with the same key operate on the same project
+	// resources.
+	GetProjectKey() string
 }
 
 // WithLogger extends Scope with a logger.
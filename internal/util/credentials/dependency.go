@@ -16,13 +16,19 @@ limitations under the License.
 package credentials
 
 import (
+	"context"
+
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dependency"
 )
 
@@ -44,31 +50,105 @@ import (
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=secrets,verbs=update;patch
 
+// AddCredentialsWatch adds watches to b which reconcile an object when its
+// credentials change. This includes changes to an OpenStackCloud, its secret,
+// or the labels of a namespace, which may change the credentials inherited by
+// an object which does not specify cloudCredentialsRef.
 func AddCredentialsWatch[
-	objectTP dependency.ObjectType[objectT],
+	objectTP ObjectType[objectT],
 	objectListTP dependency.ObjectListType[objectListT, objectT],
-	depTP dependency.DependencyType[depT],
 
-	objectT any, objectListT any, depT any,
+	objectT any, objectListT any,
 ](
 	log logr.Logger,
 	k8sClient client.Client,
 	b *builder.Builder,
-	credentialsDep dependency.DeletionGuardDependency[objectTP, objectListTP, depTP, objectT, objectListT, depT],
+	credentialsDep Dependency[objectTP, objectListTP, objectT, objectListT],
 ) error {
 	credentialsWatchEventHandler, err := credentialsDep.WatchEventHandler(log, k8sClient)
 	if err != nil {
 		return err
 	}
 
-	b.Watches(&corev1.Secret{}, credentialsWatchEventHandler,
-		// Only trigger a reconcile when the credentials are created. We
-		// don't need to reconcile for updates.
-		builder.WithPredicates(predicate.Funcs{
-			CreateFunc: func(_ event.TypedCreateEvent[client.Object]) bool {
-				return true
-			},
-		}))
+	secretPredicate := builder.WithPredicates(predicate.Funcs{
+		CreateFunc: func(_ event.TypedCreateEvent[client.Object]) bool {
+			return true
+		},
+		// Don't reconcile every object using the credentials when
+		// ORC publishes the credentials status
+		UpdateFunc: func(e event.TypedUpdateEvent[client.Object]) bool {
+			return !onlyStatusAnnotationChanged(e.ObjectOld, e.ObjectNew)
+		},
+	})
+
+	requestsForOpenStackCloud := func(ctx context.Context, cloud *orcv1alpha1.OpenStackCloud) []reconcile.Request {
+		requests, err := credentialsDep.requestsForOpenStackCloud(ctx, k8sClient, cloud)
+		if err != nil {
+			log.Error(err, "listing objects inheriting credentials", "openstackcloud", cloud.Name)
+		}
+		return requests
+	}
+
+	b.Watches(&corev1.Secret{}, credentialsWatchEventHandler, secretPredicate).
+		// A second watch is necessary for secrets referenced by an
+		// OpenStackCloud, as they may be in a different namespace
+		Watches(&corev1.Secret{},
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+				clouds, err := getOpenStackCloudsForSecret(ctx, k8sClient, obj)
+				if err != nil {
+					log.Error(err, "listing OpenStackClouds for secret", "name", obj.GetName(), "namespace", obj.GetNamespace())
+					return nil
+				}
+				var requests []reconcile.Request
+				for i := range clouds {
+					requests = append(requests, requestsForOpenStackCloud(ctx, &clouds[i])...)
+				}
+				return requests
+			}),
+			secretPredicate).
+		Watches(&orcv1alpha1.OpenStackCloud{}, enqueueForOpenStackCloud(requestsForOpenStackCloud),
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&corev1.Namespace{},
+			handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, obj client.Object) []reconcile.Request {
+				objects, err := credentialsDep.getInheritingObjects(ctx, k8sClient, obj.GetName())
+				if err != nil {
+					log.Error(err, "listing objects inheriting credentials", "namespace", obj.GetName())
+					return nil
+				}
+				requests := make([]reconcile.Request, len(objects))
+				for i := range objects {
+					requests[i].NamespacedName = client.ObjectKeyFromObject(objectTP(&objects[i]))
+				}
+				return requests
+			}),
+			// The OpenStackCloud which allows a namespace can only
+			// change when its labels change
+			builder.WithPredicates(predicate.LabelChangedPredicate{}))
 
 	return nil
 }
+
+// onlyStatusAnnotationChanged returns true if the only difference between two
+// versions of a credentials secret is the credentials status annotation.
+func onlyStatusAnnotationChanged(oldObj, newObj client.Object) bool {
+	oldSecret, oldOK := oldObj.(*corev1.Secret)
+	newSecret, newOK := newObj.(*corev1.Secret)
+	if !oldOK || !newOK {
+		return false
+	}
+	if oldSecret.Annotations[orcv1alpha1.CloudCredentialsStatusAnnotation] == newSecret.Annotations[orcv1alpha1.CloudCredentialsStatusAnnotation] {
+		return false
+	}
+
+	normalize := func(secret *corev1.Secret) *corev1.Secret {
+		secret = secret.DeepCopy()
+		secret.ResourceVersion = ""
+		secret.ManagedFields = nil
+		delete(secret.Annotations, orcv1alpha1.CloudCredentialsStatusAnnotation)
+		if len(secret.Annotations) == 0 {
+			secret.Annotations = nil
+		}
+		return secret
+	}
+	return equality.Semantic.DeepEqual(normalize(oldSecret), normalize(newSecret))
+}
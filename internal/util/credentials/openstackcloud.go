@@ -0,0 +1,463 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package credentials
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/progress"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/logging"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/scope"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dependency"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/finalizers"
+	orcstrings "github.com/k-orc/openstack-resource-controller/v2/internal/util/strings"
+)
+
+// +kubebuilder:rbac:groups=openstack.k-orc.cloud,resources=openstackclouds,verbs=update;patch
+
+// inheritedCredentialsIndex indexes objects which do not specify
+// cloudCredentialsRef, and therefore inherit the credentials of an
+// OpenStackCloud.
+const inheritedCredentialsIndex = "spec.cloudCredentialsRef.inherited"
+
+// ObjectType is an ORC object which has cloud credentials.
+type ObjectType[objectT any] interface {
+	dependency.ObjectType[objectT]
+	orcv1alpha1.CloudCredentialsRefProvider
+}
+
+// Dependency is the dependency of an ORC object on its cloud credentials. An
+// object which specifies cloudCredentialsRef depends on the referenced secret.
+// An object which does not depends on the OpenStackCloud which allows its
+// namespace, and on the secret referenced by the OpenStackCloud.
+//
+// In addition to the deletion guard of the referenced secret, Dependency adds
+// deletion guards to OpenStackClouds and their secrets which prevent them
+// from being deleted while an object inherits credentials from them. These
+// use a different finalizer, as the secret of an OpenStackCloud may also be
+// referenced directly.
+type Dependency[
+	objectTP ObjectType[objectT],
+	objectListTP dependency.ObjectListType[objectListT, objectT],
+
+	objectT any, objectListT any,
+] struct {
+	dependency.DeletionGuardDependency[objectTP, objectListTP, *corev1.Secret, objectT, objectListT, corev1.Secret]
+
+	inheritedFinalizer  string
+	inheritedFieldOwner client.FieldOwner
+}
+
+// NewDependency returns a Dependency of objects of the controller named
+// controllerName on their cloud credentials. secretDependency is the
+// dependency on the secret referenced by cloudCredentialsRef.
+func NewDependency[
+	objectTP ObjectType[objectT],
+	objectListTP dependency.ObjectListType[objectListT, objectT],
+
+	objectT any, objectListT any,
+](
+	secretDependency dependency.DeletionGuardDependency[objectTP, objectListTP, *corev1.Secret, objectT, objectListT, corev1.Secret],
+	controllerName string,
+) Dependency[objectTP, objectListTP, objectT, objectListT] {
+	return Dependency[objectTP, objectListTP, objectT, objectListT]{
+		DeletionGuardDependency: secretDependency,
+		inheritedFinalizer:      orcstrings.GetFinalizerName(controllerName) + "-openstackcloud",
+		inheritedFieldOwner:     orcstrings.GetSSAFieldOwnerWithTxn(controllerName, orcstrings.SSATransactionOpenStackCloud),
+	}
+}
+
+// GetDependencies returns the credentials secret of the given object, ensuring
+// that it has the required finalizer. If the object inherits its credentials,
+// the OpenStackCloud is also given the required finalizer, and its name is
+// recorded in the object's status.
+func (d *Dependency[objectTP, _, _, _]) GetDependencies(ctx context.Context, k8sClient client.Client, obj objectTP, readyFilter func(*corev1.Secret) bool) (map[string]*corev1.Secret, progress.ReconcileStatus) {
+	if _, credentialsRef := obj.GetCloudCredentialsRef(); credentialsRef != nil {
+		return d.DeletionGuardDependency.GetDependencies(ctx, k8sClient, obj, readyFilter)
+	}
+
+	cloud, err := scope.GetOpenStackCloudForObject(ctx, k8sClient, obj)
+	if err != nil {
+		return nil, progress.WrapError(err)
+	}
+
+	// We can't add a finalizer to an OpenStackCloud which is being deleted
+	if !cloud.GetDeletionTimestamp().IsZero() && !slices.Contains(cloud.GetFinalizers(), d.inheritedFinalizer) {
+		return nil, progress.WrapError(fmt.Errorf("OpenStackCloud %s is being deleted", cloud.Name))
+	}
+	if err := dependency.EnsureFinalizer(ctx, k8sClient, cloud, d.inheritedFinalizer, d.inheritedFieldOwner); err != nil {
+		return nil, progress.WrapError(err)
+	}
+
+	secretRef := types.NamespacedName{Namespace: cloud.Spec.SecretRef.Namespace, Name: string(cloud.Spec.SecretRef.Name)}
+	secret := &corev1.Secret{}
+	if err := k8sClient.Get(ctx, secretRef, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, progress.WaitingOnObject("Secret", secretRef.Name, progress.WaitingOnCreation)
+		}
+		return nil, progress.WrapError(err)
+	}
+	if !readyFilter(secret) {
+		return nil, progress.WaitingOnObject("Secret", secretRef.Name, progress.WaitingOnReady)
+	}
+	if err := dependency.EnsureFinalizer(ctx, k8sClient, secret, d.inheritedFinalizer, d.inheritedFieldOwner); err != nil {
+		return nil, progress.WrapError(err)
+	}
+	if err := d.recordOpenStackCloud(ctx, k8sClient, obj, cloud.Name); err != nil {
+		return nil, progress.WrapError(err)
+	}
+
+	return map[string]*corev1.Secret{secretRef.Name: secret}, nil
+}
+
+// recordOpenStackCloud records the name of the OpenStackCloud whose
+// credentials are used by obj in its status, so that obj does not silently
+// switch to another OpenStackCloud if its namespace is relabelled.
+//
+// The field is written with a JSON merge patch because it is not part of the
+// status transaction, which would remove it.
+func (d *Dependency[objectTP, _, _, _]) recordOpenStackCloud(ctx context.Context, k8sClient client.Client, obj objectTP, cloudName string) error {
+	statusProvider, ok := any(obj).(orcv1alpha1.OpenStackCloudStatusProvider)
+	if !ok {
+		return nil
+	}
+	if recorded, _ := statusProvider.GetOpenStackCloudStatus(); recorded != nil && *recorded == cloudName {
+		return nil
+	}
+
+	patch, err := json.Marshal(map[string]any{
+		"status": map[string]any{
+			"openStackCloudName": cloudName,
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return k8sClient.Status().Patch(ctx, obj, client.RawPatch(types.MergePatchType, patch), d.inheritedFieldOwner)
+}
+
+// AddToManager adds the indexers and deletion guards of the Dependency to the
+// manager.
+func (d *Dependency[objectTP, _, objectT, _]) AddToManager(ctx context.Context, mgr ctrl.Manager) error {
+	return errors.Join(
+		d.DeletionGuardDependency.AddToManager(ctx, mgr),
+		mgr.GetFieldIndexer().IndexField(ctx, objectTP(new(objectT)), inheritedCredentialsIndex, func(cObj client.Object) []string {
+			obj, ok := cObj.(objectTP)
+			if !ok {
+				return nil
+			}
+			if _, credentialsRef := obj.GetCloudCredentialsRef(); credentialsRef != nil {
+				return nil
+			}
+			return []string{"true"}
+		}),
+		d.addOpenStackCloudDeletionGuard(mgr),
+		d.addOpenStackCloudSecretDeletionGuard(mgr),
+	)
+}
+
+// getInheritingObjects returns all objects in namespace which inherit their
+// credentials from an OpenStackCloud, or in all namespaces if namespace is
+// empty.
+func (d *Dependency[_, objectListTP, objectT, objectListT]) getInheritingObjects(ctx context.Context, k8sClient client.Client, namespace string) ([]objectT, error) {
+	var objectList objectListTP = new(objectListT)
+	if err := k8sClient.List(ctx, objectList, client.InNamespace(namespace), client.MatchingFields{inheritedCredentialsIndex: "true"}); err != nil {
+		return nil, err
+	}
+	return objectList.GetItems(), nil
+}
+
+// getObjectsForOpenStackCloud returns all objects which inherit their
+// credentials from cloud.
+func (d *Dependency[objectTP, _, objectT, _]) getObjectsForOpenStackCloud(ctx context.Context, k8sClient client.Client, cloud *orcv1alpha1.OpenStackCloud) ([]objectT, error) {
+	objects, err := d.getInheritingObjects(ctx, k8sClient, "")
+	if err != nil {
+		return nil, err
+	}
+
+	allowed := make(map[string]bool)
+	var errs []error
+	objects = slices.DeleteFunc(objects, func(object objectT) bool {
+		namespace := objectTP(&object).GetNamespace()
+		isAllowed, ok := allowed[namespace]
+		if !ok {
+			ns := &corev1.Namespace{}
+			if err := k8sClient.Get(ctx, client.ObjectKey{Name: namespace}, ns); err != nil {
+				errs = append(errs, fmt.Errorf("fetching namespace %s: %w", namespace, err))
+				return false
+			}
+			isAllowed, err = scope.OpenStackCloudAllowsNamespace(cloud, ns)
+			if err != nil {
+				errs = append(errs, err)
+				return false
+			}
+			allowed[namespace] = isAllowed
+		}
+		return !isAllowed
+	})
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+	return objects, nil
+}
+
+// getOpenStackCloudsForSecret returns all OpenStackClouds which reference the
+// given secret.
+func getOpenStackCloudsForSecret(ctx context.Context, k8sClient client.Client, secret client.Object) ([]orcv1alpha1.OpenStackCloud, error) {
+	cloudList := &orcv1alpha1.OpenStackCloudList{}
+	if err := k8sClient.List(ctx, cloudList); err != nil {
+		return nil, fmt.Errorf("listing OpenStackClouds: %w", err)
+	}
+	return slices.DeleteFunc(cloudList.Items, func(cloud orcv1alpha1.OpenStackCloud) bool {
+		return cloud.Spec.SecretRef.Namespace != secret.GetNamespace() || string(cloud.Spec.SecretRef.Name) != secret.GetName()
+	}), nil
+}
+
+// requestsForOpenStackCloud returns a reconcile request for every object which
+// inherits its credentials from cloud.
+func (d *Dependency[objectTP, _, _, _]) requestsForOpenStackCloud(ctx context.Context, k8sClient client.Client, cloud *orcv1alpha1.OpenStackCloud) ([]reconcile.Request, error) {
+	objects, err := d.getObjectsForOpenStackCloud(ctx, k8sClient, cloud)
+	if err != nil {
+		return nil, err
+	}
+	requests := make([]reconcile.Request, len(objects))
+	for i := range objects {
+		requests[i].NamespacedName = client.ObjectKeyFromObject(objectTP(&objects[i]))
+	}
+	return requests, nil
+}
+
+// enqueueForOpenStackCloud returns an EventHandler which enqueues the requests
+// returned by getRequests for an OpenStackCloud. On update, it enqueues the
+// requests of both the old and new OpenStackCloud.
+func enqueueForOpenStackCloud(getRequests func(context.Context, *orcv1alpha1.OpenStackCloud) []reconcile.Request) handler.EventHandler {
+	enqueue := func(ctx context.Context, q workqueue.TypedRateLimitingInterface[reconcile.Request], objs ...client.Object) {
+		for _, obj := range objs {
+			cloud, ok := obj.(*orcv1alpha1.OpenStackCloud)
+			if !ok {
+				continue
+			}
+			for _, request := range getRequests(ctx, cloud) {
+				q.Add(request)
+			}
+		}
+	}
+
+	return handler.Funcs{
+		CreateFunc: func(ctx context.Context, e event.TypedCreateEvent[client.Object], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueue(ctx, q, e.Object)
+		},
+		UpdateFunc: func(ctx context.Context, e event.TypedUpdateEvent[client.Object], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueue(ctx, q, e.ObjectOld, e.ObjectNew)
+		},
+		DeleteFunc: func(ctx context.Context, e event.TypedDeleteEvent[client.Object], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueue(ctx, q, e.Object)
+		},
+		GenericFunc: func(ctx context.Context, e event.TypedGenericEvent[client.Object], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			enqueue(ctx, q, e.Object)
+		},
+	}
+}
+
+// hasFinalizerAndIsDeleted returns a predicate which selects objects which are
+// marked deleted and have the given finalizer.
+func hasFinalizerAndIsDeleted(finalizer string) predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return !obj.GetDeletionTimestamp().IsZero() && slices.Contains(obj.GetFinalizers(), finalizer)
+	})
+}
+
+// guardControllerName returns the name of a deletion guard controller for
+// objects of the same type as objSpecimen.
+func guardControllerName(mgr ctrl.Manager, dependencyName string, objSpecimen client.Object) (string, error) {
+	gvk, err := apiutil.GVKForObject(objSpecimen, mgr.GetScheme())
+	if err != nil {
+		return "", err
+	}
+	return dependencyName + "_deletion_guard_for_" + strings.ToLower(gvk.Kind), nil
+}
+
+// addOpenStackCloudDeletionGuard adds a controller which removes our
+// finalizer from a deleted OpenStackCloud when no object inherits its
+// credentials.
+func (d *Dependency[objectTP, _, objectT, _]) addOpenStackCloudDeletionGuard(mgr ctrl.Manager) error {
+	objSpecimen := objectTP(new(objectT))
+	controllerName, err := guardControllerName(mgr, "openstackcloud", objSpecimen)
+	if err != nil {
+		return err
+	}
+
+	deletionGuard := reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		log := ctrl.LoggerFrom(ctx, "name", req.Name)
+		log.V(logging.Verbose).Info("Reconciling deletion guard")
+
+		k8sClient := mgr.GetClient()
+
+		cloud := &orcv1alpha1.OpenStackCloud{}
+		if err := k8sClient.Get(ctx, req.NamespacedName, cloud); err != nil {
+			return ctrl.Result{}, client.IgnoreNotFound(err)
+		}
+		if cloud.GetDeletionTimestamp().IsZero() {
+			log.V(logging.Verbose).Info("Dependency is not marked deleted")
+			return ctrl.Result{}, nil
+		}
+
+		objects, err := d.getObjectsForOpenStackCloud(ctx, k8sClient, cloud)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if len(objects) > 0 {
+			log.V(logging.Verbose).Info("Waiting for dependencies", "dependencies", len(objects))
+			return ctrl.Result{}, nil
+		}
+
+		log.V(logging.Verbose).Info("Removing finalizer")
+		return ctrl.Result{}, k8sClient.Patch(ctx, cloud, finalizers.RemoveFinalizerPatch(cloud), client.ForceOwnership, d.inheritedFieldOwner)
+	})
+
+	// When an inheriting object is deleted, reconcile every deleted
+	// OpenStackCloud in case it was the last object using it
+	enqueueDeletedClouds := handler.Funcs{
+		DeleteFunc: func(ctx context.Context, _ event.TypedDeleteEvent[client.Object], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			cloudList := &orcv1alpha1.OpenStackCloudList{}
+			if err := mgr.GetClient().List(ctx, cloudList); err != nil {
+				ctrl.LoggerFrom(ctx).Error(err, "listing OpenStackClouds")
+				return
+			}
+			for i := range cloudList.Items {
+				if !cloudList.Items[i].GetDeletionTimestamp().IsZero() {
+					q.Add(reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&cloudList.Items[i])})
+				}
+			}
+		},
+	}
+
+	err = builder.ControllerManagedBy(mgr).
+		For(&orcv1alpha1.OpenStackCloud{}, builder.WithPredicates(hasFinalizerAndIsDeleted(d.inheritedFinalizer))).
+		Watches(objSpecimen, enqueueDeletedClouds).
+		Named(controllerName).
+		Complete(deletionGuard)
+	if err != nil {
+		return fmt.Errorf("failed to construct %s controller: %w", controllerName, err)
+	}
+	return nil
+}
+
+// addOpenStackCloudSecretDeletionGuard adds a controller which removes our
+// finalizer from a secret when no object inherits its credentials from an
+// OpenStackCloud which references it.
+//
+// Unlike other deletion guards, the finalizer is removed as soon as the secret
+// is no longer used rather than when it is deleted. The secret may be in a
+// namespace which is not watched by the manager, in which case we never
+// receive an event when it is deleted.
+func (d *Dependency[objectTP, _, objectT, _]) addOpenStackCloudSecretDeletionGuard(mgr ctrl.Manager) error {
+	objSpecimen := objectTP(new(objectT))
+	controllerName, err := guardControllerName(mgr, "openstackcloud_credentials", objSpecimen)
+	if err != nil {
+		return err
+	}
+
+	deletionGuard := reconcile.Func(func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+		log := ctrl.LoggerFrom(ctx, "name", req.Name, "namespace", req.Namespace)
+		log.V(logging.Verbose).Info("Reconciling deletion guard")
+
+		k8sClient := mgr.GetClient()
+
+		secret := &corev1.Secret{}
+		if err := k8sClient.Get(ctx, req.NamespacedName, secret); err != nil {
+			return ctrl.Result{}, client.IgnoreNotFound(err)
+		}
+		if !slices.Contains(secret.GetFinalizers(), d.inheritedFinalizer) {
+			return ctrl.Result{}, nil
+		}
+
+		clouds, err := getOpenStackCloudsForSecret(ctx, k8sClient, secret)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		for i := range clouds {
+			objects, err := d.getObjectsForOpenStackCloud(ctx, k8sClient, &clouds[i])
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			if len(objects) > 0 {
+				log.V(logging.Verbose).Info("Waiting for dependencies", "openstackcloud", clouds[i].Name, "dependencies", len(objects))
+				return ctrl.Result{}, nil
+			}
+		}
+
+		log.V(logging.Verbose).Info("Removing finalizer")
+		return ctrl.Result{}, k8sClient.Patch(ctx, secret, finalizers.RemoveFinalizerPatch(secret), client.ForceOwnership, d.inheritedFieldOwner)
+	})
+
+	// When an inheriting object is deleted, reconcile the secret of every
+	// OpenStackCloud in case it was the last object using it
+	enqueueCloudSecrets := handler.Funcs{
+		DeleteFunc: func(ctx context.Context, _ event.TypedDeleteEvent[client.Object], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			cloudList := &orcv1alpha1.OpenStackCloudList{}
+			if err := mgr.GetClient().List(ctx, cloudList); err != nil {
+				ctrl.LoggerFrom(ctx).Error(err, "listing OpenStackClouds")
+				return
+			}
+			for i := range cloudList.Items {
+				q.Add(reconcile.Request{NamespacedName: types.NamespacedName{
+					Namespace: cloudList.Items[i].Spec.SecretRef.Namespace,
+					Name:      string(cloudList.Items[i].Spec.SecretRef.Name),
+				}})
+			}
+		},
+	}
+
+	// When an OpenStackCloud no longer references the secret, it may no
+	// longer be in use
+	enqueueCloudSecret := enqueueForOpenStackCloud(func(_ context.Context, cloud *orcv1alpha1.OpenStackCloud) []reconcile.Request {
+		return []reconcile.Request{{NamespacedName: types.NamespacedName{
+			Namespace: cloud.Spec.SecretRef.Namespace,
+			Name:      string(cloud.Spec.SecretRef.Name),
+		}}}
+	})
+
+	err = builder.ControllerManagedBy(mgr).
+		For(&corev1.Secret{}, builder.WithPredicates(hasFinalizerAndIsDeleted(d.inheritedFinalizer))).
+		Watches(objSpecimen, enqueueCloudSecrets).
+		Watches(&orcv1alpha1.OpenStackCloud{}, enqueueCloudSecret).
+		Named(controllerName).
+		Complete(deletionGuard)
+	if err != nil {
+		return fmt.Errorf("failed to construct %s controller: %w", controllerName, err)
+	}
+	return nil
+}
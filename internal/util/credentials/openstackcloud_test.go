@@ -0,0 +1,250 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package credentials
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/progress"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dependency"
+	orcerrors "github.com/k-orc/openstack-resource-controller/v2/internal/util/errors"
+)
+
+const testFinalizer = "openstack.k-orc.cloud/network"
+
+var testDependency = NewDependency(
+	dependency.NewDeletionGuardDependency[*orcv1alpha1.NetworkList, *corev1.Secret](
+		"spec.cloudCredentialsRef.secretName",
+		func(obj *orcv1alpha1.Network) []string {
+			if obj.Spec.CloudCredentialsRef == nil {
+				return nil
+			}
+			return []string{obj.Spec.CloudCredentialsRef.SecretName}
+		},
+		testFinalizer, "openstack.k-orc.cloud/networkcontroller",
+	),
+	"network",
+)
+
+func newTestClient(objects ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	_ = orcv1alpha1.AddToScheme(scheme)
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(&orcv1alpha1.Network{}).
+		WithIndex(&orcv1alpha1.Network{}, inheritedCredentialsIndex, func(obj client.Object) []string {
+			if obj.(*orcv1alpha1.Network).Spec.CloudCredentialsRef != nil {
+				return nil
+			}
+			return []string{"true"}
+		}).
+		// The cache used by the manager populates TypeMeta, which is
+		// required to construct apply patches. The fake client does not.
+		WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(ctx context.Context, c client.WithWatch, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+				if err := c.Get(ctx, key, obj, opts...); err != nil {
+					return err
+				}
+				return setGVK(c.Scheme(), obj)
+			},
+			List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+				if err := c.List(ctx, list, opts...); err != nil {
+					return err
+				}
+				return meta.EachListItem(list, func(obj runtime.Object) error {
+					return setGVK(c.Scheme(), obj)
+				})
+			},
+		}).
+		Build()
+}
+
+func setGVK(scheme *runtime.Scheme, obj runtime.Object) error {
+	gvk, err := apiutil.GVKForObject(obj, scheme)
+	if err != nil {
+		return err
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	return nil
+}
+
+func testNetwork(namespace, name string, credentialsRef *orcv1alpha1.CloudCredentialsReference) *orcv1alpha1.Network {
+	return &orcv1alpha1.Network{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec:       orcv1alpha1.NetworkSpec{CloudCredentialsRef: credentialsRef},
+	}
+}
+
+func testOpenStackCloud() *orcv1alpha1.OpenStackCloud {
+	return &orcv1alpha1.OpenStackCloud{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Spec: orcv1alpha1.OpenStackCloudSpec{
+			SecretRef: orcv1alpha1.OpenStackCloudSecretReference{Namespace: "orc-system", Name: "cloud-credentials"},
+			AllowedNamespaces: orcv1alpha1.OpenStackCloudAllowedNamespaces{
+				Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"cloud": "default"}},
+			},
+		},
+	}
+}
+
+func testNamespace(name string, labels map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+}
+
+func TestGetDependenciesInherited(t *testing.T) {
+	t.Parallel()
+
+	cloudSecret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "orc-system", Name: "cloud-credentials"}}
+	network := testNetwork("team-a", "network", nil)
+	k8sClient := newTestClient(
+		testOpenStackCloud(), cloudSecret, network,
+		testNamespace("team-a", map[string]string{"cloud": "default"}),
+	)
+
+	secrets, rs := testDependency.GetDependencies(context.TODO(), k8sClient, network, func(*corev1.Secret) bool { return true })
+	if needsReschedule, err := rs.NeedsReschedule(); needsReschedule {
+		t.Fatalf("unexpected reschedule: %v", err)
+	}
+	if _, ok := secrets["cloud-credentials"]; !ok || len(secrets) != 1 {
+		t.Errorf("got secrets %v, want cloud-credentials", secrets)
+	}
+
+	for _, obj := range []client.Object{&orcv1alpha1.OpenStackCloud{}, &corev1.Secret{}} {
+		key := client.ObjectKey{Name: "default"}
+		if _, ok := obj.(*corev1.Secret); ok {
+			key = client.ObjectKeyFromObject(cloudSecret)
+		}
+		if err := k8sClient.Get(context.TODO(), key, obj); err != nil {
+			t.Fatal(err)
+		}
+		if !slices.Contains(obj.GetFinalizers(), testDependency.inheritedFinalizer) {
+			t.Errorf("%T %s does not have finalizer %s: %v", obj, key, testDependency.inheritedFinalizer, obj.GetFinalizers())
+		}
+	}
+}
+
+// TestGetDependenciesRelabelledNamespace verifies that an object whose
+// namespace is relabelled does not switch to the credentials of another
+// OpenStackCloud once it has an OpenStack resource.
+func TestGetDependenciesRelabelledNamespace(t *testing.T) {
+	t.Parallel()
+
+	otherCloud := testOpenStackCloud()
+	otherCloud.Name = "other"
+	otherCloud.Spec.SecretRef.Name = "other-credentials"
+	otherCloud.Spec.AllowedNamespaces.Selector = &metav1.LabelSelector{MatchLabels: map[string]string{"cloud": "other"}}
+
+	k8sClient := newTestClient(
+		testOpenStackCloud(), otherCloud,
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "orc-system", Name: "cloud-credentials"}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "orc-system", Name: "other-credentials"}},
+		testNetwork("team-a", "existing", nil),
+		testNetwork("team-a", "new", nil),
+		testNamespace("team-a", map[string]string{"cloud": "default"}),
+	)
+
+	getDependencies := func(name string) (*orcv1alpha1.Network, progress.ReconcileStatus) {
+		t.Helper()
+
+		network := &orcv1alpha1.Network{}
+		if err := k8sClient.Get(context.TODO(), client.ObjectKey{Namespace: "team-a", Name: name}, network); err != nil {
+			t.Fatal(err)
+		}
+		_, rs := testDependency.GetDependencies(context.TODO(), k8sClient, network, func(*corev1.Secret) bool { return true })
+		if err := k8sClient.Get(context.TODO(), client.ObjectKeyFromObject(network), network); err != nil {
+			t.Fatal(err)
+		}
+		return network, rs
+	}
+
+	network, rs := getDependencies("existing")
+	if needsReschedule, err := rs.NeedsReschedule(); needsReschedule {
+		t.Fatalf("unexpected reschedule: %v", err)
+	}
+	if got := ptr.Deref(network.Status.OpenStackCloudName, ""); got != "default" {
+		t.Fatalf("got status.openStackCloudName %q, want default", got)
+	}
+
+	network.Status.ID = ptr.To("network-id")
+	if err := k8sClient.Status().Update(context.TODO(), network); err != nil {
+		t.Fatal(err)
+	}
+
+	namespace := &corev1.Namespace{}
+	if err := k8sClient.Get(context.TODO(), client.ObjectKey{Name: "team-a"}, namespace); err != nil {
+		t.Fatal(err)
+	}
+	namespace.Labels = map[string]string{"cloud": "other"}
+	if err := k8sClient.Update(context.TODO(), namespace); err != nil {
+		t.Fatal(err)
+	}
+
+	network, rs = getDependencies("existing")
+	var terminalError *orcerrors.TerminalError
+	if err := rs.GetError(); !errors.As(err, &terminalError) || terminalError.Reason != orcv1alpha1.ConditionReasonInvalidConfiguration {
+		t.Errorf("got error %v, want terminal %s error", err, orcv1alpha1.ConditionReasonInvalidConfiguration)
+	}
+	if got := ptr.Deref(network.Status.OpenStackCloudName, ""); got != "default" {
+		t.Errorf("got status.openStackCloudName %q after relabelling, want default", got)
+	}
+
+	// An object which does not have an OpenStack resource yet uses the
+	// OpenStackCloud which now allows the namespace
+	network, rs = getDependencies("new")
+	if needsReschedule, err := rs.NeedsReschedule(); needsReschedule {
+		t.Fatalf("unexpected reschedule: %v", err)
+	}
+	if got := ptr.Deref(network.Status.OpenStackCloudName, ""); got != "other" {
+		t.Errorf("got status.openStackCloudName %q, want other", got)
+	}
+}
+
+func TestGetObjectsForOpenStackCloud(t *testing.T) {
+	t.Parallel()
+
+	k8sClient := newTestClient(
+		testNamespace("team-a", map[string]string{"cloud": "default"}),
+		testNamespace("team-b", map[string]string{"cloud": "other"}),
+		testNetwork("team-a", "inherited", nil),
+		testNetwork("team-a", "explicit", &orcv1alpha1.CloudCredentialsReference{SecretName: "credentials"}),
+		testNetwork("team-b", "other-cloud", nil),
+	)
+
+	objects, err := testDependency.getObjectsForOpenStackCloud(context.TODO(), k8sClient, testOpenStackCloud())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(objects) != 1 || objects[0].Namespace != "team-a" || objects[0].Name != "inherited" {
+		t.Errorf("got objects %v, want team-a/inherited", objects)
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dryrun prevents OpenStack requests which would modify a resource
+// from being sent on behalf of an object in dry run mode.
+//
+// A reconcile in dry run mode executes with a context returned by WithDryRun.
+// The scope's RoundTripper does not send any request made with this context
+// which may modify a resource, and instead returns an *Error describing the
+// request. The generic reconciler reports the Error as an action which was
+// not performed.
+//
+// Actuators should describe the change they are about to make with WithAction
+// before calling OpenStack, so that the reported action is meaningful to the
+// user. Otherwise the action is described by the request's method and path.
+package dryrun
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type contextKey struct{}
+
+type actionContextKey struct{}
+
+// WithDryRun returns a context in which OpenStack requests which may modify a
+// resource are not sent.
+func WithDryRun(ctx context.Context) context.Context {
+	return context.WithValue(ctx, contextKey{}, true)
+}
+
+// IsDryRun returns true if ctx was returned by WithDryRun.
+func IsDryRun(ctx context.Context) bool {
+	dryRun, _ := ctx.Value(contextKey{}).(bool)
+	return dryRun
+}
+
+// WithAction returns a context in which OpenStack requests which are not sent
+// in dry run mode are reported as action, e.g. "update OpenStack server name".
+// It has no effect outside dry run mode.
+func WithAction(ctx context.Context, action string) context.Context {
+	return context.WithValue(ctx, actionContextKey{}, action)
+}
+
+func actionFromContext(ctx context.Context) string {
+	action, _ := ctx.Value(actionContextKey{}).(string)
+	return action
+}
+
+// Error is returned for an OpenStack request which was not sent because it
+// was made in dry run mode.
+type Error struct {
+	Method string
+	Path   string
+
+	// Description is the action set with WithAction, if any.
+	Description string
+}
+
+func (e *Error) Error() string {
+	return "not sending " + e.Method + " " + e.Path + " in dry run mode"
+}
+
+// Action returns a description of the request which was not sent.
+func (e *Error) Action() string {
+	if e.Description != "" {
+		return "Would " + e.Description
+	}
+	return fmt.Sprintf("Would send %s %s", e.Method, e.Path)
+}
+
+// Allowed returns true if request may be sent in dry run mode, because it
+// does not modify an OpenStack resource. Authentication requests are allowed
+// because they are required to read resources.
+func Allowed(request *http.Request) bool {
+	switch request.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	case http.MethodPost:
+		// Keystone password, token and application credential
+		// authentication, and federated authentication
+		path := strings.TrimSuffix(request.URL.Path, "/")
+		return strings.HasSuffix(path, "/auth/tokens") ||
+			(strings.Contains(path, "/OS-FEDERATION/") && strings.HasSuffix(path, "/auth"))
+	default:
+		return false
+	}
+}
+
+// CheckRequest returns an *Error if request must not be sent because its
+// context is in dry run mode.
+func CheckRequest(request *http.Request) error {
+	if !IsDryRun(request.Context()) || Allowed(request) {
+		return nil
+	}
+	return &Error{
+		Method:      request.Method,
+		Path:        request.URL.Path,
+		Description: actionFromContext(request.Context()),
+	}
+}
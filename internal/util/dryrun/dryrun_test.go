@@ -0,0 +1,101 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dryrun
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestCheckRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		method  string
+		url     string
+		allowed bool
+	}{
+		{name: "get", method: http.MethodGet, url: "https://cloud.example.com:9696/v2.0/networks", allowed: true},
+		{name: "head", method: http.MethodHead, url: "https://cloud.example.com/image/v2/images/id", allowed: true},
+		{name: "keystone auth", method: http.MethodPost, url: "https://cloud.example.com:5000/v3/auth/tokens", allowed: true},
+		{name: "federated auth", method: http.MethodPost, url: "https://cloud.example.com:5000/v3/OS-FEDERATION/identity_providers/idp/protocols/openid/auth", allowed: true},
+		{name: "create", method: http.MethodPost, url: "https://cloud.example.com:9696/v2.0/networks", allowed: false},
+		{name: "action", method: http.MethodPost, url: "https://cloud.example.com/compute/v2.1/servers/id/action", allowed: false},
+		{name: "update", method: http.MethodPut, url: "https://cloud.example.com:9696/v2.0/networks/id", allowed: false},
+		{name: "patch", method: http.MethodPatch, url: "https://cloud.example.com/image/v2/images/id", allowed: false},
+		{name: "delete", method: http.MethodDelete, url: "https://cloud.example.com:9696/v2.0/networks/id", allowed: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			request, err := http.NewRequestWithContext(WithDryRun(context.Background()), tc.method, tc.url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := CheckRequest(request); (err == nil) != tc.allowed {
+				t.Errorf("CheckRequest() = %v, allowed %t", err, tc.allowed)
+			}
+
+			// Nothing is blocked outside dry run mode
+			request, err = http.NewRequestWithContext(context.Background(), tc.method, tc.url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := CheckRequest(request); err != nil {
+				t.Errorf("CheckRequest() outside dry run mode = %v", err)
+			}
+		})
+	}
+}
+
+func TestErrorAction(t *testing.T) {
+	t.Parallel()
+
+	const url = "https://cloud.example.com/compute/v2.1/servers/id"
+
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{name: "no action", ctx: WithDryRun(context.Background()), want: "Would send PUT /compute/v2.1/servers/id"},
+		{name: "action", ctx: WithAction(WithDryRun(context.Background()), "update OpenStack server name"), want: "Would update OpenStack server name"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			request, err := http.NewRequestWithContext(tc.ctx, http.MethodPut, url, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var dryRunErr *Error
+			if !errors.As(CheckRequest(request), &dryRunErr) {
+				t.Fatalf("expected *Error")
+			}
+			if got := dryRunErr.Action(); got != tc.want {
+				t.Errorf("Action() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -21,6 +21,8 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/gophercloud/gophercloud/v2"
 )
@@ -119,7 +121,70 @@ func IsConflict(err error) bool {
 	return gophercloud.ResponseCodeIs(err, http.StatusConflict)
 }
 
+// IsPreconditionFailed returns true if err is an HTTP 412 Precondition Failed
+// response. Neutron returns this when an update specifies a revision number
+// which does not match the current revision of the resource.
+func IsPreconditionFailed(err error) bool {
+	return gophercloud.ResponseCodeIs(err, http.StatusPreconditionFailed)
+}
+
+// IsAuthenticationError returns true if err indicates that OpenStack rejected
+// our credentials: either an HTTP 401 Unauthorized response, or a failure to
+// reauthenticate after one.
+func IsAuthenticationError(err error) bool {
+	if gophercloud.ResponseCodeIs(err, http.StatusUnauthorized) {
+		return true
+	}
+
+	var errUnableToReauthenticate *gophercloud.ErrUnableToReauthenticate
+	if errors.As(err, &errUnableToReauthenticate) {
+		return true
+	}
+
+	var errAfterReauthentication *gophercloud.ErrErrorAfterReauthentication
+	return errors.As(err, &errAfterReauthentication) &&
+		gophercloud.ResponseCodeIs(errAfterReauthentication.ErrOriginal, http.StatusUnauthorized)
+}
+
 // IsNotImplementedError returns true if err is an HTTP 501 Not Implemented response.
 func IsNotImplementedError(err error) bool {
 	return gophercloud.ResponseCodeIs(err, http.StatusNotImplemented)
 }
+
+// minRetryAfter is the minimum interval returned by RetryAfter. It ensures we
+// requeue even if OpenStack asks us to retry immediately.
+const minRetryAfter = time.Second
+
+// defaultRetryAfter is the interval returned by RetryAfter if OpenStack
+// throttled a request without saying when to retry, or with a Retry-After
+// header we could not parse.
+const defaultRetryAfter = 30 * time.Second
+
+// RetryAfter returns the interval after which OpenStack asked us to retry if
+// err is an HTTP 429 Too Many Requests or 503 Service Unavailable response.
+// The Retry-After header may contain either a number of seconds or an HTTP
+// date. If the response has no valid Retry-After header, RetryAfter returns a
+// default interval.
+func RetryAfter(err error) (time.Duration, bool) {
+	var errUnexpectedResponseCode gophercloud.ErrUnexpectedResponseCode
+	if !errors.As(err, &errUnexpectedResponseCode) {
+		return 0, false
+	}
+	if errUnexpectedResponseCode.Actual != http.StatusTooManyRequests &&
+		errUnexpectedResponseCode.Actual != http.StatusServiceUnavailable {
+		return 0, false
+	}
+
+	value := errUnexpectedResponseCode.ResponseHeader.Get("Retry-After")
+
+	var retryAfter time.Duration
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		retryAfter = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(value); err == nil {
+		retryAfter = time.Until(date)
+	} else {
+		retryAfter = defaultRetryAfter
+	}
+
+	return max(retryAfter, minRetryAfter), true
+}
@@ -20,6 +20,7 @@ import (
 	"fmt"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/gophercloud/gophercloud/v2"
 )
@@ -72,6 +73,11 @@ func TestIsRetryable(t *testing.T) {
 			err:  newHTTPError(http.StatusForbidden, ""),
 			want: true,
 		},
+		{
+			name: "412 Precondition Failed is retryable",
+			err:  newHTTPError(http.StatusPreconditionFailed, ""),
+			want: true,
+		},
 		{
 			name: "500 Internal Server Error is retryable",
 			err:  newHTTPError(http.StatusInternalServerError, ""),
@@ -106,3 +112,142 @@ func TestIsRetryable(t *testing.T) {
 		})
 	}
 }
+
+func TestIsPreconditionFailed(t *testing.T) {
+	if !IsPreconditionFailed(fmt.Errorf("wrapping: %w", newHTTPError(http.StatusPreconditionFailed, ""))) {
+		t.Errorf("expected 412 Precondition Failed to be detected")
+	}
+	if IsPreconditionFailed(newHTTPError(http.StatusConflict, "")) {
+		t.Errorf("expected 409 Conflict not to be detected")
+	}
+}
+
+func TestIsAuthenticationError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "nil error",
+			err:  nil,
+			want: false,
+		},
+		{
+			name: "401 Unauthorized",
+			err:  fmt.Errorf("wrapping: %w", newHTTPError(http.StatusUnauthorized, "")),
+			want: true,
+		},
+		{
+			name: "403 Forbidden",
+			err:  newHTTPError(http.StatusForbidden, ""),
+			want: false,
+		},
+		{
+			name: "unable to reauthenticate",
+			err: fmt.Errorf("wrapping: %w", &gophercloud.ErrUnableToReauthenticate{
+				ErrOriginal: newHTTPError(http.StatusUnauthorized, ""),
+				ErrReauth:   newHTTPError(http.StatusUnauthorized, ""),
+			}),
+			want: true,
+		},
+		{
+			name: "401 Unauthorized after reauthentication",
+			err:  &gophercloud.ErrErrorAfterReauthentication{ErrOriginal: newHTTPError(http.StatusUnauthorized, "")},
+			want: true,
+		},
+		{
+			name: "404 Not Found after reauthentication",
+			err:  &gophercloud.ErrErrorAfterReauthentication{ErrOriginal: newHTTPError(http.StatusNotFound, "")},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsAuthenticationError(tt.err); got != tt.want {
+				t.Errorf("IsAuthenticationError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func newRetryAfterError(statusCode int, retryAfter string) error {
+	return gophercloud.ErrUnexpectedResponseCode{
+		Actual:         statusCode,
+		ResponseHeader: http.Header{"Retry-After": []string{retryAfter}},
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		err    error
+		want   time.Duration
+		wantOK bool
+	}{
+		{
+			name:   "429 with seconds",
+			err:    newRetryAfterError(http.StatusTooManyRequests, "30"),
+			want:   30 * time.Second,
+			wantOK: true,
+		},
+		{
+			name:   "wrapped 503 with seconds",
+			err:    fmt.Errorf("wrapping: %w", newRetryAfterError(http.StatusServiceUnavailable, "120")),
+			want:   2 * time.Minute,
+			wantOK: true,
+		},
+		{
+			name:   "429 with zero seconds is requeued after the minimum",
+			err:    newRetryAfterError(http.StatusTooManyRequests, "0"),
+			want:   minRetryAfter,
+			wantOK: true,
+		},
+		{
+			name:   "429 with a past date is requeued after the minimum",
+			err:    newRetryAfterError(http.StatusTooManyRequests, "Wed, 21 Oct 2015 07:28:00 GMT"),
+			want:   minRetryAfter,
+			wantOK: true,
+		},
+		{
+			name:   "429 without Retry-After is requeued after the default",
+			err:    newHTTPError(http.StatusTooManyRequests, ""),
+			want:   defaultRetryAfter,
+			wantOK: true,
+		},
+		{
+			name:   "503 without Retry-After is requeued after the default",
+			err:    newHTTPError(http.StatusServiceUnavailable, ""),
+			want:   defaultRetryAfter,
+			wantOK: true,
+		},
+		{
+			name:   "429 with invalid Retry-After is requeued after the default",
+			err:    newRetryAfterError(http.StatusTooManyRequests, "soon"),
+			want:   defaultRetryAfter,
+			wantOK: true,
+		},
+		{
+			name:   "429 with negative Retry-After is requeued after the default",
+			err:    newRetryAfterError(http.StatusTooManyRequests, "-1"),
+			want:   defaultRetryAfter,
+			wantOK: true,
+		},
+		{
+			name: "500 with Retry-After",
+			err:  newRetryAfterError(http.StatusInternalServerError, "30"),
+		},
+		{
+			name: "non-HTTP error",
+			err:  fmt.Errorf("some error"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := RetryAfter(tt.err)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("RetryAfter() = %v, %v, want %v, %v", got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
@@ -28,6 +28,11 @@ const (
 	// Field owner of the object finalizer.
 	SSATransactionFinalizer SSATransactionID = "finalizer"
 	SSATransactionStatus    SSATransactionID = "status"
+	// Field owner of credentials written to a secret.
+	SSATransactionCredentials SSATransactionID = "credentials"
+	// Field owner of the finalizer of an OpenStackCloud, and of its secret,
+	// added by objects which inherit its credentials.
+	SSATransactionOpenStackCloud SSATransactionID = "openstackcloud"
 )
 
 func getSSAFieldOwnerString(controllerName string) string {
@@ -26,6 +26,7 @@ import (
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/interfaces"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/controllers/generic/progress"
 	"github.com/k-orc/openstack-resource-controller/v2/internal/osclients"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/util/dryrun"
 	"k8s.io/utils/set"
 )
 
@@ -65,7 +66,9 @@ func ReconcileTags[orcObjectPT, osResourceT any, T StringTag](
 		}
 
 		// Tags are out of sync, call the API to replace them.
-		err := tagReplacer(ctx, specTagSet.SortedList())
+		desiredTags := specTagSet.SortedList()
+		ctx = dryrun.WithAction(ctx, "set tags to "+strings.Join(desiredTags, ","))
+		err := tagReplacer(ctx, desiredTags)
 		if err != nil {
 			return progress.WrapError(err)
 		}
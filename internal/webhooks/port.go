@@ -0,0 +1,123 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+)
+
+// +kubebuilder:webhook:path=/validate-openstack-k-orc-cloud-v1alpha1-port,mutating=false,failurePolicy=fail,sideEffects=None,groups=openstack.k-orc.cloud,resources=ports,verbs=create;update,versions=v1alpha1,name=vport-v1alpha1.openstack.k-orc.cloud,admissionReviewVersions=v1
+
+// portValidator rejects a Port with a fixed IP which is not in the CIDR of the
+// Subnet it references.
+type portValidator struct {
+	client client.Reader
+}
+
+var _ admission.CustomValidator = &portValidator{}
+
+func (*portValidator) GetName() string {
+	return "port"
+}
+
+func (v *portValidator) SetupWithManager(mgr ctrl.Manager) error {
+	v.client = mgr.GetClient()
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&orcv1alpha1.Port{}).
+		WithValidator(v).
+		Complete()
+}
+
+func (v *portValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	port, ok := obj.(*orcv1alpha1.Port)
+	if !ok {
+		return nil, fmt.Errorf("expected a Port but got %T", obj)
+	}
+	return nil, v.validate(ctx, port)
+}
+
+func (v *portValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldPort, ok := oldObj.(*orcv1alpha1.Port)
+	if !ok {
+		return nil, fmt.Errorf("expected a Port but got %T", oldObj)
+	}
+	port, ok := newObj.(*orcv1alpha1.Port)
+	if !ok {
+		return nil, fmt.Errorf("expected a Port but got %T", newObj)
+	}
+
+	if equality.Semantic.DeepEqual(oldPort.Spec, port.Spec) {
+		return nil, nil
+	}
+	return nil, v.validate(ctx, port)
+}
+
+func (*portValidator) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *portValidator) validate(ctx context.Context, port *orcv1alpha1.Port) error {
+	resource := port.Spec.Resource
+	if resource == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	for i := range resource.Addresses {
+		address := &resource.Addresses[i]
+		if address.IP == nil {
+			continue
+		}
+
+		// The format of the IP is validated by the CRD
+		ip, err := netip.ParseAddr(string(*address.IP))
+		if err != nil {
+			continue
+		}
+
+		subnet := &orcv1alpha1.Subnet{}
+		if found, err := getOptional(ctx, v.client, port.Namespace, address.SubnetRef, subnet); err != nil {
+			return fmt.Errorf("fetching subnet %s: %w", address.SubnetRef, err)
+		} else if !found {
+			continue
+		}
+
+		cidr := subnetCIDR(subnet)
+		prefix, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			continue
+		}
+
+		if !prefix.Contains(ip) {
+			errs = append(errs, field.Invalid(field.NewPath("spec", "resource", "addresses").Index(i).Child("ip"), *address.IP,
+				fmt.Sprintf("not in cidr %s of subnet %s", cidr, address.SubnetRef)))
+		}
+	}
+
+	return invalid("Port", port.Name, errs)
+}
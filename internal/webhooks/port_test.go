@@ -0,0 +1,90 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+)
+
+func TestPortValidator(t *testing.T) {
+	t.Parallel()
+
+	subnet := newSubnet("subnet", "network", "192.168.0.0/24")
+
+	newPort := func(ip, subnetRef string) *orcv1alpha1.Port {
+		address := orcv1alpha1.Address{SubnetRef: orcv1alpha1.KubernetesNameRef(subnetRef)}
+		if ip != "" {
+			address.IP = ptr.To(orcv1alpha1.IPvAny(ip))
+		}
+		return &orcv1alpha1.Port{
+			ObjectMeta: metav1.ObjectMeta{Name: "port", Namespace: testNamespace},
+			Spec: orcv1alpha1.PortSpec{
+				Resource: &orcv1alpha1.PortResourceSpec{
+					NetworkRef: "network",
+					Addresses:  []orcv1alpha1.Address{address},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		port    *orcv1alpha1.Port
+		wantErr bool
+	}{
+		{
+			name: "ip in subnet",
+			port: newPort("192.168.0.10", "subnet"),
+		},
+		{
+			name: "no ip",
+			port: newPort("", "subnet"),
+		},
+		{
+			name: "subnet does not exist yet",
+			port: newPort("10.0.0.10", "missing"),
+		},
+		{
+			name:    "ip not in subnet",
+			port:    newPort("10.0.0.10", "subnet"),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			validator := &portValidator{client: newFakeClient(subnet)}
+			_, err := validator.ValidateCreate(context.Background(), tc.port)
+			if tc.wantErr {
+				if !apierrors.IsInvalid(err) {
+					t.Errorf("expected an Invalid error, got %v", err)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
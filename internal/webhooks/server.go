@@ -0,0 +1,142 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+)
+
+// +kubebuilder:webhook:path=/validate-openstack-k-orc-cloud-v1alpha1-server,mutating=false,failurePolicy=fail,sideEffects=None,groups=openstack.k-orc.cloud,resources=servers,verbs=create;update,versions=v1alpha1,name=vserver-v1alpha1.openstack.k-orc.cloud,admissionReviewVersions=v1
+
+// serverValidator rejects a Server which references Ports in different
+// projects.
+type serverValidator struct {
+	client client.Reader
+}
+
+var _ admission.CustomValidator = &serverValidator{}
+
+func (*serverValidator) GetName() string {
+	return "server"
+}
+
+func (v *serverValidator) SetupWithManager(mgr ctrl.Manager) error {
+	v.client = mgr.GetClient()
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&orcv1alpha1.Server{}).
+		WithValidator(v).
+		Complete()
+}
+
+func (v *serverValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	server, ok := obj.(*orcv1alpha1.Server)
+	if !ok {
+		return nil, fmt.Errorf("expected a Server but got %T", obj)
+	}
+	return nil, v.validate(ctx, server)
+}
+
+func (v *serverValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldServer, ok := oldObj.(*orcv1alpha1.Server)
+	if !ok {
+		return nil, fmt.Errorf("expected a Server but got %T", oldObj)
+	}
+	server, ok := newObj.(*orcv1alpha1.Server)
+	if !ok {
+		return nil, fmt.Errorf("expected a Server but got %T", newObj)
+	}
+
+	if equality.Semantic.DeepEqual(oldServer.Spec, server.Spec) {
+		return nil, nil
+	}
+	return nil, v.validate(ctx, server)
+}
+
+func (*serverValidator) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *serverValidator) validate(ctx context.Context, server *orcv1alpha1.Server) error {
+	resource := server.Spec.Resource
+	if resource == nil {
+		return nil
+	}
+
+	var errs field.ErrorList
+	var firstPortRef orcv1alpha1.KubernetesNameRef
+	var firstProjectID string
+	for i := range resource.Ports {
+		portRef := resource.Ports[i].PortRef
+		if portRef == nil {
+			continue
+		}
+
+		port := &orcv1alpha1.Port{}
+		if found, err := getOptional(ctx, v.client, server.Namespace, *portRef, port); err != nil {
+			return fmt.Errorf("fetching port %s: %w", *portRef, err)
+		} else if !found {
+			continue
+		}
+
+		projectID, err := v.portProjectID(ctx, port)
+		if err != nil {
+			return err
+		}
+		if projectID == "" {
+			continue
+		}
+
+		if firstProjectID == "" {
+			firstPortRef, firstProjectID = *portRef, projectID
+		} else if projectID != firstProjectID {
+			errs = append(errs, field.Invalid(field.NewPath("spec", "resource", "ports").Index(i).Child("portRef"), *portRef,
+				fmt.Sprintf("port is in project %s but port %s is in project %s", projectID, firstPortRef, firstProjectID)))
+		}
+	}
+
+	return invalid("Server", server.Name, errs)
+}
+
+// portProjectID returns the ID of the project of a Port as reported by
+// OpenStack, or of the Project it references if the Port has not been created
+// yet. It returns an empty string if the project is not known.
+func (v *serverValidator) portProjectID(ctx context.Context, port *orcv1alpha1.Port) (string, error) {
+	if port.Status.Resource != nil && port.Status.Resource.ProjectID != "" {
+		return port.Status.Resource.ProjectID, nil
+	}
+	if port.Spec.Resource == nil || port.Spec.Resource.ProjectRef == nil {
+		return "", nil
+	}
+
+	project := &orcv1alpha1.Project{}
+	if found, err := getOptional(ctx, v.client, port.Namespace, *port.Spec.Resource.ProjectRef, project); err != nil {
+		return "", fmt.Errorf("fetching project %s: %w", *port.Spec.Resource.ProjectRef, err)
+	} else if !found || project.Status.ID == nil {
+		return "", nil
+	}
+	return *project.Status.ID, nil
+}
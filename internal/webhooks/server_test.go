@@ -0,0 +1,101 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+)
+
+func TestServerValidator(t *testing.T) {
+	t.Parallel()
+
+	project := &orcv1alpha1.Project{
+		ObjectMeta: metav1.ObjectMeta{Name: "project-b", Namespace: testNamespace},
+		Status:     orcv1alpha1.ProjectStatus{ID: ptr.To("b")},
+	}
+	portInProjectA := &orcv1alpha1.Port{
+		ObjectMeta: metav1.ObjectMeta{Name: "port-a", Namespace: testNamespace},
+		Status: orcv1alpha1.PortStatus{
+			Resource: &orcv1alpha1.PortResourceStatus{ProjectID: "a"},
+		},
+	}
+	otherPortInProjectA := portInProjectA.DeepCopy()
+	otherPortInProjectA.Name = "other-port-a"
+	portInProjectB := &orcv1alpha1.Port{
+		ObjectMeta: metav1.ObjectMeta{Name: "port-b", Namespace: testNamespace},
+		Spec: orcv1alpha1.PortSpec{
+			Resource: &orcv1alpha1.PortResourceSpec{ProjectRef: ptr.To[orcv1alpha1.KubernetesNameRef]("project-b")},
+		},
+	}
+
+	newServer := func(portRefs ...string) *orcv1alpha1.Server {
+		server := &orcv1alpha1.Server{
+			ObjectMeta: metav1.ObjectMeta{Name: "server", Namespace: testNamespace},
+			Spec: orcv1alpha1.ServerSpec{
+				Resource: &orcv1alpha1.ServerResourceSpec{},
+			},
+		}
+		for _, portRef := range portRefs {
+			server.Spec.Resource.Ports = append(server.Spec.Resource.Ports,
+				orcv1alpha1.ServerPortSpec{PortRef: ptr.To(orcv1alpha1.KubernetesNameRef(portRef))})
+		}
+		return server
+	}
+
+	tests := []struct {
+		name    string
+		server  *orcv1alpha1.Server
+		wantErr bool
+	}{
+		{
+			name:   "ports in the same project",
+			server: newServer("port-a", "other-port-a"),
+		},
+		{
+			name:   "port does not exist yet",
+			server: newServer("port-a", "missing"),
+		},
+		{
+			name:    "ports in different projects",
+			server:  newServer("port-a", "port-b"),
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			validator := &serverValidator{client: newFakeClient(project, portInProjectA, otherPortInProjectA, portInProjectB)}
+			_, err := validator.ValidateCreate(context.Background(), tc.server)
+			if tc.wantErr {
+				if !apierrors.IsInvalid(err) {
+					t.Errorf("expected an Invalid error, got %v", err)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
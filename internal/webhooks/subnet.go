@@ -0,0 +1,158 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+)
+
+// +kubebuilder:webhook:path=/validate-openstack-k-orc-cloud-v1alpha1-subnet,mutating=false,failurePolicy=fail,sideEffects=None,groups=openstack.k-orc.cloud,resources=subnets,verbs=create;update,versions=v1alpha1,name=vsubnet-v1alpha1.openstack.k-orc.cloud,admissionReviewVersions=v1
+
+// subnetValidator rejects a Subnet whose CIDR overlaps the CIDR of another
+// Subnet on the same Network.
+//
+// Only Subnets in the same namespace which reference the same ORC Network are
+// considered. Overlaps with subnets which are not managed or imported by ORC,
+// or which belong to a Subnet in another namespace, are not detected here and
+// are reported by the controller when Neutron rejects the subnet.
+type subnetValidator struct {
+	client client.Reader
+}
+
+var _ admission.CustomValidator = &subnetValidator{}
+
+func (*subnetValidator) GetName() string {
+	return "subnet"
+}
+
+func (v *subnetValidator) SetupWithManager(mgr ctrl.Manager) error {
+	v.client = mgr.GetClient()
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&orcv1alpha1.Subnet{}).
+		WithValidator(v).
+		Complete()
+}
+
+func (v *subnetValidator) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	subnet, ok := obj.(*orcv1alpha1.Subnet)
+	if !ok {
+		return nil, fmt.Errorf("expected a Subnet but got %T", obj)
+	}
+	return nil, v.validate(ctx, subnet)
+}
+
+func (v *subnetValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	oldSubnet, ok := oldObj.(*orcv1alpha1.Subnet)
+	if !ok {
+		return nil, fmt.Errorf("expected a Subnet but got %T", oldObj)
+	}
+	subnet, ok := newObj.(*orcv1alpha1.Subnet)
+	if !ok {
+		return nil, fmt.Errorf("expected a Subnet but got %T", newObj)
+	}
+
+	// Don't block metadata updates, e.g. removing finalizers, of an object
+	// which was admitted before a conflicting object was created
+	if equality.Semantic.DeepEqual(oldSubnet.Spec, subnet.Spec) {
+		return nil, nil
+	}
+	return nil, v.validate(ctx, subnet)
+}
+
+func (*subnetValidator) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+func (v *subnetValidator) validate(ctx context.Context, subnet *orcv1alpha1.Subnet) error {
+	resource := subnet.Spec.Resource
+	if resource == nil {
+		return nil
+	}
+
+	// The format of the CIDR is validated by the CRD
+	prefix, err := netip.ParsePrefix(string(resource.CIDR))
+	if err != nil {
+		return nil
+	}
+
+	subnetList := &orcv1alpha1.SubnetList{}
+	if err := v.client.List(ctx, subnetList, client.InNamespace(subnet.Namespace)); err != nil {
+		return fmt.Errorf("listing subnets: %w", err)
+	}
+
+	var errs field.ErrorList
+	for i := range subnetList.Items {
+		other := &subnetList.Items[i]
+		if other.Name == subnet.Name || subnetNetworkRef(other) != resource.NetworkRef {
+			continue
+		}
+
+		// A Subnet which is being deleted will release its CIDR
+		if !other.DeletionTimestamp.IsZero() {
+			continue
+		}
+
+		otherCIDR := subnetCIDR(other)
+		otherPrefix, err := netip.ParsePrefix(otherCIDR)
+		if err != nil {
+			continue
+		}
+
+		if prefix.Overlaps(otherPrefix) {
+			errs = append(errs, field.Invalid(field.NewPath("spec", "resource", "cidr"), resource.CIDR,
+				fmt.Sprintf("overlaps with cidr %s of subnet %s on network %s", otherCIDR, other.Name, resource.NetworkRef)))
+		}
+	}
+
+	return invalid("Subnet", subnet.Name, errs)
+}
+
+// subnetNetworkRef returns the Network referenced by a managed or imported
+// Subnet.
+func subnetNetworkRef(subnet *orcv1alpha1.Subnet) orcv1alpha1.KubernetesNameRef {
+	if subnet.Spec.Resource != nil {
+		return subnet.Spec.Resource.NetworkRef
+	}
+	if subnet.Spec.Import != nil && subnet.Spec.Import.Filter != nil {
+		return subnet.Spec.Import.Filter.NetworkRef
+	}
+	return ""
+}
+
+// subnetCIDR returns the CIDR of a Subnet as reported by OpenStack, or as
+// specified if the Subnet has not been created yet. It returns an empty string
+// if the CIDR is not known.
+func subnetCIDR(subnet *orcv1alpha1.Subnet) string {
+	if subnet.Status.Resource != nil && subnet.Status.Resource.CIDR != "" {
+		return subnet.Status.Resource.CIDR
+	}
+	if subnet.Spec.Resource != nil {
+		return string(subnet.Spec.Resource.CIDR)
+	}
+	return ""
+}
@@ -0,0 +1,134 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	"github.com/k-orc/openstack-resource-controller/v2/internal/scheme"
+)
+
+const testNamespace = "test"
+
+func newFakeClient(objs ...client.Object) client.Client {
+	return fake.NewClientBuilder().WithScheme(scheme.New()).WithObjects(objs...).Build()
+}
+
+func newSubnet(name, networkRef, cidr string) *orcv1alpha1.Subnet {
+	return &orcv1alpha1.Subnet{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Spec: orcv1alpha1.SubnetSpec{
+			Resource: &orcv1alpha1.SubnetResourceSpec{
+				NetworkRef: orcv1alpha1.KubernetesNameRef(networkRef),
+				IPVersion:  4,
+				CIDR:       orcv1alpha1.CIDR(cidr),
+			},
+		},
+	}
+}
+
+func TestSubnetValidator(t *testing.T) {
+	t.Parallel()
+
+	existing := newSubnet("existing", "network", "192.168.0.0/24")
+	importedSubnet := &orcv1alpha1.Subnet{
+		ObjectMeta: metav1.ObjectMeta{Name: "imported", Namespace: testNamespace},
+		Spec: orcv1alpha1.SubnetSpec{
+			Import: &orcv1alpha1.SubnetImport{
+				Filter: &orcv1alpha1.SubnetFilter{NetworkRef: "network"},
+			},
+		},
+		Status: orcv1alpha1.SubnetStatus{
+			Resource: &orcv1alpha1.SubnetResourceStatus{CIDR: "10.0.0.0/16"},
+		},
+	}
+
+	deleting := newSubnet("deleting", "network", "172.16.0.0/24")
+	deleting.DeletionTimestamp = ptr.To(metav1.Now())
+	deleting.Finalizers = []string{"openstack.k-orc.cloud/subnet"}
+
+	tests := []struct {
+		name    string
+		subnet  *orcv1alpha1.Subnet
+		wantErr bool
+	}{
+		{
+			name:   "no overlap",
+			subnet: newSubnet("new", "network", "192.168.1.0/24"),
+		},
+		{
+			name:   "overlap on a different network",
+			subnet: newSubnet("new", "other-network", "192.168.0.0/24"),
+		},
+		{
+			name:    "overlap with a managed subnet",
+			subnet:  newSubnet("new", "network", "192.168.0.128/25"),
+			wantErr: true,
+		},
+		{
+			name:    "overlap with an imported subnet",
+			subnet:  newSubnet("new", "network", "10.0.4.0/24"),
+			wantErr: true,
+		},
+		{
+			name:   "update of the existing subnet",
+			subnet: existing,
+		},
+		{
+			name:   "overlap with a subnet which is being deleted",
+			subnet: newSubnet("new", "network", "172.16.0.0/24"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			validator := &subnetValidator{client: newFakeClient(existing, importedSubnet, deleting)}
+			_, err := validator.ValidateCreate(context.Background(), tc.subnet)
+			if tc.wantErr {
+				if !apierrors.IsInvalid(err) {
+					t.Errorf("expected an Invalid error, got %v", err)
+				}
+			} else if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestSubnetValidatorUpdateWithoutSpecChange(t *testing.T) {
+	t.Parallel()
+
+	existing := newSubnet("existing", "network", "192.168.0.0/24")
+	conflicting := newSubnet("conflicting", "network", "192.168.0.0/24")
+	validator := &subnetValidator{client: newFakeClient(existing, conflicting)}
+
+	updated := conflicting.DeepCopy()
+	updated.Finalizers = []string{"openstack.k-orc.cloud/subnet"}
+	if _, err := validator.ValidateUpdate(context.Background(), conflicting, updated); err != nil {
+		t.Errorf("expected metadata update to be allowed, got %v", err)
+	}
+}
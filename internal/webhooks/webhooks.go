@@ -0,0 +1,101 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhooks implements validating admission webhooks for rules which
+// cannot be expressed as CRD validations because they depend on other ORC
+// objects.
+//
+// The webhooks only validate against ORC objects which exist at admission
+// time. If a referenced object does not exist yet, or has not yet reported the
+// value being validated, the webhook admits the object and validation is left
+// to the controller.
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+)
+
+// Webhook is a validating webhook for a single kind.
+type Webhook interface {
+	// GetName returns the name used to enable the webhook, which is the
+	// lowercase name of its kind.
+	GetName() string
+
+	SetupWithManager(ctrl.Manager) error
+}
+
+// All returns every available webhook.
+func All() []Webhook {
+	return []Webhook{
+		&subnetValidator{},
+		&portValidator{},
+		&serverValidator{},
+	}
+}
+
+// SetupWithManager registers the named webhooks with mgr. It returns an error
+// if any name is not the name of an available webhook.
+func SetupWithManager(mgr ctrl.Manager, names []string) error {
+	available := All()
+	for _, name := range names {
+		i := slices.IndexFunc(available, func(w Webhook) bool { return w.GetName() == name })
+		if i < 0 {
+			return fmt.Errorf("unknown webhook %q: must be one of %s", name, strings.Join(Names(), ", "))
+		}
+		if err := available[i].SetupWithManager(mgr); err != nil {
+			return fmt.Errorf("unable to create %s webhook: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// Names returns the names of every available webhook.
+func Names() []string {
+	var names []string
+	for _, w := range All() {
+		names = append(names, w.GetName())
+	}
+	return names
+}
+
+// invalid returns an Invalid error for the named object of the given kind if
+// errs is not empty.
+func invalid(kind string, name string, errs field.ErrorList) error {
+	if len(errs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(orcv1alpha1.SchemeGroupVersion.WithKind(kind).GroupKind(), name, errs)
+}
+
+// getOptional fetches the named object in namespace into obj. It returns false
+// without error if the object does not exist.
+func getOptional(ctx context.Context, k8sClient client.Reader, namespace string, name orcv1alpha1.KubernetesNameRef, obj client.Object) (bool, error) {
+	err := k8sClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: string(name)}, obj)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
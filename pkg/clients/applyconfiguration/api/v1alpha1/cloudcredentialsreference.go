@@ -23,6 +23,7 @@ package v1alpha1
 type CloudCredentialsReferenceApplyConfiguration struct {
 	SecretName *string `json:"secretName,omitempty"`
 	CloudName  *string `json:"cloudName,omitempty"`
+	RegionName *string `json:"regionName,omitempty"`
 }
 
 // CloudCredentialsReferenceApplyConfiguration constructs a declarative configuration of the CloudCredentialsReference type for use with
@@ -46,3 +47,11 @@ func (b *CloudCredentialsReferenceApplyConfiguration) WithCloudName(value string
 	b.CloudName = &value
 	return b
 }
+
+// WithRegionName sets the RegionName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the RegionName field is set to the value of the last call.
+func (b *CloudCredentialsReferenceApplyConfiguration) WithRegionName(value string) *CloudCredentialsReferenceApplyConfiguration {
+	b.RegionName = &value
+	return b
+}
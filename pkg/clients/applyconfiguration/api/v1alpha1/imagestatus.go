@@ -30,6 +30,8 @@ type ImageStatusApplyConfiguration struct {
 	ID                                 *string                                `json:"id,omitempty"`
 	Resource                           *ImageResourceStatusApplyConfiguration `json:"resource,omitempty"`
 	LastSyncTime                       *metav1.Time                           `json:"lastSyncTime,omitempty"`
+	Drift                              []string                               `json:"drift,omitempty"`
+	OpenStackCloudName                 *string                                `json:"openStackCloudName,omitempty"`
 	ImageStatusExtraApplyConfiguration `json:",inline"`
 }
 
@@ -76,6 +78,24 @@ func (b *ImageStatusApplyConfiguration) WithLastSyncTime(value metav1.Time) *Ima
 	return b
 }
 
+// WithDrift adds the given value to the Drift field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Drift field.
+func (b *ImageStatusApplyConfiguration) WithDrift(values ...string) *ImageStatusApplyConfiguration {
+	for i := range values {
+		b.Drift = append(b.Drift, values[i])
+	}
+	return b
+}
+
+// WithOpenStackCloudName sets the OpenStackCloudName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OpenStackCloudName field is set to the value of the last call.
+func (b *ImageStatusApplyConfiguration) WithOpenStackCloudName(value string) *ImageStatusApplyConfiguration {
+	b.OpenStackCloudName = &value
+	return b
+}
+
 // WithDownloadAttempts sets the DownloadAttempts field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the DownloadAttempts field is set to the value of the last call.
@@ -25,7 +25,9 @@ import (
 // ManagedOptionsApplyConfiguration represents a declarative configuration of the ManagedOptions type for use
 // with apply.
 type ManagedOptionsApplyConfiguration struct {
-	OnDelete *apiv1alpha1.OnDelete `json:"onDelete,omitempty"`
+	OnDelete           *apiv1alpha1.OnDelete    `json:"onDelete,omitempty"`
+	DriftPolicy        *apiv1alpha1.DriftPolicy `json:"driftPolicy,omitempty"`
+	DeletionProtection *bool                    `json:"deletionProtection,omitempty"`
 }
 
 // ManagedOptionsApplyConfiguration constructs a declarative configuration of the ManagedOptions type for use with
@@ -41,3 +43,19 @@ func (b *ManagedOptionsApplyConfiguration) WithOnDelete(value apiv1alpha1.OnDele
 	b.OnDelete = &value
 	return b
 }
+
+// WithDriftPolicy sets the DriftPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DriftPolicy field is set to the value of the last call.
+func (b *ManagedOptionsApplyConfiguration) WithDriftPolicy(value apiv1alpha1.DriftPolicy) *ManagedOptionsApplyConfiguration {
+	b.DriftPolicy = &value
+	return b
+}
+
+// WithDeletionProtection sets the DeletionProtection field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the DeletionProtection field is set to the value of the last call.
+func (b *ManagedOptionsApplyConfiguration) WithDeletionProtection(value bool) *ManagedOptionsApplyConfiguration {
+	b.DeletionProtection = &value
+	return b
+}
@@ -26,9 +26,11 @@ import (
 // RoleAssignmentStatusApplyConfiguration represents a declarative configuration of the RoleAssignmentStatus type for use
 // with apply.
 type RoleAssignmentStatusApplyConfiguration struct {
-	Conditions   []v1.ConditionApplyConfiguration                `json:"conditions,omitempty"`
-	Resource     *RoleAssignmentResourceStatusApplyConfiguration `json:"resource,omitempty"`
-	LastSyncTime *metav1.Time                                    `json:"lastSyncTime,omitempty"`
+	Conditions         []v1.ConditionApplyConfiguration                `json:"conditions,omitempty"`
+	Resource           *RoleAssignmentResourceStatusApplyConfiguration `json:"resource,omitempty"`
+	LastSyncTime       *metav1.Time                                    `json:"lastSyncTime,omitempty"`
+	Drift              []string                                        `json:"drift,omitempty"`
+	OpenStackCloudName *string                                         `json:"openStackCloudName,omitempty"`
 }
 
 // RoleAssignmentStatusApplyConfiguration constructs a declarative configuration of the RoleAssignmentStatus type for use with
@@ -65,3 +67,21 @@ func (b *RoleAssignmentStatusApplyConfiguration) WithLastSyncTime(value metav1.T
 	b.LastSyncTime = &value
 	return b
 }
+
+// WithDrift adds the given value to the Drift field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Drift field.
+func (b *RoleAssignmentStatusApplyConfiguration) WithDrift(values ...string) *RoleAssignmentStatusApplyConfiguration {
+	for i := range values {
+		b.Drift = append(b.Drift, values[i])
+	}
+	return b
+}
+
+// WithOpenStackCloudName sets the OpenStackCloudName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OpenStackCloudName field is set to the value of the last call.
+func (b *RoleAssignmentStatusApplyConfiguration) WithOpenStackCloudName(value string) *RoleAssignmentStatusApplyConfiguration {
+	b.OpenStackCloudName = &value
+	return b
+}
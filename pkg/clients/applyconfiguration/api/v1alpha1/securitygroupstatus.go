@@ -26,10 +26,12 @@ import (
 // SecurityGroupStatusApplyConfiguration represents a declarative configuration of the SecurityGroupStatus type for use
 // with apply.
 type SecurityGroupStatusApplyConfiguration struct {
-	Conditions   []v1.ConditionApplyConfiguration               `json:"conditions,omitempty"`
-	ID           *string                                        `json:"id,omitempty"`
-	Resource     *SecurityGroupResourceStatusApplyConfiguration `json:"resource,omitempty"`
-	LastSyncTime *metav1.Time                                   `json:"lastSyncTime,omitempty"`
+	Conditions         []v1.ConditionApplyConfiguration               `json:"conditions,omitempty"`
+	ID                 *string                                        `json:"id,omitempty"`
+	Resource           *SecurityGroupResourceStatusApplyConfiguration `json:"resource,omitempty"`
+	LastSyncTime       *metav1.Time                                   `json:"lastSyncTime,omitempty"`
+	Drift              []string                                       `json:"drift,omitempty"`
+	OpenStackCloudName *string                                        `json:"openStackCloudName,omitempty"`
 }
 
 // SecurityGroupStatusApplyConfiguration constructs a declarative configuration of the SecurityGroupStatus type for use with
@@ -74,3 +76,21 @@ func (b *SecurityGroupStatusApplyConfiguration) WithLastSyncTime(value metav1.Ti
 	b.LastSyncTime = &value
 	return b
 }
+
+// WithDrift adds the given value to the Drift field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Drift field.
+func (b *SecurityGroupStatusApplyConfiguration) WithDrift(values ...string) *SecurityGroupStatusApplyConfiguration {
+	for i := range values {
+		b.Drift = append(b.Drift, values[i])
+	}
+	return b
+}
+
+// WithOpenStackCloudName sets the OpenStackCloudName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OpenStackCloudName field is set to the value of the last call.
+func (b *SecurityGroupStatusApplyConfiguration) WithOpenStackCloudName(value string) *SecurityGroupStatusApplyConfiguration {
+	b.OpenStackCloudName = &value
+	return b
+}
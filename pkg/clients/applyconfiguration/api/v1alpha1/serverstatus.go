@@ -26,10 +26,12 @@ import (
 // ServerStatusApplyConfiguration represents a declarative configuration of the ServerStatus type for use
 // with apply.
 type ServerStatusApplyConfiguration struct {
-	Conditions   []v1.ConditionApplyConfiguration        `json:"conditions,omitempty"`
-	ID           *string                                 `json:"id,omitempty"`
-	Resource     *ServerResourceStatusApplyConfiguration `json:"resource,omitempty"`
-	LastSyncTime *metav1.Time                            `json:"lastSyncTime,omitempty"`
+	Conditions         []v1.ConditionApplyConfiguration        `json:"conditions,omitempty"`
+	ID                 *string                                 `json:"id,omitempty"`
+	Resource           *ServerResourceStatusApplyConfiguration `json:"resource,omitempty"`
+	LastSyncTime       *metav1.Time                            `json:"lastSyncTime,omitempty"`
+	Drift              []string                                `json:"drift,omitempty"`
+	OpenStackCloudName *string                                 `json:"openStackCloudName,omitempty"`
 }
 
 // ServerStatusApplyConfiguration constructs a declarative configuration of the ServerStatus type for use with
@@ -74,3 +76,21 @@ func (b *ServerStatusApplyConfiguration) WithLastSyncTime(value metav1.Time) *Se
 	b.LastSyncTime = &value
 	return b
 }
+
+// WithDrift adds the given value to the Drift field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Drift field.
+func (b *ServerStatusApplyConfiguration) WithDrift(values ...string) *ServerStatusApplyConfiguration {
+	for i := range values {
+		b.Drift = append(b.Drift, values[i])
+	}
+	return b
+}
+
+// WithOpenStackCloudName sets the OpenStackCloudName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OpenStackCloudName field is set to the value of the last call.
+func (b *ServerStatusApplyConfiguration) WithOpenStackCloudName(value string) *ServerStatusApplyConfiguration {
+	b.OpenStackCloudName = &value
+	return b
+}
@@ -29,9 +29,13 @@ type VolumeResourceSpecApplyConfiguration struct {
 	Description      *string                            `json:"description,omitempty"`
 	Size             *int32                             `json:"size,omitempty"`
 	VolumeTypeRef    *apiv1alpha1.KubernetesNameRef     `json:"volumeTypeRef,omitempty"`
+	MigrationPolicy  *apiv1alpha1.VolumeMigrationPolicy `json:"migrationPolicy,omitempty"`
 	AvailabilityZone *string                            `json:"availabilityZone,omitempty"`
 	Metadata         []VolumeMetadataApplyConfiguration `json:"metadata,omitempty"`
 	ImageRef         *apiv1alpha1.KubernetesNameRef     `json:"imageRef,omitempty"`
+	SnapshotRef      *apiv1alpha1.KubernetesNameRef     `json:"snapshotRef,omitempty"`
+	SourceVolumeRef  *apiv1alpha1.KubernetesNameRef     `json:"sourceVolumeRef,omitempty"`
+	BackupRef        *apiv1alpha1.KubernetesNameRef     `json:"backupRef,omitempty"`
 }
 
 // VolumeResourceSpecApplyConfiguration constructs a declarative configuration of the VolumeResourceSpec type for use with
@@ -72,6 +76,14 @@ func (b *VolumeResourceSpecApplyConfiguration) WithVolumeTypeRef(value apiv1alph
 	return b
 }
 
+// WithMigrationPolicy sets the MigrationPolicy field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the MigrationPolicy field is set to the value of the last call.
+func (b *VolumeResourceSpecApplyConfiguration) WithMigrationPolicy(value apiv1alpha1.VolumeMigrationPolicy) *VolumeResourceSpecApplyConfiguration {
+	b.MigrationPolicy = &value
+	return b
+}
+
 // WithAvailabilityZone sets the AvailabilityZone field in the declarative configuration to the given value
 // and returns the receiver, so that objects can be built by chaining "With" function invocations.
 // If called multiple times, the AvailabilityZone field is set to the value of the last call.
@@ -100,3 +112,27 @@ func (b *VolumeResourceSpecApplyConfiguration) WithImageRef(value apiv1alpha1.Ku
 	b.ImageRef = &value
 	return b
 }
+
+// WithSnapshotRef sets the SnapshotRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SnapshotRef field is set to the value of the last call.
+func (b *VolumeResourceSpecApplyConfiguration) WithSnapshotRef(value apiv1alpha1.KubernetesNameRef) *VolumeResourceSpecApplyConfiguration {
+	b.SnapshotRef = &value
+	return b
+}
+
+// WithSourceVolumeRef sets the SourceVolumeRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the SourceVolumeRef field is set to the value of the last call.
+func (b *VolumeResourceSpecApplyConfiguration) WithSourceVolumeRef(value apiv1alpha1.KubernetesNameRef) *VolumeResourceSpecApplyConfiguration {
+	b.SourceVolumeRef = &value
+	return b
+}
+
+// WithBackupRef sets the BackupRef field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the BackupRef field is set to the value of the last call.
+func (b *VolumeResourceSpecApplyConfiguration) WithBackupRef(value apiv1alpha1.KubernetesNameRef) *VolumeResourceSpecApplyConfiguration {
+	b.BackupRef = &value
+	return b
+}
@@ -26,10 +26,13 @@ import (
 // VolumeStatusApplyConfiguration represents a declarative configuration of the VolumeStatus type for use
 // with apply.
 type VolumeStatusApplyConfiguration struct {
-	Conditions   []v1.ConditionApplyConfiguration        `json:"conditions,omitempty"`
-	ID           *string                                 `json:"id,omitempty"`
-	Resource     *VolumeResourceStatusApplyConfiguration `json:"resource,omitempty"`
-	LastSyncTime *metav1.Time                            `json:"lastSyncTime,omitempty"`
+	Conditions                          []v1.ConditionApplyConfiguration        `json:"conditions,omitempty"`
+	ID                                  *string                                 `json:"id,omitempty"`
+	Resource                            *VolumeResourceStatusApplyConfiguration `json:"resource,omitempty"`
+	LastSyncTime                        *metav1.Time                            `json:"lastSyncTime,omitempty"`
+	Drift                               []string                                `json:"drift,omitempty"`
+	OpenStackCloudName                  *string                                 `json:"openStackCloudName,omitempty"`
+	VolumeStatusExtraApplyConfiguration `json:",inline"`
 }
 
 // VolumeStatusApplyConfiguration constructs a declarative configuration of the VolumeStatus type for use with
@@ -74,3 +77,29 @@ func (b *VolumeStatusApplyConfiguration) WithLastSyncTime(value metav1.Time) *Vo
 	b.LastSyncTime = &value
 	return b
 }
+
+// WithDrift adds the given value to the Drift field in the declarative configuration
+// and returns the receiver, so that objects can be build by chaining "With" function invocations.
+// If called multiple times, values provided by each call will be appended to the Drift field.
+func (b *VolumeStatusApplyConfiguration) WithDrift(values ...string) *VolumeStatusApplyConfiguration {
+	for i := range values {
+		b.Drift = append(b.Drift, values[i])
+	}
+	return b
+}
+
+// WithOpenStackCloudName sets the OpenStackCloudName field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the OpenStackCloudName field is set to the value of the last call.
+func (b *VolumeStatusApplyConfiguration) WithOpenStackCloudName(value string) *VolumeStatusApplyConfiguration {
+	b.OpenStackCloudName = &value
+	return b
+}
+
+// WithLastRetype sets the LastRetype field in the declarative configuration to the given value
+// and returns the receiver, so that objects can be built by chaining "With" function invocations.
+// If called multiple times, the LastRetype field is set to the value of the last call.
+func (b *VolumeStatusApplyConfiguration) WithLastRetype(value *VolumeRetypeAttemptApplyConfiguration) *VolumeStatusApplyConfiguration {
+	b.VolumeStatusExtraApplyConfiguration.LastRetype = value
+	return b
+}
@@ -130,7 +130,6 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudCredentialsRef
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
-      default: {}
     - name: import
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.AddressScopeImport
@@ -157,12 +156,21 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: id
       type:
         scalar: string
     - name: lastSyncTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
     - name: resource
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.AddressScopeResourceStatus
@@ -352,7 +360,6 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudCredentialsRef
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
-      default: {}
     - name: import
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.ApplicationCredentialImport
@@ -379,12 +386,21 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: id
       type:
         scalar: string
     - name: lastSyncTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
     - name: resource
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.ApplicationCredentialResourceStatus
@@ -394,6 +410,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudName
       type:
         scalar: string
+    - name: regionName
+      type:
+        scalar: string
     - name: secretName
       type:
         scalar: string
@@ -466,7 +485,6 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudCredentialsRef
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
-      default: {}
     - name: import
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.DomainImport
@@ -493,12 +511,21 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: id
       type:
         scalar: string
     - name: lastSyncTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
     - name: resource
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.DomainResourceStatus
@@ -587,7 +614,6 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudCredentialsRef
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
-      default: {}
     - name: import
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.EndpointImport
@@ -614,12 +640,21 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: id
       type:
         scalar: string
     - name: lastSyncTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
     - name: resource
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.EndpointResourceStatus
@@ -787,7 +822,6 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudCredentialsRef
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
-      default: {}
     - name: import
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.FlavorImport
@@ -814,12 +848,21 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: id
       type:
         scalar: string
     - name: lastSyncTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
     - name: resource
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.FlavorResourceStatus
@@ -979,7 +1022,6 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudCredentialsRef
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
-      default: {}
     - name: import
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.FloatingIPImport
@@ -1006,12 +1048,21 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: id
       type:
         scalar: string
     - name: lastSyncTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
     - name: resource
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.FloatingIPResourceStatus
@@ -1084,7 +1135,6 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudCredentialsRef
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
-      default: {}
     - name: import
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.GroupImport
@@ -1111,12 +1161,21 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: id
       type:
         scalar: string
     - name: lastSyncTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
     - name: resource
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.GroupResourceStatus
@@ -1361,7 +1420,6 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudCredentialsRef
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
-      default: {}
     - name: import
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.ImageImport
@@ -1391,12 +1449,21 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: downloadAttempts
       type:
         scalar: numeric
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: id
       type:
         scalar: string
     - name: lastSyncTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
     - name: resource
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.ImageResourceStatus
@@ -1469,7 +1536,6 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudCredentialsRef
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
-      default: {}
     - name: import
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.KeyPairImport
@@ -1496,18 +1562,33 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: id
       type:
         scalar: string
     - name: lastSyncTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
     - name: resource
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.KeyPairResourceStatus
 - name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.ManagedOptions
   map:
     fields:
+    - name: deletionProtection
+      type:
+        scalar: boolean
+    - name: driftPolicy
+      type:
+        scalar: string
     - name: onDelete
       type:
         scalar: string
@@ -1691,7 +1772,6 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudCredentialsRef
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
-      default: {}
     - name: import
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.NetworkImport
@@ -1718,15 +1798,82 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: id
       type:
         scalar: string
     - name: lastSyncTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
     - name: resource
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.NetworkResourceStatus
+- name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.OpenStackCloud
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.OpenStackCloudSpec
+      default: {}
+- name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.OpenStackCloudAllowedNamespaces
+  map:
+    fields:
+    - name: names
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: selector
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector
+- name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.OpenStackCloudSecretReference
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
+    - name: namespace
+      type:
+        scalar: string
+- name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.OpenStackCloudSpec
+  map:
+    fields:
+    - name: allowedNamespaces
+      type:
+        namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.OpenStackCloudAllowedNamespaces
+      default: {}
+    - name: caCert
+      type:
+        scalar: string
+    - name: cloudName
+      type:
+        scalar: string
+    - name: regionName
+      type:
+        scalar: string
+    - name: secretRef
+      type:
+        namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.OpenStackCloudSecretReference
+      default: {}
 - name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.Port
   map:
     fields:
@@ -1977,7 +2124,6 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudCredentialsRef
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
-      default: {}
     - name: import
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.PortImport
@@ -2004,12 +2150,21 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: id
       type:
         scalar: string
     - name: lastSyncTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
     - name: resource
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.PortResourceStatus
@@ -2133,7 +2288,6 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudCredentialsRef
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
-      default: {}
     - name: import
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.ProjectImport
@@ -2160,12 +2314,21 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: id
       type:
         scalar: string
     - name: lastSyncTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
     - name: resource
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.ProjectResourceStatus
@@ -2289,7 +2452,6 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudCredentialsRef
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
-      default: {}
     - name: import
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.RoleAssignmentImport
@@ -2316,9 +2478,18 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: lastSyncTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
     - name: resource
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.RoleAssignmentResourceStatus
@@ -2370,7 +2541,6 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudCredentialsRef
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
-      default: {}
     - name: import
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.RoleImport
@@ -2397,12 +2567,21 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: id
       type:
         scalar: string
     - name: lastSyncTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
     - name: resource
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.RoleResourceStatus
@@ -2603,7 +2782,6 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudCredentialsRef
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
-      default: {}
     - name: import
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.RouterImport
@@ -2630,12 +2808,21 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: id
       type:
         scalar: string
     - name: lastSyncTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
     - name: resource
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.RouterResourceStatus
@@ -2822,7 +3009,6 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudCredentialsRef
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
-      default: {}
     - name: import
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.SecurityGroupImport
@@ -2849,12 +3035,21 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: id
       type:
         scalar: string
     - name: lastSyncTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
     - name: resource
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.SecurityGroupResourceStatus
@@ -3005,7 +3200,6 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudCredentialsRef
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
-      default: {}
     - name: import
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.ServerGroupImport
@@ -3032,12 +3226,21 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: id
       type:
         scalar: string
     - name: lastSyncTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
     - name: resource
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.ServerGroupResourceStatus
@@ -3253,7 +3456,6 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudCredentialsRef
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
-      default: {}
     - name: import
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.ServerImport
@@ -3280,12 +3482,21 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: id
       type:
         scalar: string
     - name: lastSyncTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
     - name: resource
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.ServerResourceStatus
@@ -3379,7 +3590,6 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudCredentialsRef
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
-      default: {}
     - name: import
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.ServiceImport
@@ -3406,12 +3616,21 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: id
       type:
         scalar: string
     - name: lastSyncTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
     - name: resource
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.ServiceResourceStatus
@@ -3512,7 +3731,6 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudCredentialsRef
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
-      default: {}
     - name: import
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.ShareNetworkImport
@@ -3539,12 +3757,21 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: id
       type:
         scalar: string
     - name: lastSyncTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
     - name: resource
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.ShareNetworkResourceStatus
@@ -3778,7 +4005,6 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudCredentialsRef
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
-      default: {}
     - name: import
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.SubnetImport
@@ -3805,12 +4031,21 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: id
       type:
         scalar: string
     - name: lastSyncTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
     - name: resource
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.SubnetResourceStatus
@@ -3967,7 +4202,6 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudCredentialsRef
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
-      default: {}
     - name: import
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.TrunkImport
@@ -3994,12 +4228,21 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: id
       type:
         scalar: string
     - name: lastSyncTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
     - name: resource
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.TrunkResourceStatus
@@ -4123,7 +4366,6 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudCredentialsRef
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
-      default: {}
     - name: import
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.UserImport
@@ -4150,12 +4392,21 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: id
       type:
         scalar: string
     - name: lastSyncTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
     - name: resource
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.UserResourceStatus
@@ -4198,27 +4449,194 @@ var schemaYAML = typed.YAMLObject(`types:
       type:
         scalar: string
       default: ""
-- name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeFilter
+- name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeBackup
   map:
     fields:
-    - name: availabilityZone
+    - name: apiVersion
       type:
         scalar: string
-    - name: description
+    - name: kind
       type:
         scalar: string
-    - name: name
+    - name: metadata
       type:
-        scalar: string
-    - name: size
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
       type:
-        scalar: numeric
-- name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeImport
+        namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeBackupSpec
+      default: {}
+    - name: status
+      type:
+        namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeBackupStatus
+      default: {}
+- name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeBackupFilter
   map:
     fields:
-    - name: filter
+    - name: description
       type:
-        namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeFilter
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+    - name: volumeRef
+      type:
+        scalar: string
+- name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeBackupImport
+  map:
+    fields:
+    - name: filter
+      type:
+        namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeBackupFilter
+    - name: id
+      type:
+        scalar: string
+- name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeBackupResourceSpec
+  map:
+    fields:
+    - name: container
+      type:
+        scalar: string
+    - name: description
+      type:
+        scalar: string
+    - name: force
+      type:
+        scalar: boolean
+    - name: incremental
+      type:
+        scalar: boolean
+    - name: name
+      type:
+        scalar: string
+    - name: snapshotRef
+      type:
+        scalar: string
+    - name: volumeRef
+      type:
+        scalar: string
+- name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeBackupResourceStatus
+  map:
+    fields:
+    - name: availabilityZone
+      type:
+        scalar: string
+    - name: container
+      type:
+        scalar: string
+    - name: createdAt
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: dataTimestamp
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: description
+      type:
+        scalar: string
+    - name: failReason
+      type:
+        scalar: string
+    - name: hasDependentBackups
+      type:
+        scalar: boolean
+    - name: incremental
+      type:
+        scalar: boolean
+    - name: name
+      type:
+        scalar: string
+    - name: objectCount
+      type:
+        scalar: numeric
+    - name: projectID
+      type:
+        scalar: string
+    - name: size
+      type:
+        scalar: numeric
+    - name: snapshotID
+      type:
+        scalar: string
+    - name: status
+      type:
+        scalar: string
+    - name: updatedAt
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: volumeID
+      type:
+        scalar: string
+- name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeBackupSpec
+  map:
+    fields:
+    - name: cloudCredentialsRef
+      type:
+        namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
+    - name: import
+      type:
+        namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeBackupImport
+    - name: managedOptions
+      type:
+        namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.ManagedOptions
+    - name: managementPolicy
+      type:
+        scalar: string
+    - name: resource
+      type:
+        namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeBackupResourceSpec
+    - name: resyncPeriod
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+- name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeBackupStatus
+  map:
+    fields:
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
+          elementRelationship: associative
+          keys:
+          - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: id
+      type:
+        scalar: string
+    - name: lastSyncTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
+    - name: resource
+      type:
+        namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeBackupResourceStatus
+- name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeFilter
+  map:
+    fields:
+    - name: availabilityZone
+      type:
+        scalar: string
+    - name: description
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+    - name: size
+      type:
+        scalar: numeric
+- name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeImport
+  map:
+    fields:
+    - name: filter
+      type:
+        namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeFilter
     - name: id
       type:
         scalar: string
@@ -4248,6 +4666,9 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: availabilityZone
       type:
         scalar: string
+    - name: backupRef
+      type:
+        scalar: string
     - name: description
       type:
         scalar: string
@@ -4260,12 +4681,21 @@ var schemaYAML = typed.YAMLObject(`types:
           elementType:
             namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeMetadata
           elementRelationship: atomic
+    - name: migrationPolicy
+      type:
+        scalar: string
     - name: name
       type:
         scalar: string
     - name: size
       type:
         scalar: numeric
+    - name: snapshotRef
+      type:
+        scalar: string
+    - name: sourceVolumeRef
+      type:
+        scalar: string
     - name: volumeTypeRef
       type:
         scalar: string
@@ -4344,13 +4774,195 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: volumeType
       type:
         scalar: string
+- name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeRetypeAttempt
+  map:
+    fields:
+    - name: generation
+      type:
+        scalar: numeric
+      default: 0
+    - name: volumeType
+      type:
+        scalar: string
+      default: ""
+- name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeSnapshot
+  map:
+    fields:
+    - name: apiVersion
+      type:
+        scalar: string
+    - name: kind
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.ObjectMeta
+      default: {}
+    - name: spec
+      type:
+        namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeSnapshotSpec
+      default: {}
+    - name: status
+      type:
+        namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeSnapshotStatus
+      default: {}
+- name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeSnapshotFilter
+  map:
+    fields:
+    - name: description
+      type:
+        scalar: string
+    - name: name
+      type:
+        scalar: string
+    - name: volumeRef
+      type:
+        scalar: string
+- name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeSnapshotImport
+  map:
+    fields:
+    - name: filter
+      type:
+        namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeSnapshotFilter
+    - name: id
+      type:
+        scalar: string
+- name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeSnapshotMetadata
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
+      default: ""
+    - name: value
+      type:
+        scalar: string
+      default: ""
+- name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeSnapshotMetadataStatus
+  map:
+    fields:
+    - name: name
+      type:
+        scalar: string
+    - name: value
+      type:
+        scalar: string
+- name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeSnapshotResourceSpec
+  map:
+    fields:
+    - name: description
+      type:
+        scalar: string
+    - name: force
+      type:
+        scalar: boolean
+    - name: metadata
+      type:
+        list:
+          elementType:
+            namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeSnapshotMetadata
+          elementRelationship: atomic
+    - name: name
+      type:
+        scalar: string
+    - name: volumeRef
+      type:
+        scalar: string
+- name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeSnapshotResourceStatus
+  map:
+    fields:
+    - name: createdAt
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: description
+      type:
+        scalar: string
+    - name: metadata
+      type:
+        list:
+          elementType:
+            namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeSnapshotMetadataStatus
+          elementRelationship: atomic
+    - name: name
+      type:
+        scalar: string
+    - name: progress
+      type:
+        scalar: string
+    - name: projectID
+      type:
+        scalar: string
+    - name: size
+      type:
+        scalar: numeric
+    - name: status
+      type:
+        scalar: string
+    - name: updatedAt
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: userID
+      type:
+        scalar: string
+    - name: volumeID
+      type:
+        scalar: string
+- name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeSnapshotSpec
+  map:
+    fields:
+    - name: cloudCredentialsRef
+      type:
+        namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
+    - name: import
+      type:
+        namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeSnapshotImport
+    - name: managedOptions
+      type:
+        namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.ManagedOptions
+    - name: managementPolicy
+      type:
+        scalar: string
+    - name: resource
+      type:
+        namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeSnapshotResourceSpec
+    - name: resyncPeriod
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Duration
+- name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeSnapshotStatus
+  map:
+    fields:
+    - name: conditions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Condition
+          elementRelationship: associative
+          keys:
+          - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
+    - name: id
+      type:
+        scalar: string
+    - name: lastSyncTime
+      type:
+        namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
+    - name: resource
+      type:
+        namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeSnapshotResourceStatus
 - name: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeSpec
   map:
     fields:
     - name: cloudCredentialsRef
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
-      default: {}
     - name: import
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeImport
@@ -4377,12 +4989,24 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: id
       type:
         scalar: string
+    - name: lastRetype
+      type:
+        namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeRetypeAttempt
     - name: lastSyncTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
     - name: resource
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeResourceStatus
@@ -4490,7 +5114,6 @@ var schemaYAML = typed.YAMLObject(`types:
     - name: cloudCredentialsRef
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.CloudCredentialsReference
-      default: {}
     - name: import
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeTypeImport
@@ -4517,12 +5140,21 @@ var schemaYAML = typed.YAMLObject(`types:
           elementRelationship: associative
           keys:
           - type
+    - name: drift
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: associative
     - name: id
       type:
         scalar: string
     - name: lastSyncTime
       type:
         namedType: io.k8s.apimachinery.pkg.apis.meta.v1.Time
+    - name: openStackCloudName
+      type:
+        scalar: string
     - name: resource
       type:
         namedType: com.github.k-orc.openstack-resource-controller.v2.api.v1alpha1.VolumeTypeResourceStatus
@@ -4565,6 +5197,38 @@ var schemaYAML = typed.YAMLObject(`types:
         elementType:
           namedType: __untyped_deduced_
         elementRelationship: separable
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelector
+  map:
+    fields:
+    - name: matchExpressions
+      type:
+        list:
+          elementType:
+            namedType: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelectorRequirement
+          elementRelationship: atomic
+    - name: matchLabels
+      type:
+        map:
+          elementType:
+            scalar: string
+    elementRelationship: atomic
+- name: io.k8s.apimachinery.pkg.apis.meta.v1.LabelSelectorRequirement
+  map:
+    fields:
+    - name: key
+      type:
+        scalar: string
+      default: ""
+    - name: operator
+      type:
+        scalar: string
+      default: ""
+    - name: values
+      type:
+        list:
+          elementType:
+            scalar: string
+          elementRelationship: atomic
 - name: io.k8s.apimachinery.pkg.apis.meta.v1.ManagedFieldsEntry
   map:
     fields:
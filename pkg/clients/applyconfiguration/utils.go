@@ -232,6 +232,14 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.NetworkStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("NeutronStatusMetadata"):
 		return &apiv1alpha1.NeutronStatusMetadataApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackCloud"):
+		return &apiv1alpha1.OpenStackCloudApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackCloudAllowedNamespaces"):
+		return &apiv1alpha1.OpenStackCloudAllowedNamespacesApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackCloudSecretReference"):
+		return &apiv1alpha1.OpenStackCloudSecretReferenceApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("OpenStackCloudSpec"):
+		return &apiv1alpha1.OpenStackCloudSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("Port"):
 		return &apiv1alpha1.PortApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("PortFilter"):
@@ -466,6 +474,20 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.VolumeApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("VolumeAttachmentStatus"):
 		return &apiv1alpha1.VolumeAttachmentStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VolumeBackup"):
+		return &apiv1alpha1.VolumeBackupApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VolumeBackupFilter"):
+		return &apiv1alpha1.VolumeBackupFilterApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VolumeBackupImport"):
+		return &apiv1alpha1.VolumeBackupImportApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VolumeBackupResourceSpec"):
+		return &apiv1alpha1.VolumeBackupResourceSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VolumeBackupResourceStatus"):
+		return &apiv1alpha1.VolumeBackupResourceStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VolumeBackupSpec"):
+		return &apiv1alpha1.VolumeBackupSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VolumeBackupStatus"):
+		return &apiv1alpha1.VolumeBackupStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("VolumeFilter"):
 		return &apiv1alpha1.VolumeFilterApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("VolumeImport"):
@@ -478,10 +500,32 @@ func ForKind(kind schema.GroupVersionKind) interface{} {
 		return &apiv1alpha1.VolumeResourceSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("VolumeResourceStatus"):
 		return &apiv1alpha1.VolumeResourceStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VolumeRetypeAttempt"):
+		return &apiv1alpha1.VolumeRetypeAttemptApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshot"):
+		return &apiv1alpha1.VolumeSnapshotApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotFilter"):
+		return &apiv1alpha1.VolumeSnapshotFilterApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotImport"):
+		return &apiv1alpha1.VolumeSnapshotImportApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotMetadata"):
+		return &apiv1alpha1.VolumeSnapshotMetadataApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotMetadataStatus"):
+		return &apiv1alpha1.VolumeSnapshotMetadataStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotResourceSpec"):
+		return &apiv1alpha1.VolumeSnapshotResourceSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotResourceStatus"):
+		return &apiv1alpha1.VolumeSnapshotResourceStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotSpec"):
+		return &apiv1alpha1.VolumeSnapshotSpecApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VolumeSnapshotStatus"):
+		return &apiv1alpha1.VolumeSnapshotStatusApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("VolumeSpec"):
 		return &apiv1alpha1.VolumeSpecApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("VolumeStatus"):
 		return &apiv1alpha1.VolumeStatusApplyConfiguration{}
+	case v1alpha1.SchemeGroupVersion.WithKind("VolumeStatusExtra"):
+		return &apiv1alpha1.VolumeStatusExtraApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("VolumeType"):
 		return &apiv1alpha1.VolumeTypeApplyConfiguration{}
 	case v1alpha1.SchemeGroupVersion.WithKind("VolumeTypeExtraSpec"):
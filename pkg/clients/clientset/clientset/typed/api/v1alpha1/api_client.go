@@ -38,6 +38,7 @@ type OpenstackV1alpha1Interface interface {
 	ImagesGetter
 	KeyPairsGetter
 	NetworksGetter
+	OpenStackCloudsGetter
 	PortsGetter
 	ProjectsGetter
 	RolesGetter
@@ -53,6 +54,8 @@ type OpenstackV1alpha1Interface interface {
 	TrunksGetter
 	UsersGetter
 	VolumesGetter
+	VolumeBackupsGetter
+	VolumeSnapshotsGetter
 	VolumeTypesGetter
 }
 
@@ -101,6 +104,10 @@ func (c *OpenstackV1alpha1Client) Networks(namespace string) NetworkInterface {
 	return newNetworks(c, namespace)
 }
 
+func (c *OpenstackV1alpha1Client) OpenStackClouds() OpenStackCloudInterface {
+	return newOpenStackClouds(c)
+}
+
 func (c *OpenstackV1alpha1Client) Ports(namespace string) PortInterface {
 	return newPorts(c, namespace)
 }
@@ -161,6 +168,14 @@ func (c *OpenstackV1alpha1Client) Volumes(namespace string) VolumeInterface {
 	return newVolumes(c, namespace)
 }
 
+func (c *OpenstackV1alpha1Client) VolumeBackups(namespace string) VolumeBackupInterface {
+	return newVolumeBackups(c, namespace)
+}
+
+func (c *OpenstackV1alpha1Client) VolumeSnapshots(namespace string) VolumeSnapshotInterface {
+	return newVolumeSnapshots(c, namespace)
+}
+
 func (c *OpenstackV1alpha1Client) VolumeTypes(namespace string) VolumeTypeInterface {
 	return newVolumeTypes(c, namespace)
 }
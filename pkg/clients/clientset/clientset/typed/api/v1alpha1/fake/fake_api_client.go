@@ -68,6 +68,10 @@ func (c *FakeOpenstackV1alpha1) Networks(namespace string) v1alpha1.NetworkInter
 	return newFakeNetworks(c, namespace)
 }
 
+func (c *FakeOpenstackV1alpha1) OpenStackClouds() v1alpha1.OpenStackCloudInterface {
+	return newFakeOpenStackClouds(c)
+}
+
 func (c *FakeOpenstackV1alpha1) Ports(namespace string) v1alpha1.PortInterface {
 	return newFakePorts(c, namespace)
 }
@@ -128,6 +132,14 @@ func (c *FakeOpenstackV1alpha1) Volumes(namespace string) v1alpha1.VolumeInterfa
 	return newFakeVolumes(c, namespace)
 }
 
+func (c *FakeOpenstackV1alpha1) VolumeBackups(namespace string) v1alpha1.VolumeBackupInterface {
+	return newFakeVolumeBackups(c, namespace)
+}
+
+func (c *FakeOpenstackV1alpha1) VolumeSnapshots(namespace string) v1alpha1.VolumeSnapshotInterface {
+	return newFakeVolumeSnapshots(c, namespace)
+}
+
 func (c *FakeOpenstackV1alpha1) VolumeTypes(namespace string) v1alpha1.VolumeTypeInterface {
 	return newFakeVolumeTypes(c, namespace)
 }
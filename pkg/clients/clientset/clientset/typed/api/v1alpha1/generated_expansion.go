@@ -38,6 +38,8 @@ type KeyPairExpansion interface{}
 
 type NetworkExpansion interface{}
 
+type OpenStackCloudExpansion interface{}
+
 type PortExpansion interface{}
 
 type ProjectExpansion interface{}
@@ -68,4 +70,8 @@ type UserExpansion interface{}
 
 type VolumeExpansion interface{}
 
+type VolumeBackupExpansion interface{}
+
+type VolumeSnapshotExpansion interface{}
+
 type VolumeTypeExpansion interface{}
@@ -44,6 +44,8 @@ type Interface interface {
 	KeyPairs() KeyPairInformer
 	// Networks returns a NetworkInformer.
 	Networks() NetworkInformer
+	// OpenStackClouds returns a OpenStackCloudInformer.
+	OpenStackClouds() OpenStackCloudInformer
 	// Ports returns a PortInformer.
 	Ports() PortInformer
 	// Projects returns a ProjectInformer.
@@ -74,6 +76,10 @@ type Interface interface {
 	Users() UserInformer
 	// Volumes returns a VolumeInformer.
 	Volumes() VolumeInformer
+	// VolumeBackups returns a VolumeBackupInformer.
+	VolumeBackups() VolumeBackupInformer
+	// VolumeSnapshots returns a VolumeSnapshotInformer.
+	VolumeSnapshots() VolumeSnapshotInformer
 	// VolumeTypes returns a VolumeTypeInformer.
 	VolumeTypes() VolumeTypeInformer
 }
@@ -139,6 +145,11 @@ func (v *version) Networks() NetworkInformer {
 	return &networkInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// OpenStackClouds returns a OpenStackCloudInformer.
+func (v *version) OpenStackClouds() OpenStackCloudInformer {
+	return &openStackCloudInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Ports returns a PortInformer.
 func (v *version) Ports() PortInformer {
 	return &portInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -214,6 +225,16 @@ func (v *version) Volumes() VolumeInformer {
 	return &volumeInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// VolumeBackups returns a VolumeBackupInformer.
+func (v *version) VolumeBackups() VolumeBackupInformer {
+	return &volumeBackupInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
+// VolumeSnapshots returns a VolumeSnapshotInformer.
+func (v *version) VolumeSnapshots() VolumeSnapshotInformer {
+	return &volumeSnapshotInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // VolumeTypes returns a VolumeTypeInformer.
 func (v *version) VolumeTypes() VolumeTypeInformer {
 	return &volumeTypeInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
@@ -73,6 +73,8 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Openstack().V1alpha1().KeyPairs().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("networks"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Openstack().V1alpha1().Networks().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("openstackclouds"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Openstack().V1alpha1().OpenStackClouds().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("ports"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Openstack().V1alpha1().Ports().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("projects"):
@@ -103,6 +105,10 @@ func (f *sharedInformerFactory) ForResource(resource schema.GroupVersionResource
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Openstack().V1alpha1().Users().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("volumes"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Openstack().V1alpha1().Volumes().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("volumebackups"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Openstack().V1alpha1().VolumeBackups().Informer()}, nil
+	case v1alpha1.SchemeGroupVersion.WithResource("volumesnapshots"):
+		return &genericInformer{resource: resource.GroupResource(), informer: f.Openstack().V1alpha1().VolumeSnapshots().Informer()}, nil
 	case v1alpha1.SchemeGroupVersion.WithResource("volumetypes"):
 		return &genericInformer{resource: resource.GroupResource(), informer: f.Openstack().V1alpha1().VolumeTypes().Informer()}, nil
 
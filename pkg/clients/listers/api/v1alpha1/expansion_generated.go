@@ -98,6 +98,10 @@ type NetworkListerExpansion interface{}
 // NetworkNamespaceLister.
 type NetworkNamespaceListerExpansion interface{}
 
+// OpenStackCloudListerExpansion allows custom methods to be added to
+// OpenStackCloudLister.
+type OpenStackCloudListerExpansion interface{}
+
 // PortListerExpansion allows custom methods to be added to
 // PortLister.
 type PortListerExpansion interface{}
@@ -218,6 +222,22 @@ type VolumeListerExpansion interface{}
 // VolumeNamespaceLister.
 type VolumeNamespaceListerExpansion interface{}
 
+// VolumeBackupListerExpansion allows custom methods to be added to
+// VolumeBackupLister.
+type VolumeBackupListerExpansion interface{}
+
+// VolumeBackupNamespaceListerExpansion allows custom methods to be added to
+// VolumeBackupNamespaceLister.
+type VolumeBackupNamespaceListerExpansion interface{}
+
+// VolumeSnapshotListerExpansion allows custom methods to be added to
+// VolumeSnapshotLister.
+type VolumeSnapshotListerExpansion interface{}
+
+// VolumeSnapshotNamespaceListerExpansion allows custom methods to be added to
+// VolumeSnapshotNamespaceLister.
+type VolumeSnapshotNamespaceListerExpansion interface{}
+
 // VolumeTypeListerExpansion allows custom methods to be added to
 // VolumeTypeLister.
 type VolumeTypeListerExpansion interface{}
@@ -167,6 +167,29 @@ var _ = Describe("ORC Network API validations", func() {
 		Expect(applyObj(ctx, network, patch)).NotTo(Succeed())
 	})
 
+	It("should not permit adding, modifying or removing cloudCredentialsRef.regionName", func(ctx context.Context) {
+		network := networkStub(namespace)
+		patch := baseNetworkPatch(network)
+		patch.Spec.WithResource(testNetworkResource())
+		Expect(applyObj(ctx, network, patch)).To(Succeed(), "create network without regionName")
+
+		patch.Spec.CloudCredentialsRef.WithRegionName("RegionOne")
+		Expect(applyObj(ctx, network, patch)).NotTo(Succeed(), "add regionName")
+
+		regionalNetwork := networkStub(namespace)
+		regionalNetwork.Name = "regional-network"
+		patch = baseNetworkPatch(regionalNetwork)
+		patch.Spec.WithResource(testNetworkResource())
+		patch.Spec.CloudCredentialsRef.WithRegionName("RegionOne")
+		Expect(applyObj(ctx, regionalNetwork, patch)).To(Succeed(), "create network with regionName")
+
+		patch.Spec.CloudCredentialsRef.WithRegionName("RegionTwo")
+		Expect(applyObj(ctx, regionalNetwork, patch)).NotTo(Succeed(), "modify regionName")
+
+		patch.Spec.WithCloudCredentialsRef(testCredentials())
+		Expect(applyObj(ctx, regionalNetwork, patch)).NotTo(Succeed(), "remove regionName")
+	})
+
 	It("should permit valid import filter", func(ctx context.Context) {
 		network := networkStub(namespace)
 		patch := baseNetworkPatch(network)
@@ -0,0 +1,70 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apivalidations
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	applyconfigv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/pkg/clients/applyconfiguration/api/v1alpha1"
+)
+
+func openStackCloudStub() *orcv1alpha1.OpenStackCloud {
+	obj := &orcv1alpha1.OpenStackCloud{}
+	obj.Name = "test-" + utilrand.String(10)
+	DeferCleanup(func(ctx context.Context) {
+		Expect(client.IgnoreNotFound(k8sClient.Delete(ctx, obj))).To(Succeed(), "delete OpenStackCloud")
+	})
+	return obj
+}
+
+func baseOpenStackCloudPatch(cloud client.Object) *applyconfigv1alpha1.OpenStackCloudApplyConfiguration {
+	return applyconfigv1alpha1.OpenStackCloud(cloud.GetName()).
+		WithSpec(applyconfigv1alpha1.OpenStackCloudSpec().
+			WithSecretRef(applyconfigv1alpha1.OpenStackCloudSecretReference().
+				WithNamespace("orc-system").
+				WithName("openstack-credentials")).
+			WithAllowedNamespaces(applyconfigv1alpha1.OpenStackCloudAllowedNamespaces().
+				WithNames(cloud.GetName())))
+}
+
+var _ = Describe("ORC OpenStackCloud API validations", func() {
+	It("should not permit adding, modifying or removing regionName", func(ctx context.Context) {
+		cloud := openStackCloudStub()
+		patch := baseOpenStackCloudPatch(cloud)
+		Expect(applyObj(ctx, cloud, patch)).To(Succeed(), "create OpenStackCloud without regionName")
+
+		patch.Spec.WithRegionName("RegionOne")
+		Expect(applyObj(ctx, cloud, patch)).NotTo(Succeed(), "add regionName")
+
+		regionalCloud := openStackCloudStub()
+		patch = baseOpenStackCloudPatch(regionalCloud)
+		patch.Spec.WithRegionName("RegionOne")
+		Expect(applyObj(ctx, regionalCloud, patch)).To(Succeed(), "create OpenStackCloud with regionName")
+
+		patch.Spec.WithRegionName("RegionTwo")
+		Expect(applyObj(ctx, regionalCloud, patch)).NotTo(Succeed(), "modify regionName")
+
+		patch = baseOpenStackCloudPatch(regionalCloud)
+		Expect(applyObj(ctx, regionalCloud, patch)).NotTo(Succeed(), "remove regionName")
+	})
+})
@@ -111,17 +111,39 @@ var _ = Describe("ORC Volume API validations", func() {
 		Expect(applyObj(ctx, volume, patch)).To(MatchError(ContainSubstring("spec.resource.size in body should be greater than or equal to 1")))
 	})
 
-	It("should have immutable size", func(ctx context.Context) {
+	It("should allow size to be increased", func(ctx context.Context) {
 		volume := volumeStub(namespace)
 		patch := baseVolumePatch(volume)
 		patch.Spec.WithResource(applyconfigv1alpha1.VolumeResourceSpec().WithSize(1))
 		Expect(applyObj(ctx, volume, patch)).To(Succeed())
 
 		patch.Spec.WithResource(applyconfigv1alpha1.VolumeResourceSpec().WithSize(2))
-		Expect(applyObj(ctx, volume, patch)).To(MatchError(ContainSubstring("size is immutable")))
+		Expect(applyObj(ctx, volume, patch)).To(Succeed())
+	})
+
+	It("should not allow size to be decreased", func(ctx context.Context) {
+		volume := volumeStub(namespace)
+		patch := baseVolumePatch(volume)
+		patch.Spec.WithResource(applyconfigv1alpha1.VolumeResourceSpec().WithSize(2))
+		Expect(applyObj(ctx, volume, patch)).To(Succeed())
+
+		patch.Spec.WithResource(applyconfigv1alpha1.VolumeResourceSpec().WithSize(1))
+		Expect(applyObj(ctx, volume, patch)).To(MatchError(ContainSubstring("size may not be decreased")))
+	})
+
+	It("should allow volumeTypeRef to be changed", func(ctx context.Context) {
+		volume := volumeStub(namespace)
+		patch := baseVolumePatch(volume)
+		patch.Spec.WithResource(applyconfigv1alpha1.VolumeResourceSpec().
+			WithSize(1).WithVolumeTypeRef("type-a"))
+		Expect(applyObj(ctx, volume, patch)).To(Succeed())
+
+		patch.Spec.WithResource(applyconfigv1alpha1.VolumeResourceSpec().
+			WithSize(1).WithVolumeTypeRef("type-b").WithMigrationPolicy(orcv1alpha1.VolumeMigrationPolicyOnDemand))
+		Expect(applyObj(ctx, volume, patch)).To(Succeed())
 	})
 
-	It("should have immutable volumeTypeRef", func(ctx context.Context) {
+	It("should not allow volumeTypeRef to be removed", func(ctx context.Context) {
 		volume := volumeStub(namespace)
 		patch := baseVolumePatch(volume)
 		patch.Spec.WithResource(applyconfigv1alpha1.VolumeResourceSpec().
@@ -129,8 +151,28 @@ var _ = Describe("ORC Volume API validations", func() {
 		Expect(applyObj(ctx, volume, patch)).To(Succeed())
 
 		patch.Spec.WithResource(applyconfigv1alpha1.VolumeResourceSpec().
-			WithSize(1).WithVolumeTypeRef("type-b"))
-		Expect(applyObj(ctx, volume, patch)).To(MatchError(ContainSubstring("volumeTypeRef is immutable")))
+			WithSize(1))
+		Expect(applyObj(ctx, volume, patch)).To(MatchError(ContainSubstring("volumeTypeRef may not be removed")))
+	})
+
+	It("should allow volumeTypeRef to be added", func(ctx context.Context) {
+		volume := volumeStub(namespace)
+		patch := baseVolumePatch(volume)
+		patch.Spec.WithResource(applyconfigv1alpha1.VolumeResourceSpec().
+			WithSize(1))
+		Expect(applyObj(ctx, volume, patch)).To(Succeed())
+
+		patch.Spec.WithResource(applyconfigv1alpha1.VolumeResourceSpec().
+			WithSize(1).WithVolumeTypeRef("type-a"))
+		Expect(applyObj(ctx, volume, patch)).To(Succeed())
+	})
+
+	It("should reject an invalid migrationPolicy", func(ctx context.Context) {
+		volume := volumeStub(namespace)
+		patch := baseVolumePatch(volume)
+		patch.Spec.WithResource(applyconfigv1alpha1.VolumeResourceSpec().
+			WithSize(1).WithMigrationPolicy("always"))
+		Expect(applyObj(ctx, volume, patch)).To(MatchError(ContainSubstring("spec.resource.migrationPolicy")))
 	})
 
 	It("should have immutable availabilityZone", func(ctx context.Context) {
@@ -156,4 +198,55 @@ var _ = Describe("ORC Volume API validations", func() {
 			WithSize(1).WithImageRef("image-b"))
 		Expect(applyObj(ctx, volume, patch)).To(MatchError(ContainSubstring("imageRef is immutable")))
 	})
+
+	It("should have immutable snapshotRef", func(ctx context.Context) {
+		volume := volumeStub(namespace)
+		patch := baseVolumePatch(volume)
+		patch.Spec.WithResource(applyconfigv1alpha1.VolumeResourceSpec().
+			WithSize(1).WithSnapshotRef("snapshot-a"))
+		Expect(applyObj(ctx, volume, patch)).To(Succeed())
+
+		patch.Spec.WithResource(applyconfigv1alpha1.VolumeResourceSpec().
+			WithSize(1).WithSnapshotRef("snapshot-b"))
+		Expect(applyObj(ctx, volume, patch)).To(MatchError(ContainSubstring("snapshotRef is immutable")))
+	})
+
+	It("should have immutable sourceVolumeRef", func(ctx context.Context) {
+		volume := volumeStub(namespace)
+		patch := baseVolumePatch(volume)
+		patch.Spec.WithResource(applyconfigv1alpha1.VolumeResourceSpec().
+			WithSize(1).WithSourceVolumeRef("volume-a"))
+		Expect(applyObj(ctx, volume, patch)).To(Succeed())
+
+		patch.Spec.WithResource(applyconfigv1alpha1.VolumeResourceSpec().
+			WithSize(1).WithSourceVolumeRef("volume-b"))
+		Expect(applyObj(ctx, volume, patch)).To(MatchError(ContainSubstring("sourceVolumeRef is immutable")))
+	})
+
+	It("should have immutable backupRef", func(ctx context.Context) {
+		volume := volumeStub(namespace)
+		patch := baseVolumePatch(volume)
+		patch.Spec.WithResource(applyconfigv1alpha1.VolumeResourceSpec().
+			WithSize(1).WithBackupRef("backup-a"))
+		Expect(applyObj(ctx, volume, patch)).To(Succeed())
+
+		patch.Spec.WithResource(applyconfigv1alpha1.VolumeResourceSpec().
+			WithSize(1).WithBackupRef("backup-b"))
+		Expect(applyObj(ctx, volume, patch)).To(MatchError(ContainSubstring("backupRef is immutable")))
+	})
+
+	DescribeTable("should reject multiple create sources",
+		func(ctx context.Context, resource *applyconfigv1alpha1.VolumeResourceSpecApplyConfiguration) {
+			volume := volumeStub(namespace)
+			patch := baseVolumePatch(volume)
+			patch.Spec.WithResource(resource.WithSize(1))
+			Expect(applyObj(ctx, volume, patch)).To(MatchError(ContainSubstring("only one of imageRef, snapshotRef, sourceVolumeRef or backupRef may be specified")))
+		},
+		Entry("imageRef and snapshotRef", applyconfigv1alpha1.VolumeResourceSpec().WithImageRef("image").WithSnapshotRef("snapshot")),
+		Entry("imageRef and sourceVolumeRef", applyconfigv1alpha1.VolumeResourceSpec().WithImageRef("image").WithSourceVolumeRef("volume")),
+		Entry("snapshotRef and sourceVolumeRef", applyconfigv1alpha1.VolumeResourceSpec().WithSnapshotRef("snapshot").WithSourceVolumeRef("volume")),
+		Entry("imageRef and backupRef", applyconfigv1alpha1.VolumeResourceSpec().WithImageRef("image").WithBackupRef("backup")),
+		Entry("snapshotRef and backupRef", applyconfigv1alpha1.VolumeResourceSpec().WithSnapshotRef("snapshot").WithBackupRef("backup")),
+		Entry("sourceVolumeRef and backupRef", applyconfigv1alpha1.VolumeResourceSpec().WithSourceVolumeRef("volume").WithBackupRef("backup")),
+	)
 })
@@ -0,0 +1,167 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apivalidations
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	applyconfigv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/pkg/clients/applyconfiguration/api/v1alpha1"
+)
+
+const (
+	volumebackupName = "volumebackup"
+	volumebackupID   = "8b2f9d8e-0a8c-4a41-9c7e-5f3e8b1d6c42"
+)
+
+func volumebackupStub(namespace *corev1.Namespace) *orcv1alpha1.VolumeBackup {
+	obj := &orcv1alpha1.VolumeBackup{}
+	obj.Name = volumebackupName
+	obj.Namespace = namespace.Name
+	return obj
+}
+
+func testVolumeBackupResource() *applyconfigv1alpha1.VolumeBackupResourceSpecApplyConfiguration {
+	return applyconfigv1alpha1.VolumeBackupResourceSpec().
+		WithVolumeRef("volume")
+}
+
+func baseVolumeBackupPatch(obj client.Object) *applyconfigv1alpha1.VolumeBackupApplyConfiguration {
+	return applyconfigv1alpha1.VolumeBackup(obj.GetName(), obj.GetNamespace()).
+		WithSpec(applyconfigv1alpha1.VolumeBackupSpec().
+			WithCloudCredentialsRef(testCredentials()))
+}
+
+func testVolumeBackupImport() *applyconfigv1alpha1.VolumeBackupImportApplyConfiguration {
+	return applyconfigv1alpha1.VolumeBackupImport().WithID(volumebackupID)
+}
+
+var _ = Describe("ORC VolumeBackup API validations", func() {
+	var namespace *corev1.Namespace
+	BeforeEach(func() {
+		namespace = createNamespace()
+	})
+
+	runManagementPolicyTests(func() *corev1.Namespace { return namespace }, managementPolicyTestArgs[*applyconfigv1alpha1.VolumeBackupApplyConfiguration]{
+		createObject: func(ns *corev1.Namespace) client.Object { return volumebackupStub(ns) },
+		basePatch: func(obj client.Object) *applyconfigv1alpha1.VolumeBackupApplyConfiguration {
+			return baseVolumeBackupPatch(obj)
+		},
+		applyResource: func(p *applyconfigv1alpha1.VolumeBackupApplyConfiguration) {
+			p.Spec.WithResource(testVolumeBackupResource())
+		},
+		applyImport: func(p *applyconfigv1alpha1.VolumeBackupApplyConfiguration) {
+			p.Spec.WithImport(testVolumeBackupImport())
+		},
+		applyEmptyImport: func(p *applyconfigv1alpha1.VolumeBackupApplyConfiguration) {
+			p.Spec.WithImport(applyconfigv1alpha1.VolumeBackupImport())
+		},
+		applyEmptyFilter: func(p *applyconfigv1alpha1.VolumeBackupApplyConfiguration) {
+			p.Spec.WithImport(applyconfigv1alpha1.VolumeBackupImport().WithFilter(applyconfigv1alpha1.VolumeBackupFilter()))
+		},
+		applyValidFilter: func(p *applyconfigv1alpha1.VolumeBackupApplyConfiguration) {
+			p.Spec.WithImport(applyconfigv1alpha1.VolumeBackupImport().WithFilter(applyconfigv1alpha1.VolumeBackupFilter().WithName("foo")))
+		},
+		applyManaged: func(p *applyconfigv1alpha1.VolumeBackupApplyConfiguration) {
+			p.Spec.WithManagementPolicy(orcv1alpha1.ManagementPolicyManaged)
+		},
+		applyUnmanaged: func(p *applyconfigv1alpha1.VolumeBackupApplyConfiguration) {
+			p.Spec.WithManagementPolicy(orcv1alpha1.ManagementPolicyUnmanaged)
+		},
+		applyManagedOptions: func(p *applyconfigv1alpha1.VolumeBackupApplyConfiguration) {
+			p.Spec.WithManagedOptions(applyconfigv1alpha1.ManagedOptions().WithOnDelete(orcv1alpha1.OnDeleteDetach))
+		},
+		getManagementPolicy: func(obj client.Object) orcv1alpha1.ManagementPolicy {
+			return obj.(*orcv1alpha1.VolumeBackup).Spec.ManagementPolicy
+		},
+		getOnDelete: func(obj client.Object) orcv1alpha1.OnDelete {
+			return obj.(*orcv1alpha1.VolumeBackup).Spec.ManagedOptions.OnDelete
+		},
+	})
+
+	It("should reject a volumebackup without required fields", func(ctx context.Context) {
+		obj := volumebackupStub(namespace)
+		patch := baseVolumeBackupPatch(obj)
+		patch.Spec.WithResource(applyconfigv1alpha1.VolumeBackupResourceSpec())
+		Expect(applyObj(ctx, obj, patch)).NotTo(Succeed())
+	})
+
+	It("should have immutable volumeRef", func(ctx context.Context) {
+		obj := volumebackupStub(namespace)
+		patch := baseVolumeBackupPatch(obj)
+		patch.Spec.WithResource(testVolumeBackupResource().
+			WithVolumeRef("volume-a"))
+		Expect(applyObj(ctx, obj, patch)).To(Succeed())
+
+		patch.Spec.WithResource(testVolumeBackupResource().
+			WithVolumeRef("volume-b"))
+		Expect(applyObj(ctx, obj, patch)).To(MatchError(ContainSubstring("volumeRef is immutable")))
+	})
+
+	It("should have immutable force", func(ctx context.Context) {
+		obj := volumebackupStub(namespace)
+		patch := baseVolumeBackupPatch(obj)
+		patch.Spec.WithResource(testVolumeBackupResource().
+			WithForce(true))
+		Expect(applyObj(ctx, obj, patch)).To(Succeed())
+
+		patch.Spec.WithResource(testVolumeBackupResource().
+			WithForce(false))
+		Expect(applyObj(ctx, obj, patch)).To(MatchError(ContainSubstring("force is immutable")))
+	})
+
+	It("should have immutable snapshotRef", func(ctx context.Context) {
+		obj := volumebackupStub(namespace)
+		patch := baseVolumeBackupPatch(obj)
+		patch.Spec.WithResource(testVolumeBackupResource().
+			WithSnapshotRef("snapshot-a"))
+		Expect(applyObj(ctx, obj, patch)).To(Succeed())
+
+		patch.Spec.WithResource(testVolumeBackupResource().
+			WithSnapshotRef("snapshot-b"))
+		Expect(applyObj(ctx, obj, patch)).To(MatchError(ContainSubstring("snapshotRef is immutable")))
+	})
+
+	It("should have immutable incremental", func(ctx context.Context) {
+		obj := volumebackupStub(namespace)
+		patch := baseVolumeBackupPatch(obj)
+		patch.Spec.WithResource(testVolumeBackupResource().
+			WithIncremental(true))
+		Expect(applyObj(ctx, obj, patch)).To(Succeed())
+
+		patch.Spec.WithResource(testVolumeBackupResource().
+			WithIncremental(false))
+		Expect(applyObj(ctx, obj, patch)).To(MatchError(ContainSubstring("incremental is immutable")))
+	})
+
+	It("should have immutable container", func(ctx context.Context) {
+		obj := volumebackupStub(namespace)
+		patch := baseVolumeBackupPatch(obj)
+		patch.Spec.WithResource(testVolumeBackupResource().
+			WithContainer("container-a"))
+		Expect(applyObj(ctx, obj, patch)).To(Succeed())
+
+		patch.Spec.WithResource(testVolumeBackupResource().
+			WithContainer("container-b"))
+		Expect(applyObj(ctx, obj, patch)).To(MatchError(ContainSubstring("container is immutable")))
+	})
+})
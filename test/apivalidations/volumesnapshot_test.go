@@ -0,0 +1,143 @@
+/*
+Copyright The ORC Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apivalidations
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	orcv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/api/v1alpha1"
+	applyconfigv1alpha1 "github.com/k-orc/openstack-resource-controller/v2/pkg/clients/applyconfiguration/api/v1alpha1"
+)
+
+const (
+	volumesnapshotName = "volumesnapshot"
+	volumesnapshotID   = "265c9e4f-0f5a-46e4-9f3f-fb8de25ae120"
+)
+
+func volumesnapshotStub(namespace *corev1.Namespace) *orcv1alpha1.VolumeSnapshot {
+	obj := &orcv1alpha1.VolumeSnapshot{}
+	obj.Name = volumesnapshotName
+	obj.Namespace = namespace.Name
+	return obj
+}
+
+func testVolumeSnapshotResource() *applyconfigv1alpha1.VolumeSnapshotResourceSpecApplyConfiguration {
+	return applyconfigv1alpha1.VolumeSnapshotResourceSpec().
+		WithVolumeRef("volume")
+}
+
+func baseVolumeSnapshotPatch(obj client.Object) *applyconfigv1alpha1.VolumeSnapshotApplyConfiguration {
+	return applyconfigv1alpha1.VolumeSnapshot(obj.GetName(), obj.GetNamespace()).
+		WithSpec(applyconfigv1alpha1.VolumeSnapshotSpec().
+			WithCloudCredentialsRef(testCredentials()))
+}
+
+func testVolumeSnapshotImport() *applyconfigv1alpha1.VolumeSnapshotImportApplyConfiguration {
+	return applyconfigv1alpha1.VolumeSnapshotImport().WithID(volumesnapshotID)
+}
+
+var _ = Describe("ORC VolumeSnapshot API validations", func() {
+	var namespace *corev1.Namespace
+	BeforeEach(func() {
+		namespace = createNamespace()
+	})
+
+	runManagementPolicyTests(func() *corev1.Namespace { return namespace }, managementPolicyTestArgs[*applyconfigv1alpha1.VolumeSnapshotApplyConfiguration]{
+		createObject: func(ns *corev1.Namespace) client.Object { return volumesnapshotStub(ns) },
+		basePatch: func(obj client.Object) *applyconfigv1alpha1.VolumeSnapshotApplyConfiguration {
+			return baseVolumeSnapshotPatch(obj)
+		},
+		applyResource: func(p *applyconfigv1alpha1.VolumeSnapshotApplyConfiguration) {
+			p.Spec.WithResource(testVolumeSnapshotResource())
+		},
+		applyImport: func(p *applyconfigv1alpha1.VolumeSnapshotApplyConfiguration) {
+			p.Spec.WithImport(testVolumeSnapshotImport())
+		},
+		applyEmptyImport: func(p *applyconfigv1alpha1.VolumeSnapshotApplyConfiguration) {
+			p.Spec.WithImport(applyconfigv1alpha1.VolumeSnapshotImport())
+		},
+		applyEmptyFilter: func(p *applyconfigv1alpha1.VolumeSnapshotApplyConfiguration) {
+			p.Spec.WithImport(applyconfigv1alpha1.VolumeSnapshotImport().WithFilter(applyconfigv1alpha1.VolumeSnapshotFilter()))
+		},
+		applyValidFilter: func(p *applyconfigv1alpha1.VolumeSnapshotApplyConfiguration) {
+			p.Spec.WithImport(applyconfigv1alpha1.VolumeSnapshotImport().WithFilter(applyconfigv1alpha1.VolumeSnapshotFilter().WithName("foo")))
+		},
+		applyManaged: func(p *applyconfigv1alpha1.VolumeSnapshotApplyConfiguration) {
+			p.Spec.WithManagementPolicy(orcv1alpha1.ManagementPolicyManaged)
+		},
+		applyUnmanaged: func(p *applyconfigv1alpha1.VolumeSnapshotApplyConfiguration) {
+			p.Spec.WithManagementPolicy(orcv1alpha1.ManagementPolicyUnmanaged)
+		},
+		applyManagedOptions: func(p *applyconfigv1alpha1.VolumeSnapshotApplyConfiguration) {
+			p.Spec.WithManagedOptions(applyconfigv1alpha1.ManagedOptions().WithOnDelete(orcv1alpha1.OnDeleteDetach))
+		},
+		getManagementPolicy: func(obj client.Object) orcv1alpha1.ManagementPolicy {
+			return obj.(*orcv1alpha1.VolumeSnapshot).Spec.ManagementPolicy
+		},
+		getOnDelete: func(obj client.Object) orcv1alpha1.OnDelete {
+			return obj.(*orcv1alpha1.VolumeSnapshot).Spec.ManagedOptions.OnDelete
+		},
+	})
+
+	It("should reject a volumesnapshot without required fields", func(ctx context.Context) {
+		obj := volumesnapshotStub(namespace)
+		patch := baseVolumeSnapshotPatch(obj)
+		patch.Spec.WithResource(applyconfigv1alpha1.VolumeSnapshotResourceSpec())
+		Expect(applyObj(ctx, obj, patch)).NotTo(Succeed())
+	})
+
+	It("should have immutable volumeRef", func(ctx context.Context) {
+		obj := volumesnapshotStub(namespace)
+		patch := baseVolumeSnapshotPatch(obj)
+		patch.Spec.WithResource(testVolumeSnapshotResource().
+			WithVolumeRef("volume-a"))
+		Expect(applyObj(ctx, obj, patch)).To(Succeed())
+
+		patch.Spec.WithResource(testVolumeSnapshotResource().
+			WithVolumeRef("volume-b"))
+		Expect(applyObj(ctx, obj, patch)).To(MatchError(ContainSubstring("volumeRef is immutable")))
+	})
+
+	It("should have immutable force", func(ctx context.Context) {
+		obj := volumesnapshotStub(namespace)
+		patch := baseVolumeSnapshotPatch(obj)
+		patch.Spec.WithResource(testVolumeSnapshotResource().
+			WithForce(true))
+		Expect(applyObj(ctx, obj, patch)).To(Succeed())
+
+		patch.Spec.WithResource(testVolumeSnapshotResource().
+			WithForce(false))
+		Expect(applyObj(ctx, obj, patch)).To(MatchError(ContainSubstring("force is immutable")))
+	})
+
+	It("should have immutable metadata", func(ctx context.Context) {
+		obj := volumesnapshotStub(namespace)
+		patch := baseVolumeSnapshotPatch(obj)
+		patch.Spec.WithResource(testVolumeSnapshotResource().
+			WithMetadata(applyconfigv1alpha1.VolumeSnapshotMetadata().WithName("key").WithValue("a")))
+		Expect(applyObj(ctx, obj, patch)).To(Succeed())
+
+		patch.Spec.WithResource(testVolumeSnapshotResource().
+			WithMetadata(applyconfigv1alpha1.VolumeSnapshotMetadata().WithName("key").WithValue("b")))
+		Expect(applyObj(ctx, obj, patch)).To(MatchError(ContainSubstring("metadata is immutable")))
+	})
+})